@@ -16,6 +16,7 @@ type Config struct {
 	PulsarConfig     pulsar.PulsarConfig
 	RedisConf        redis.RedisConf
 	SymbolEtcdConfig etcd.EtcdConfig
+	FeeAccountUid    int64      //平台手续费账户的用户id
 	OTLP             OTLPConfig `yaml:"otlp" json:"otlp"`
 }
 
@@ -72,6 +72,12 @@ func InitConsumer(sc *svc.ServiceContext) {
 						logx.Severef("[consumer]  match result cancel order failed err=%v data=%v", err, r)
 					}
 					//解冻用户资产
+				case *matchMq.MatchResp_Amend:
+
+					logx.Debugw("receive match amend data ", logx.Field("data", r))
+					if err := logic.NewHandleMatchResultLogic(sc).HandleAmendOrder(r, storeConsumedMessageId); err != nil {
+						logx.Severef("[consumer]  match result amend order failed err=%v data=%v", err, r)
+					}
 				}
 				if err := c.Ack(message); err != nil {
 					logx.Severef("ack message failed err = %v message =%v", err, message)
@@ -15,6 +15,7 @@ type User struct {
 	Status      int32  `gorm:"column:status;not null;comment:用户状态，1正常2锁定" json:"status"`
 	CreatedAt   int64  `gorm:"column:created_at;not null;comment:创建时间" json:"created_at"`
 	UpdatedAt   int64  `gorm:"column:updated_at;not null;comment:更新时间" json:"updated_at"`
+	VipLevel    int32  `gorm:"column:vip_level;not null;comment:vip等级 0普通用户" json:"vip_level"`
 }
 
 // TableName User's table name
@@ -34,6 +34,7 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Status = field.NewInt32(tableName, "status")
 	_user.CreatedAt = field.NewInt64(tableName, "created_at")
 	_user.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_user.VipLevel = field.NewInt32(tableName, "vip_level")
 
 	_user.fillFieldMap()
 
@@ -51,6 +52,7 @@ type user struct {
 	Status      field.Int32  // 用户状态，1正常2锁定
 	CreatedAt   field.Int64  // 创建时间
 	UpdatedAt   field.Int64  // 更新时间
+	VipLevel    field.Int32  // vip等级 0普通用户
 
 	fieldMap map[string]field.Expr
 }
@@ -74,6 +76,7 @@ func (u *user) updateTableName(table string) *user {
 	u.Status = field.NewInt32(table, "status")
 	u.CreatedAt = field.NewInt64(table, "created_at")
 	u.UpdatedAt = field.NewInt64(table, "updated_at")
+	u.VipLevel = field.NewInt32(table, "vip_level")
 
 	u.fillFieldMap()
 
@@ -96,7 +99,7 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 7)
+	u.fieldMap = make(map[string]field.Expr, 8)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["username"] = u.Username
 	u.fieldMap["password"] = u.Password
@@ -104,6 +107,7 @@ func (u *user) fillFieldMap() {
 	u.fieldMap["status"] = u.Status
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
+	u.fieldMap["vip_level"] = u.VipLevel
 }
 
 func (u user) clone(db *gorm.DB) user {
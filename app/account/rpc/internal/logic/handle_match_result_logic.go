@@ -5,11 +5,15 @@ import (
 	"github.com/luxun9527/gex/app/account/rpc/internal/dao/model"
 	"github.com/luxun9527/gex/app/account/rpc/internal/dao/query"
 	"github.com/luxun9527/gex/app/account/rpc/internal/svc"
+	"github.com/luxun9527/gex/common/proto/define"
 	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
 	"github.com/luxun9527/gex/common/utils"
 	logger "github.com/luxun9527/zlog"
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 // HandleMatchResultLogic 结算
@@ -18,6 +22,7 @@ type HandleMatchResultLogic struct {
 }
 
 // HandleMatchResult  结算，扣减用户资产
+// 手续费从收到的币中扣除，买方收取基础币，卖方收取计价币，转入平台的手续费账户。
 func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_MatchResult, storeConsumedMessageId func() error) error {
 	if len(result.MatchResult.MatchedRecord) == 0 {
 		return nil
@@ -26,6 +31,11 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 	if err := l.svcCtx.Query.Transaction(func(tx *query.Query) error {
 		assetDo := tx.WithContext(context.Background()).Asset
 		i := len(result.MatchResult.MatchedRecord) - 1
+		//taker的手续费和平台收取的手续费
+		takerFee, baseCoinFee, quoteCoinFee := utils.DecimalZeroMaxPrec, utils.DecimalZeroMaxPrec, utils.DecimalZeroMaxPrec
+		for _, v := range result.MatchResult.MatchedRecord {
+			takerFee = takerFee.Add(utils.NewFromStringMaxPrec(v.TakerFee))
+		}
 		//taker只更新一次
 		//取taker基础币
 		takerBaseCoin, err := assetDo.Select(asset.ID, asset.FrozenQty, asset.AvailableQty).
@@ -63,8 +73,9 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 				UpdateSimple(asset.FrozenQty.Value(frozenQty), asset.AvailableQty.Value(availableQty)); err != nil {
 				return err
 			}
-			//taker 加可用基础币
-			qty := utils.NewFromStringMaxPrec(takerBaseCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(result.MatchResult.Qty))
+			//taker 加可用基础币，扣除手续费
+			qty := utils.NewFromStringMaxPrec(takerBaseCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(result.MatchResult.Qty)).Sub(takerFee)
+			baseCoinFee = baseCoinFee.Add(takerFee)
 			if _, err := assetDo.
 				Where(asset.ID.Eq(takerBaseCoin.ID)).
 				Update(asset.AvailableQty, qty); err != nil {
@@ -79,8 +90,9 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 				Update(asset.FrozenQty, qty); err != nil {
 				return err
 			}
-			//taker 加可用计价币
-			amount := utils.NewFromStringMaxPrec(takerQuoteCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(result.MatchResult.Amount))
+			//taker 加可用计价币，扣除手续费
+			amount := utils.NewFromStringMaxPrec(takerQuoteCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(result.MatchResult.Amount)).Sub(takerFee)
+			quoteCoinFee = quoteCoinFee.Add(takerFee)
 			if _, err := assetDo.
 				Where(asset.ID.Eq(takerQuoteCoin.ID)).
 				Update(asset.AvailableQty, amount); err != nil {
@@ -94,6 +106,7 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 			var (
 				makerBaseCoin  *model.Asset
 				makerQuoteCoin *model.Asset
+				makerFee       = utils.NewFromStringMaxPrec(v.MakerFee)
 			)
 
 			makerBaseCoin, err = assetDo.Select(asset.ID, asset.FrozenQty, asset.AvailableQty).
@@ -117,8 +130,9 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 					Update(asset.FrozenQty, makerBaseCoin.FrozenQty); err != nil {
 					return err
 				}
-				//maker 加可用计价币
-				makerQuoteCoin.AvailableQty = utils.NewFromStringMaxPrec(makerQuoteCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(v.Amount)).String()
+				//maker 加可用计价币，扣除手续费
+				makerQuoteCoin.AvailableQty = utils.NewFromStringMaxPrec(makerQuoteCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(v.Amount)).Sub(makerFee).String()
+				quoteCoinFee = quoteCoinFee.Add(makerFee)
 				if _, err := assetDo.
 					Where(asset.ID.Eq(makerQuoteCoin.ID)).
 					Update(asset.AvailableQty, makerQuoteCoin.AvailableQty); err != nil {
@@ -132,8 +146,9 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 					Update(asset.FrozenQty, makerQuoteCoin.FrozenQty); err != nil {
 					return err
 				}
-				//maker 加可用基础币
-				makerBaseCoin.AvailableQty = utils.NewFromStringMaxPrec(makerBaseCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(v.Qty)).String()
+				//maker 加可用基础币，扣除手续费
+				makerBaseCoin.AvailableQty = utils.NewFromStringMaxPrec(makerBaseCoin.AvailableQty).Add(utils.NewFromStringMaxPrec(v.Qty)).Sub(makerFee).String()
+				baseCoinFee = baseCoinFee.Add(makerFee)
 				if _, err := assetDo.
 					Where(asset.ID.Eq(makerBaseCoin.ID)).
 					Update(asset.AvailableQty, makerBaseCoin.AvailableQty); err != nil {
@@ -141,6 +156,13 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 				}
 			}
 		}
+		//手续费转入平台的手续费账户
+		if err := l.addPlatformFee(tx, result.MatchResult.BaseCoinId, baseCoinFee); err != nil {
+			return err
+		}
+		if err := l.addPlatformFee(tx, result.MatchResult.QuoteCoinId, quoteCoinFee); err != nil {
+			return err
+		}
 		if err := storeConsumedMessageId(); err != nil {
 			return err
 		}
@@ -153,6 +175,45 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 	return nil
 }
 
+// addPlatformFee 增加平台手续费账户的可用资产，没有这个币种的资产则创建。
+// asset表(user_id, coin_id)唯一，使用INSERT ... ON DUPLICATE KEY UPDATE在数据库中累加，不需要先锁住手续费账户再更新。
+func (l *HandleMatchResultLogic) addPlatformFee(tx *query.Query, coinId int32, fee decimal.Decimal) error {
+	if fee.IsZero() {
+		return nil
+	}
+	asset := l.svcCtx.Query.Asset
+	now := time.Now().Unix()
+	onUpdate := map[string]interface{}{
+		asset.AvailableQty.ColumnName().String(): gorm.Expr(asset.AvailableQty.ColumnName().String()+" + ?", fee.String()),
+		asset.UpdatedAt.ColumnName().String():    now,
+	}
+	return tx.WithContext(context.Background()).Asset.
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(onUpdate),
+		}).
+		Create(&model.Asset{
+			UserID:       l.svcCtx.Config.FeeAccountUid,
+			CoinID:       coinId,
+			CoinName:     l.coinName(coinId),
+			AvailableQty: fee.String(),
+			FrozenQty:    "0",
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+}
+
+func (l *HandleMatchResultLogic) coinName(coinId int32) string {
+	var name string
+	l.svcCtx.Coins.Range(func(key, value any) bool {
+		if c := value.(*define.CoinInfo); c.CoinID == coinId {
+			name = c.CoinName
+			return false
+		}
+		return true
+	})
+	return name
+}
+
 func NewHandleMatchResultLogic(svcCtx *svc.ServiceContext) *HandleMatchResultLogic {
 	return &HandleMatchResultLogic{
 		svcCtx: svcCtx,
@@ -161,10 +222,23 @@ func NewHandleMatchResultLogic(svcCtx *svc.ServiceContext) *HandleMatchResultLog
 
 // HandleCancelOrder 取消订单解冻
 func (l *HandleMatchResultLogic) HandleCancelOrder(cancelResp *matchMq.MatchResp_Cancel, storeConsumedMessageId func() error) error {
+	return l.unFreeze(cancelResp.Cancel.Uid, cancelResp.Cancel.CoinId, cancelResp.Cancel.Qty, storeConsumedMessageId)
+}
+
+// HandleAmendOrder 修改订单解冻多冻结的部分
+func (l *HandleMatchResultLogic) HandleAmendOrder(amendResp *matchMq.MatchResp_Amend, storeConsumedMessageId func() error) error {
+	if !utils.NewFromStringMaxPrec(amendResp.Amend.UnFrozenQty).IsPositive() {
+		return storeConsumedMessageId()
+	}
+	return l.unFreeze(amendResp.Amend.Uid, amendResp.Amend.CoinId, amendResp.Amend.UnFrozenQty, storeConsumedMessageId)
+}
+
+// unFreeze 解冻用户资产
+func (l *HandleMatchResultLogic) unFreeze(uid int64, coinId int32, qty string, storeConsumedMessageId func() error) error {
 	asset := l.svcCtx.Query.Asset
 	return l.svcCtx.Query.Transaction(func(tx *query.Query) error {
 		assetDetail, err := tx.Asset.WithContext(context.Background()).
-			Where(asset.UserID.Eq(cancelResp.Cancel.Uid), asset.CoinID.Eq(cancelResp.Cancel.CoinId)).
+			Where(asset.UserID.Eq(uid), asset.CoinID.Eq(coinId)).
 			Select(asset.ID, asset.FrozenQty, asset.AvailableQty).
 			Clauses(clause.Locking{Strength: "UPDATE"}).
 			First()
@@ -172,8 +246,8 @@ func (l *HandleMatchResultLogic) HandleCancelOrder(cancelResp *matchMq.MatchResp
 			logx.Errorw("[cancel ] query user asset  failed", logger.ErrorField(err))
 			return err
 		}
-		logx.Debugw("[cancel] before update", logx.Field("assetDetail", assetDetail), logx.Field("Qty", cancelResp.Cancel.Qty))
-		q := utils.NewFromStringMaxPrec(cancelResp.Cancel.Qty)
+		logx.Debugw("[cancel] before update", logx.Field("assetDetail", assetDetail), logx.Field("Qty", qty))
+		q := utils.NewFromStringMaxPrec(qty)
 		frozenQty := utils.NewFromStringMaxPrec(assetDetail.FrozenQty).Sub(q)
 		availableQty := utils.NewFromStringMaxPrec(assetDetail.AvailableQty).Add(q)
 		logx.Debugw("[cancel] after update", logx.Field("frozenQty", frozenQty), logx.Field("availableQty", availableQty))
@@ -44,8 +44,19 @@ func (l *ValidateTokenLogic) ValidateToken(in *pb.ValidateTokenReq) (*pb.Validat
 	if !existed {
 		return nil, errs.TokenValidateFailed
 	}
+	//vip等级从用户表中读取，修改之后不需要重新登录
+	user := l.svcCtx.Query.User
+	userInfo, err := user.WithContext(l.ctx).
+		Select(user.VipLevel).
+		Where(user.ID.Eq(int32(claims.UserID))).
+		Take()
+	if err != nil {
+		logx.Errorw("find user failed", logger.ErrorField(err), logx.Field("uid", claims.UserID))
+		return nil, errs.Internal
+	}
 	return &pb.ValidateTokenResp{
 		Uid:      claims.UserID,
 		Username: claims.Username,
+		VipLevel: userInfo.VipLevel,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	commongorm "github.com/luxun9527/gex/common/pkg/gorm"
 	logger "github.com/luxun9527/zlog"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
@@ -14,4 +15,5 @@ type Config struct {
 	AdminGormConf    commongorm.GormConf
 	MatchGormConf    commongorm.GormConf
 	LanguageEtcdConf etcd.EtcdConfig
+	MatchRpcConf     zrpc.RpcClientConf
 }
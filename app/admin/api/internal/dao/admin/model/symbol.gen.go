@@ -20,6 +20,13 @@ type Symbol struct {
 	CreatedAt     uint32 `gorm:"column:created_at;not null;comment:创建时间" json:"created_at"`            // 创建时间
 	UpdatedAt     uint32 `gorm:"column:updated_at;not null;comment:修改时间" json:"updated_at"`            // 修改时间
 	DeletedAt     uint32 `gorm:"column:deleted_at;not null;comment:删除时间" json:"deleted_at"`            // 删除时间
+	TickSize      string `gorm:"column:tick_size;not null;comment:价格最小变动单位" json:"tick_size"`          // 价格最小变动单位
+	LotSize       string `gorm:"column:lot_size;not null;comment:数量最小变动单位" json:"lot_size"`            // 数量最小变动单位
+	MinQty        string `gorm:"column:min_qty;not null;comment:最小下单数量" json:"min_qty"`                // 最小下单数量
+	MaxQty        string `gorm:"column:max_qty;not null;comment:最大下单数量" json:"max_qty"`                // 最大下单数量
+	MinNotional   string `gorm:"column:min_notional;not null;comment:最小下单金额" json:"min_notional"`      // 最小下单金额
+	MaxNotional   string `gorm:"column:max_notional;not null;comment:最大下单金额" json:"max_notional"`      // 最大下单金额
+	OpenTime      int64  `gorm:"column:open_time;not null;comment:开盘时间" json:"open_time"`              // 开盘时间
 }
 
 // TableName Symbol's table name
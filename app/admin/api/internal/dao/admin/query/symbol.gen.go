@@ -39,6 +39,13 @@ func newSymbol(db *gorm.DB, opts ...gen.DOOption) symbol {
 	_symbol.CreatedAt = field.NewUint32(tableName, "created_at")
 	_symbol.UpdatedAt = field.NewUint32(tableName, "updated_at")
 	_symbol.DeletedAt = field.NewUint32(tableName, "deleted_at")
+	_symbol.TickSize = field.NewString(tableName, "tick_size")
+	_symbol.LotSize = field.NewString(tableName, "lot_size")
+	_symbol.MinQty = field.NewString(tableName, "min_qty")
+	_symbol.MaxQty = field.NewString(tableName, "max_qty")
+	_symbol.MinNotional = field.NewString(tableName, "min_notional")
+	_symbol.MaxNotional = field.NewString(tableName, "max_notional")
+	_symbol.OpenTime = field.NewInt64(tableName, "open_time")
 
 	_symbol.fillFieldMap()
 
@@ -61,6 +68,13 @@ type symbol struct {
 	CreatedAt     field.Uint32 // 创建时间
 	UpdatedAt     field.Uint32 // 修改时间
 	DeletedAt     field.Uint32 // 删除时间
+	TickSize      field.String // 价格最小变动单位
+	LotSize       field.String // 数量最小变动单位
+	MinQty        field.String // 最小下单数量
+	MaxQty        field.String // 最大下单数量
+	MinNotional   field.String // 最小下单金额
+	MaxNotional   field.String // 最大下单金额
+	OpenTime      field.Int64  // 开盘时间
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +103,13 @@ func (s *symbol) updateTableName(table string) *symbol {
 	s.CreatedAt = field.NewUint32(table, "created_at")
 	s.UpdatedAt = field.NewUint32(table, "updated_at")
 	s.DeletedAt = field.NewUint32(table, "deleted_at")
+	s.TickSize = field.NewString(table, "tick_size")
+	s.LotSize = field.NewString(table, "lot_size")
+	s.MinQty = field.NewString(table, "min_qty")
+	s.MaxQty = field.NewString(table, "max_qty")
+	s.MinNotional = field.NewString(table, "min_notional")
+	s.MaxNotional = field.NewString(table, "max_notional")
+	s.OpenTime = field.NewInt64(table, "open_time")
 
 	s.fillFieldMap()
 
@@ -113,7 +134,7 @@ func (s *symbol) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *symbol) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap = make(map[string]field.Expr, 19)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["symbol_name"] = s.SymbolName
 	s.fieldMap["symbol_id"] = s.SymbolID
@@ -126,6 +147,13 @@ func (s *symbol) fillFieldMap() {
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["tick_size"] = s.TickSize
+	s.fieldMap["lot_size"] = s.LotSize
+	s.fieldMap["min_qty"] = s.MinQty
+	s.fieldMap["max_qty"] = s.MaxQty
+	s.fieldMap["min_notional"] = s.MinNotional
+	s.fieldMap["max_notional"] = s.MaxNotional
+	s.fieldMap["open_time"] = s.OpenTime
 }
 
 func (s symbol) clone(db *gorm.DB) symbol {
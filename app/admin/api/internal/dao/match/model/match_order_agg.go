@@ -26,6 +26,8 @@ type SubMatchedOrder struct {
 	Qty          string `gorm:"column:qty;not null;comment:数量(基础币)" json:"qty"`                         // 数量(基础币)
 	Amount       string `gorm:"column:amount;not null;comment:金额（计价币）" json:"amount"`                   // 金额（计价币）
 	MatchTime    int64  `gorm:"column:match_time;not null;" json:"match_time"`                          // 金额（计价币）
+	TakerFee     string `gorm:"column:taker_fee;not null;comment:taker手续费" json:"taker_fee"`            // taker手续费
+	MakerFee     string `gorm:"column:maker_fee;not null;comment:maker手续费" json:"maker_fee"`            // maker手续费
 }
 
 type SubMatchedList []*SubMatchedOrder
@@ -24,6 +24,8 @@ type MatchedOrder struct {
 	MatchTime    int64  `gorm:"column:match_time;not null;comment:撮合时间" json:"match_time"`                               // 撮合时间
 	CreatedAt    int64  `gorm:"column:created_at;not null;comment:创建时间" json:"created_at"`                               // 创建时间
 	UpdatedAt    int64  `gorm:"column:updated_at;not null;comment:修改时间" json:"updated_at"`                               // 修改时间
+	TakerFee     string `gorm:"column:taker_fee;not null;comment:taker手续费 taker买为基础币 taker卖为计价币" json:"taker_fee"`
+	MakerFee     string `gorm:"column:maker_fee;not null;comment:maker手续费 maker买为基础币 maker卖为计价币" json:"maker_fee"`
 }
 
 // TableName MatchedOrder's table name
@@ -97,6 +97,26 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/get_match_list",
 				Handler: GetMatchListHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/get_order_book",
+				Handler: GetOrderBookHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/get_engine_order",
+				Handler: GetEngineOrderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/get_engine_stats",
+				Handler: GetEngineStatsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/halt_trading",
+				Handler: HaltTradingHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/admin/v1"),
 	)
@@ -7,6 +7,8 @@ import (
 	"github.com/luxun9527/gex/app/admin/api/internal/svc"
 	"github.com/luxun9527/gex/app/admin/api/internal/types"
 	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 	"gorm.io/gorm"
 )
@@ -41,6 +43,16 @@ func (l *AddSymbolLogic) AddSymbol(req *types.AddSymbolReq) (resp *types.AddSymb
 		logx.Errorf("AddSymbol find QuoteCoinID err: %v", err)
 		return nil, err
 	}
+	rule, err := newTradingRule(req.TickSize, req.LotSize, req.MinQty, req.MaxQty, req.MinNotional, req.MaxNotional)
+	if err != nil {
+		return nil, err
+	}
+	if err := define.CheckMaxNotional(baseCoinInfo.Prec, quoteCoinInfo.Prec, rule.MaxNotional); err != nil {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, err.Error())
+	}
+	if req.OpenTime < 0 {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "open time must be a non negative number")
+	}
 	symbolName := baseCoinInfo.CoinName + "_" + quoteCoinInfo.CoinName
 	c := &model.Symbol{
 		SymbolName:    symbolName,
@@ -51,6 +63,13 @@ func (l *AddSymbolLogic) AddSymbol(req *types.AddSymbolReq) (resp *types.AddSymb
 		QuoteCoinID:   uint32(req.QuoteCoinID),
 		QuoteCoinName: quoteCoinInfo.CoinName,
 		QuoteCoinPrec: quoteCoinInfo.Prec,
+		TickSize:      rule.TickSize,
+		LotSize:       rule.LotSize,
+		MinQty:        rule.MinQty,
+		MaxQty:        rule.MaxQty,
+		MinNotional:   rule.MinNotional,
+		MaxNotional:   rule.MaxNotional,
+		OpenTime:      req.OpenTime,
 	}
 	if err := symbol.WithContext(l.ctx).Create(c); err != nil {
 		if errors.Is(gorm.ErrDuplicatedKey, err) {
@@ -62,3 +81,38 @@ func (l *AddSymbolLogic) AddSymbol(req *types.AddSymbolReq) (resp *types.AddSymb
 
 	return &types.AddSymbolResp{}, nil
 }
+
+// newTradingRule 校验交易规则,为空或者为零表示不限制
+func newTradingRule(tickSize, lotSize, minQty, maxQty, minNotional, maxNotional string) (*model.Symbol, error) {
+	values := []*string{&tickSize, &lotSize, &minQty, &maxQty, &minNotional, &maxNotional}
+	for _, v := range values {
+		if *v == "" {
+			*v = "0"
+			continue
+		}
+		d, err := decimal.NewFromString(*v)
+		if err != nil || d.IsNegative() {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "trading rule must be a non negative number")
+		}
+		*v = d.String()
+	}
+	if isInvalidRange(minQty, maxQty) {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "min qty must less than max qty")
+	}
+	if isInvalidRange(minNotional, maxNotional) {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "min notional must less than max notional")
+	}
+	return &model.Symbol{
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+		MinQty:      minQty,
+		MaxQty:      maxQty,
+		MinNotional: minNotional,
+		MaxNotional: maxNotional,
+	}, nil
+}
+
+func isInvalidRange(min, max string) bool {
+	maxValue := decimal.RequireFromString(max)
+	return maxValue.IsPositive() && decimal.RequireFromString(min).GreaterThan(maxValue)
+}
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"github.com/luxun9527/gex/app/admin/api/internal/svc"
+	"github.com/luxun9527/gex/app/admin/api/internal/types"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetEngineOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetEngineOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEngineOrderLogic {
+	return &GetEngineOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetEngineOrder 撮合引擎中订单的实时状态，已经完成或者撤销的订单查询不到
+func (l *GetEngineOrderLogic) GetEngineOrder(req *types.GetEngineOrderReq) (resp *types.EngineOrder, err error) {
+	if req.Symbol == "" || req.Id <= 0 {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol and id are required")
+	}
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.Symbol))
+	orderResp, err := l.svcCtx.MatchClient.GetEngineOrder(ctx, &matchpb.GetEngineOrderReq{
+		Symbol: req.Symbol,
+		Id:     req.Id,
+	})
+	if err != nil {
+		logx.Errorw("GetEngineOrder failed", logx.Field("err", err), logx.Field("symbol", req.Symbol), logx.Field("id", req.Id))
+		return nil, err
+	}
+	return engineOrderToTypes(orderResp.Order), nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"github.com/luxun9527/gex/app/admin/api/internal/svc"
+	"github.com/luxun9527/gex/app/admin/api/internal/types"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetEngineStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetEngineStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEngineStatsLogic {
+	return &GetEngineStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEngineStatsLogic) GetEngineStats(req *types.EngineSymbolReq) (resp *types.GetEngineStatsResp, err error) {
+	if req.Symbol == "" {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol is required")
+	}
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.Symbol))
+	stats, err := l.svcCtx.MatchClient.GetEngineStats(ctx, &matchpb.GetEngineStatsReq{Symbol: req.Symbol})
+	if err != nil {
+		logx.Errorw("GetEngineStats failed", logx.Field("err", err), logx.Field("symbol", req.Symbol))
+		return nil, err
+	}
+	return &types.GetEngineStatsResp{
+		CurrentSeqId:   stats.CurrentSeqId,
+		ResultSeq:      stats.ResultSeq,
+		AskOrders:      stats.AskOrders,
+		BidOrders:      stats.BidOrders,
+		TriggerOrders:  stats.TriggerOrders,
+		AskLevels:      stats.AskLevels,
+		BidLevels:      stats.BidLevels,
+		BestBid:        stats.BestBid,
+		BestAsk:        stats.BestAsk,
+		LastPrice:      stats.LastPrice,
+		Halted:         stats.Halted,
+		HaltUntil:      stats.HaltUntil,
+		AuctionUntil:   stats.AuctionUntil,
+		TickQueue:      stats.TickQueue,
+		DepthQueue:     stats.DepthQueue,
+		DepthPushQueue: stats.DepthPushQueue,
+		Leader:         stats.Leader,
+	}, nil
+}
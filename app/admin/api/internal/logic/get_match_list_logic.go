@@ -40,7 +40,7 @@ SELECT
 	sum(amount) as total_amount,
 	sum(qty) as total_qty,
 	JSON_ARRAYAGG(
-	JSON_OBJECT( 'match_sub_id',mo.match_sub_id,'price',CAST(mo.price as char),'amount', CAST(mo.amount as char),'qty',CAST(mo.qty as char),'taker_user_id', mo.taker_user_id,'maker_user_id', mo.maker_user_id,'maker_order_id', mo.maker_order_id,'taker_order_id', mo.taker_order_id,'match_time', mo.match_time))  as sub_match_list
+	JSON_OBJECT( 'match_sub_id',mo.match_sub_id,'price',CAST(mo.price as char),'amount', CAST(mo.amount as char),'qty',CAST(mo.qty as char),'taker_fee',CAST(mo.taker_fee as char),'maker_fee',CAST(mo.maker_fee as char),'taker_user_id', mo.taker_user_id,'maker_user_id', mo.maker_user_id,'maker_order_id', mo.maker_order_id,'taker_order_id', mo.taker_order_id,'match_time', mo.match_time))  as sub_match_list
 FROM
 	matched_order mo 
 GROUP BY
@@ -77,6 +77,8 @@ ORDER BY
 				MatchQty:    utils.PrecCut(v.Qty, 5),
 				MatchAmount: utils.PrecCut(v.Amount, 5),
 				MatchTime:   v.MatchTime / 1e9,
+				TakerFee:    utils.PrecCut(v.TakerFee, 8),
+				MakerFee:    utils.PrecCut(v.MakerFee, 8),
 			}
 			s = append(s, d)
 		}
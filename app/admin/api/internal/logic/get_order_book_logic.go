@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"github.com/luxun9527/gex/app/admin/api/internal/svc"
+	"github.com/luxun9527/gex/app/admin/api/internal/types"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetOrderBookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOrderBookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrderBookLogic {
+	return &GetOrderBookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOrderBookLogic) GetOrderBook(req *types.EngineSymbolReq) (resp *types.GetOrderBookResp, err error) {
+	if req.Symbol == "" {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol is required")
+	}
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.Symbol))
+	bookResp, err := l.svcCtx.MatchClient.GetOrderBook(ctx, &matchpb.GetOrderBookReq{Symbol: req.Symbol})
+	if err != nil {
+		logx.Errorw("GetOrderBook failed", logx.Field("err", err), logx.Field("symbol", req.Symbol))
+		return nil, err
+	}
+	toTypes := func(orders []*matchpb.EngineOrder) []*types.EngineOrder {
+		result := make([]*types.EngineOrder, 0, len(orders))
+		for _, v := range orders {
+			result = append(result, engineOrderToTypes(v))
+		}
+		return result
+	}
+	return &types.GetOrderBookResp{
+		Version:  bookResp.Version,
+		Asks:     toTypes(bookResp.Asks),
+		Bids:     toTypes(bookResp.Bids),
+		Triggers: toTypes(bookResp.Triggers),
+	}, nil
+}
+
+func engineOrderToTypes(o *matchpb.EngineOrder) *types.EngineOrder {
+	return &types.EngineOrder{
+		Id:             o.Id,
+		OrderId:        o.OrderId,
+		Uid:            o.Uid,
+		Side:           int32(o.Side),
+		OrderType:      int32(o.OrderType),
+		OrderStatus:    int32(o.OrderStatus),
+		Price:          o.Price,
+		Qty:            o.Qty,
+		Amount:         o.Amount,
+		FilledQty:      o.FilledQty,
+		FilledAmount:   o.FilledAmount,
+		UnfilledQty:    o.UnfilledQty,
+		UnfilledAmount: o.UnfilledAmount,
+		Fee:            o.Fee,
+		TriggerPrice:   o.TriggerPrice,
+		TriggerStatus:  int32(o.TriggerStatus),
+		DisplayQty:     o.DisplayQty,
+		VisibleQty:     o.VisibleQty,
+		PostOnly:       o.PostOnly,
+		StpMode:        int32(o.StpMode),
+		ExpireTime:     o.ExpireTime,
+		QueueId:        o.QueueId,
+	}
+}
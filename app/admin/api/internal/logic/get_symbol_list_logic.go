@@ -41,6 +41,13 @@ func (l *GetSymbolListLogic) GetSymbolList(req *types.GetSymbolListReq) (resp *t
 			QuoteCoinID:   int32(v.QuoteCoinID),
 			QuoteCoinName: v.QuoteCoinName,
 			QuotePrec:     v.QuoteCoinPrec,
+			TickSize:      v.TickSize,
+			LotSize:       v.LotSize,
+			MinQty:        v.MinQty,
+			MaxQty:        v.MaxQty,
+			MinNotional:   v.MinNotional,
+			MaxNotional:   v.MaxNotional,
+			OpenTime:      v.OpenTime,
 		}
 		list = append(list, s)
 
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"github.com/luxun9527/gex/app/admin/api/internal/svc"
+	"github.com/luxun9527/gex/app/admin/api/internal/types"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type HaltTradingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewHaltTradingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HaltTradingLogic {
+	return &HaltTradingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// HaltTrading 暂停或者恢复交易，撮合引擎按照消息的顺序处理，通过get_engine_stats确认是否生效
+func (l *HaltTradingLogic) HaltTrading(req *types.HaltTradingReq) (resp *types.Empty, err error) {
+	if req.Symbol == "" {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol is required")
+	}
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.Symbol))
+	if _, err := l.svcCtx.MatchClient.HaltTrading(ctx, &matchpb.HaltTradingReq{
+		Symbol: req.Symbol,
+		Halted: req.Halted,
+	}); err != nil {
+		logx.Errorw("HaltTrading failed", logx.Field("err", err), logx.Field("symbol", req.Symbol), logx.Field("halted", req.Halted))
+		return nil, err
+	}
+	logx.Sloww("halt trading", logx.Field("symbol", req.Symbol), logx.Field("halted", req.Halted))
+	return &types.Empty{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/luxun9527/gex/common/proto/define"
 	logger "github.com/luxun9527/zlog"
+	"github.com/shopspring/decimal"
 	"gopkg.in/yaml.v3"
 
 	"github.com/luxun9527/gex/app/admin/api/internal/svc"
@@ -35,16 +36,33 @@ func (l *SyncSymbolConfigLogic) SyncSymbolConfig(req *types.Empty) (resp *types.
 		return &types.Empty{}, nil
 	}
 	for _, v := range symbols {
-		symbolInfo := &define.SymbolInfo{
-			SymbolName:         v.SymbolName,
-			SymbolID:           v.SymbolID,
-			BaseCoinName:       v.BaseCoinName,
-			BaseCoinID:         int32(v.BaseCoinID),
-			QuoteCoinName:      v.QuoteCoinName,
-			QuoteCoinID:        int32(v.QuoteCoinID),
-			BaseCoinPrecValue:  v.BaseCoinPrec,
-			QuoteCoinPrecValue: v.QuoteCoinPrec,
+		//自成交保护和手续费等不在后台管理的配置保留etcd中原有的值
+		symbolInfo := &define.SymbolInfo{}
+		r, err := l.svcCtx.EtcdCli.Get(l.ctx, define.EtcdSymbolPrefix+v.SymbolName)
+		if err != nil {
+			logx.Errorw("get config from etcd failed", logx.Field("err", err))
+			return nil, err
+		}
+		if len(r.Kvs) > 0 {
+			if err := yaml.Unmarshal(r.Kvs[0].Value, symbolInfo); err != nil {
+				logx.Errorw("yaml unmarshal config failed", logx.Field("err", err))
+			}
 		}
+		symbolInfo.SymbolName = v.SymbolName
+		symbolInfo.SymbolID = v.SymbolID
+		symbolInfo.BaseCoinName = v.BaseCoinName
+		symbolInfo.BaseCoinID = int32(v.BaseCoinID)
+		symbolInfo.QuoteCoinName = v.QuoteCoinName
+		symbolInfo.QuoteCoinID = int32(v.QuoteCoinID)
+		symbolInfo.BaseCoinPrecValue = v.BaseCoinPrec
+		symbolInfo.QuoteCoinPrecValue = v.QuoteCoinPrec
+		symbolInfo.TickSizeValue = decimal.RequireFromString(v.TickSize).String()
+		symbolInfo.LotSizeValue = decimal.RequireFromString(v.LotSize).String()
+		symbolInfo.MinQtyValue = decimal.RequireFromString(v.MinQty).String()
+		symbolInfo.MaxQtyValue = decimal.RequireFromString(v.MaxQty).String()
+		symbolInfo.MinNotionalValue = decimal.RequireFromString(v.MinNotional).String()
+		symbolInfo.MaxNotionalValue = decimal.RequireFromString(v.MaxNotional).String()
+		symbolInfo.OpenTimeValue = v.OpenTime
 		data, err := yaml.Marshal(symbolInfo)
 		if err != nil {
 			logx.Errorw("yaml marshal config failed", logx.Field("err", err))
@@ -8,6 +8,7 @@ import (
 	"github.com/luxun9527/gex/app/admin/api/internal/svc"
 	"github.com/luxun9527/gex/app/admin/api/internal/types"
 	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
 	"gorm.io/gorm"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -35,6 +36,23 @@ func (l *UpdateCoinLogic) UpdateCoin(req *types.UpdateCoinReq) (resp *types.Empt
 		Prec:     req.Prec,
 		ID:       req.ID,
 	}
+	//修改精度之后交易对的最大下单金额必须在撮合引擎金额的范围内
+	symbols, err := symbol.WithContext(l.ctx).Where(symbol.BaseCoinID.Eq(req.ID)).Or(symbol.QuoteCoinID.Eq(req.ID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range symbols {
+		baseCoinPrec, quoteCoinPrec := v.BaseCoinPrec, v.QuoteCoinPrec
+		if v.BaseCoinID == req.ID {
+			baseCoinPrec = req.Prec
+		}
+		if v.QuoteCoinID == req.ID {
+			quoteCoinPrec = req.Prec
+		}
+		if err := define.CheckMaxNotional(baseCoinPrec, quoteCoinPrec, v.MaxNotional); err != nil {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, v.SymbolName+" "+err.Error())
+		}
+	}
 	if err := l.svcCtx.AdminQuery.Transaction(func(tx *query.Query) error {
 		if _, err := tx.WithContext(l.ctx).Coin.Updates(c); err != nil {
 			return err
@@ -2,6 +2,10 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
+	"gorm.io/gorm"
 
 	"github.com/luxun9527/gex/app/admin/api/internal/svc"
 	"github.com/luxun9527/gex/app/admin/api/internal/types"
@@ -23,8 +27,44 @@ func NewUpdateSymbolLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Upda
 	}
 }
 
+// UpdateSymbol 修改交易对的交易规则和开盘时间,币种精度通过修改币种更新,修改之后需要同步配置到etcd
 func (l *UpdateSymbolLogic) UpdateSymbol(req *types.UpdateSymbolReq) (resp *types.UpdateSymbolResp, err error) {
-	// todo: add your logic here and delete this line
-
-	return
+	symbol := l.svcCtx.AdminQuery.Symbol
+	rule, err := newTradingRule(req.TickSize, req.LotSize, req.MinQty, req.MaxQty, req.MinNotional, req.MaxNotional)
+	if err != nil {
+		return nil, err
+	}
+	if req.OpenTime < 0 {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "open time must be a non negative number")
+	}
+	s, err := symbol.WithContext(l.ctx).Where(symbol.SymbolName.Eq(req.SymbolName)).Take()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+		}
+		logx.Errorw("find symbol failed", logx.Field("err", err))
+		return nil, err
+	}
+	if err := define.CheckMaxNotional(s.BaseCoinPrec, s.QuoteCoinPrec, rule.MaxNotional); err != nil {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, err.Error())
+	}
+	info, err := symbol.WithContext(l.ctx).
+		Where(symbol.SymbolName.Eq(req.SymbolName)).
+		UpdateColumnSimple(
+			symbol.TickSize.Value(rule.TickSize),
+			symbol.LotSize.Value(rule.LotSize),
+			symbol.MinQty.Value(rule.MinQty),
+			symbol.MaxQty.Value(rule.MaxQty),
+			symbol.MinNotional.Value(rule.MinNotional),
+			symbol.MaxNotional.Value(rule.MaxNotional),
+			symbol.OpenTime.Value(req.OpenTime),
+		)
+	if err != nil {
+		logx.Errorw("update symbol failed", logx.Field("err", err))
+		return nil, err
+	}
+	if info.RowsAffected == 0 {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	return &types.UpdateSymbolResp{}, nil
 }
@@ -4,11 +4,16 @@ import (
 	"github.com/luxun9527/gex/app/admin/api/internal/config"
 	adminQuery "github.com/luxun9527/gex/app/admin/api/internal/dao/admin/query"
 	matchQuery "github.com/luxun9527/gex/app/admin/api/internal/dao/match/query"
+	"github.com/luxun9527/gex/app/match/rpc/matchservice"
 	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/pkg/etcd"
 	"github.com/luxun9527/gex/common/utils"
 	logger "github.com/luxun9527/zlog"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/naming/resolver"
+	"google.golang.org/grpc"
 )
 
 type ServiceContext struct {
@@ -17,6 +22,8 @@ type ServiceContext struct {
 	JwtClient  *utils.JWT
 	AdminQuery *adminQuery.Query
 	MatchQuery *matchQuery.Query
+	//运维接口按照交易对路由到撮合服务
+	MatchClient matchservice.MatchService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -28,11 +35,24 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err != nil {
 		logx.Severef("init etcd client failed %v", err)
 	}
+	//自定义负载均衡策略，按照交易对选择撮合服务
+	var clientOpts []zrpc.ClientOption
+	serviceConfig := grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"symbol_lb"}`)
+
+	//自定义resolver
+	etcdConfig := etcd.EtcdConfig{Endpoints: c.MatchRpcConf.Etcd.Hosts}
+	etcdCli := etcdConfig.MustNewEtcdClient()
+	etcdResolver, err := resolver.NewBuilder(etcdCli)
+	if err != nil {
+		logx.Severef("NewBuilder error: %v", err)
+	}
+	clientOpts = append(clientOpts, zrpc.WithDialOption(grpc.WithResolvers(etcdResolver)), zrpc.WithDialOption(serviceConfig))
 	return &ServiceContext{
-		Config:     c,
-		EtcdCli:    cli,
-		JwtClient:  utils.NewJWT(),
-		AdminQuery: adminQuery.Use(c.AdminGormConf.MustNewGormClient()),
-		MatchQuery: matchQuery.Use(c.MatchGormConf.MustNewGormClient()),
+		Config:      c,
+		EtcdCli:     cli,
+		JwtClient:   utils.NewJWT(),
+		AdminQuery:  adminQuery.Use(c.AdminGormConf.MustNewGormClient()),
+		MatchQuery:  matchQuery.Use(c.MatchGormConf.MustNewGormClient()),
+		MatchClient: matchservice.NewMatchService(zrpc.MustNewClient(c.MatchRpcConf, clientOpts...)),
 	}
 }
@@ -59,6 +59,13 @@ type SymbolInfo struct {
 	QuoteCoinID   int32  `json:"quote_coin_id"`
 	QuoteCoinName string `json:"quote_coin_name"`
 	QuotePrec     int32  `json:"quote_prec"`
+	TickSize      string `json:"tick_size"`
+	LotSize       string `json:"lot_size"`
+	MinQty        string `json:"min_qty"`
+	MaxQty        string `json:"max_qty"`
+	MinNotional   string `json:"min_notional"`
+	MaxNotional   string `json:"max_notional"`
+	OpenTime      int64  `json:"open_time"`
 }
 
 type GetSymbolListResp struct {
@@ -67,9 +74,16 @@ type GetSymbolListResp struct {
 }
 
 type AddSymbolReq struct {
-	BaseCoinID  int32 `json:"base_coin_id"`
-	QuoteCoinID int32 `json:"quote_coin_id"`
-	SymbolId    int32 `json:"symbol_id"`
+	BaseCoinID  int32  `json:"base_coin_id"`
+	QuoteCoinID int32  `json:"quote_coin_id"`
+	SymbolId    int32  `json:"symbol_id"`
+	TickSize    string `json:"tick_size,optional"`    //价格最小变动单位
+	LotSize     string `json:"lot_size,optional"`     //数量最小变动单位
+	MinQty      string `json:"min_qty,optional"`      //最小下单数量
+	MaxQty      string `json:"max_qty,optional"`      //最大下单数量
+	MinNotional string `json:"min_notional,optional"` //最小下单金额
+	MaxNotional string `json:"max_notional,optional"` //最大下单金额
+	OpenTime    int64  `json:"open_time,optional"`    //开盘时间 单位秒,开盘之前为集合竞价
 }
 
 type AddSymbolResp struct {
@@ -109,6 +123,13 @@ type UpdateSymbolReq struct {
 	QuoteCoinID   int32  `json:"quote_coin_id"`
 	QuoteCoinName string `json:"quote_coin_name"`
 	QuotePrec     int32  `json:"quote_prec"`
+	TickSize      string `json:"tick_size,optional"`    //价格最小变动单位
+	LotSize       string `json:"lot_size,optional"`     //数量最小变动单位
+	MinQty        string `json:"min_qty,optional"`      //最小下单数量
+	MaxQty        string `json:"max_qty,optional"`      //最大下单数量
+	MinNotional   string `json:"min_notional,optional"` //最小下单金额
+	MaxNotional   string `json:"max_notional,optional"` //最大下单金额
+	OpenTime      int64  `json:"open_time,optional"`    //开盘时间 单位秒,开盘之前为集合竞价
 }
 
 type UpdateSymbolResp struct {
@@ -174,9 +195,77 @@ type SubMatchInfo struct {
 	MatchQty    string `json:"match_qty"`
 	MatchAmount string `json:"match_amount"`
 	MatchTime   int64  `json:"match_time"`
+	TakerFee    string `json:"taker_fee"`
+	MakerFee    string `json:"maker_fee"`
 }
 
 type GetMatchListResp struct {
 	List  []*MatchInfo `json:"list"`
 	Total int64        `json:"total"`
 }
+
+type EngineSymbolReq struct {
+	Symbol string `form:"symbol"` //交易对
+}
+
+type EngineOrder struct {
+	Id             int64  `json:"id"`              //主键id
+	OrderId        string `json:"order_id"`        //订单号
+	Uid            int64  `json:"uid"`             //用户id
+	Side           int32  `json:"side"`            //方向 1买 2卖
+	OrderType      int32  `json:"order_type"`      //订单类型
+	OrderStatus    int32  `json:"order_status"`    //订单状态
+	Price          string `json:"price"`           //价格
+	Qty            string `json:"qty"`             //数量
+	Amount         string `json:"amount"`          //金额
+	FilledQty      string `json:"filled_qty"`      //已成交数量
+	FilledAmount   string `json:"filled_amount"`   //已成交金额
+	UnfilledQty    string `json:"unfilled_qty"`    //未成交数量
+	UnfilledAmount string `json:"unfilled_amount"` //未成交金额
+	Fee            string `json:"fee"`             //累计的手续费
+	TriggerPrice   string `json:"trigger_price"`   //触发价格
+	TriggerStatus  int32  `json:"trigger_status"`  //条件单触发状态
+	DisplayQty     string `json:"display_qty"`     //冰山单每次显示的数量
+	VisibleQty     string `json:"visible_qty"`     //冰山单当前显示的剩余数量
+	PostOnly       bool   `json:"post_only"`       //是否只做maker
+	StpMode        int32  `json:"stp_mode"`        //自成交保护模式
+	ExpireTime     int64  `json:"expire_time"`     //过期时间 单位秒
+	QueueId        int64  `json:"queue_id"`        //重新排队的序号
+}
+
+type GetOrderBookResp struct {
+	Version  int64          `json:"version"`  //订单簿版本
+	Asks     []*EngineOrder `json:"asks"`     //卖盘
+	Bids     []*EngineOrder `json:"bids"`     //买盘
+	Triggers []*EngineOrder `json:"triggers"` //未触发的条件单
+}
+
+type GetEngineOrderReq struct {
+	Symbol string `form:"symbol"` //交易对
+	Id     int64  `form:"id"`     //主键id
+}
+
+type GetEngineStatsResp struct {
+	CurrentSeqId   int64  `json:"current_seq_id"`   //订单簿版本
+	ResultSeq      int64  `json:"result_seq"`       //撮合结果的序号
+	AskOrders      int64  `json:"ask_orders"`       //卖盘订单数量
+	BidOrders      int64  `json:"bid_orders"`       //买盘订单数量
+	TriggerOrders  int64  `json:"trigger_orders"`   //未触发的条件单数量
+	AskLevels      int64  `json:"ask_levels"`       //卖盘档位数量
+	BidLevels      int64  `json:"bid_levels"`       //买盘档位数量
+	BestBid        string `json:"best_bid"`         //买一价
+	BestAsk        string `json:"best_ask"`         //卖一价
+	LastPrice      string `json:"last_price"`       //最新成交价
+	Halted         bool   `json:"halted"`           //是否人工暂停交易
+	HaltUntil      int64  `json:"halt_until"`       //熔断恢复交易的时间 单位纳秒
+	AuctionUntil   int64  `json:"auction_until"`    //集合竞价结束的时间 单位纳秒
+	TickQueue      int64  `json:"tick_queue"`       //等待推送的成交
+	DepthQueue     int64  `json:"depth_queue"`      //等待更新的深度
+	DepthPushQueue int64  `json:"depth_push_queue"` //等待推送的深度
+	Leader         bool   `json:"leader"`           //是否是主节点
+}
+
+type HaltTradingReq struct {
+	Symbol string `json:"symbol"` //交易对
+	Halted bool   `json:"halted"` //true暂停交易 false恢复交易
+}
@@ -41,6 +41,8 @@ func newMatchedOrder(db *gorm.DB, opts ...gen.DOOption) matchedOrder {
 	_matchedOrder.MatchTime = field.NewInt64(tableName, "match_time")
 	_matchedOrder.CreatedAt = field.NewInt64(tableName, "created_at")
 	_matchedOrder.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_matchedOrder.TakerFee = field.NewString(tableName, "taker_fee")
+	_matchedOrder.MakerFee = field.NewString(tableName, "maker_fee")
 
 	_matchedOrder.fillFieldMap()
 
@@ -65,6 +67,8 @@ type matchedOrder struct {
 	MatchTime    field.Int64  // 撮合时间
 	CreatedAt    field.Int64  // 创建时间
 	UpdatedAt    field.Int64  // 修改时间
+	TakerFee     field.String // taker手续费 taker买为基础币 taker卖为计价币
+	MakerFee     field.String // maker手续费 maker买为基础币 maker卖为计价币
 
 	fieldMap map[string]field.Expr
 }
@@ -95,6 +99,8 @@ func (m *matchedOrder) updateTableName(table string) *matchedOrder {
 	m.MatchTime = field.NewInt64(table, "match_time")
 	m.CreatedAt = field.NewInt64(table, "created_at")
 	m.UpdatedAt = field.NewInt64(table, "updated_at")
+	m.TakerFee = field.NewString(table, "taker_fee")
+	m.MakerFee = field.NewString(table, "maker_fee")
 
 	m.fillFieldMap()
 
@@ -123,7 +129,7 @@ func (m *matchedOrder) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (m *matchedOrder) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 14)
+	m.fieldMap = make(map[string]field.Expr, 16)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["match_id"] = m.MatchID
 	m.fieldMap["match_sub_id"] = m.MatchSubID
@@ -138,6 +144,8 @@ func (m *matchedOrder) fillFieldMap() {
 	m.fieldMap["match_time"] = m.MatchTime
 	m.fieldMap["created_at"] = m.CreatedAt
 	m.fieldMap["updated_at"] = m.UpdatedAt
+	m.fieldMap["taker_fee"] = m.TakerFee
+	m.fieldMap["maker_fee"] = m.MakerFee
 }
 
 func (m matchedOrder) clone(db *gorm.DB) matchedOrder {
@@ -41,6 +41,8 @@ func (l *StoreMatchResultLogic) StoreMatchResult(result *matchMq.MatchResp_Match
 				Amount:       v.Amount,
 				MatchTime:    result.MatchResult.MatchTime,
 				TakerIsBuyer: f,
+				TakerFee:     v.TakerFee,
+				MakerFee:     v.MakerFee,
 			}
 			//重复消费也问题不大
 			if err := tx.WithContext(context.Background()).MatchedOrder.Create(mr); err != nil {
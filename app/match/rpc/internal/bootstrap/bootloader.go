@@ -2,22 +2,61 @@ package bootstrap
 
 import (
 	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/luxun9527/gex/app/match/rpc/internal/consumer"
 	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
 	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
 	"github.com/luxun9527/gex/app/order/rpc/orderservice"
 	"github.com/luxun9527/gex/common/proto/enum"
 	"github.com/luxun9527/gex/common/utils"
+	logger "github.com/luxun9527/zlog"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/metadata"
 )
 
+// Start 启动etcd中的交易对，之后根据交易对配置的变化启动和停止撮合引擎
 func Start(sc *svc.ServiceContext) {
-	loadOrder(sc)
-	consumer.InitMatchConsumer(sc)
+	newEngineManager(sc).watch()
 }
-func loadOrder(sc *svc.ServiceContext) {
-	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("symbol", sc.Config.Symbol))
+
+// startSymbol 启动一个交易对的撮合引擎
+func startSymbol(sc *svc.ServiceContext, s *svc.SymbolContext) {
+	//优先从快照恢复，没有快照则从订单服务加载所有未完成的订单
+	if !s.Config.SnapshotConf.Enable || !loadSnapshot(s) {
+		loadOrder(sc, s)
+	}
+	consumer.InitMatchConsumer(s)
+}
+
+// 加载最新的快照，并将订阅重置到快照中记录的消息，只重放快照之后的消息。
+func loadSnapshot(sc *svc.SymbolContext) bool {
+	path := engine.SnapshotPath(sc.Config.SnapshotConf.Dir, sc.Config.Symbol)
+	snapshot, err := engine.LoadSnapshot(path)
+	if err != nil {
+		logx.Errorw("load snapshot failed", logger.ErrorField(err), logx.Field("path", path))
+		return false
+	}
+	if snapshot == nil {
+		logx.Infow("snapshot not found", logx.Field("path", path))
+		return false
+	}
+	messageID, err := pulsar.DeserializeMessageID(snapshot.MessageId)
+	if err != nil {
+		logx.Errorw("deserialize snapshot message id failed", logger.ErrorField(err))
+		return false
+	}
+	if err := sc.MatchConsumer.Seek(messageID); err != nil {
+		logx.Errorw("seek match source consumer failed", logger.ErrorField(err), logx.Field("messageID", messageID.String()))
+		return false
+	}
+	sc.MatchEngine.RestoreSnapshot(snapshot)
+	sc.SnapshotMessageID = messageID
+	logx.Infow("load snapshot success", logx.Field("path", path), logx.Field("messageID", messageID.String()),
+		logx.Field("asks", len(snapshot.Asks)), logx.Field("bids", len(snapshot.Bids)), logx.Field("createdAt", snapshot.CreatedAt))
+	return true
+}
+func loadOrder(sc *svc.ServiceContext, s *svc.SymbolContext) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("symbol", s.Config.Symbol))
 	stream, err := sc.OrderClient.GetOrderAllPendingOrder(ctx, &orderservice.OrderEmpty{})
 	if err != nil {
 		logx.Severef("call GetOrderAllPendingOrder failed %v", err)
@@ -33,26 +72,48 @@ func loadOrder(sc *svc.ServiceContext) {
 			logx.Severef("read order from order service failed err = %v", err)
 		}
 		logx.Infow("init load order", logx.Field("order", order))
+		var p utils.FixedParser
 		o := &engine.Order{
 			Uid:            order.Uid,
 			OrderID:        order.OrderId,
 			SequenceId:     order.SequenceId,
 			CreateTime:     0,
 			IsCancel:       false,
-			Price:          utils.NewFromStringMaxPrec(order.Price),
-			Qty:            utils.NewFromStringMaxPrec(order.Qty),
+			Price:          p.Parse(order.Price),
+			Qty:            p.Parse(order.Qty),
 			OrderType:      order.OrderType,
-			Amount:         utils.NewFromStringMaxPrec(order.Amount),
+			Amount:         p.Parse(order.Amount),
 			Side:           order.Side,
 			OrderStatus:    enum.OrderStatus_NewCreated,
-			UnfilledQty:    utils.NewFromStringMaxPrec(order.UnFilledQty),
-			FilledQty:      utils.DecimalZeroMaxPrec,
-			UnfilledAmount: utils.NewFromStringMaxPrec(order.UnFilledAmount),
+			UnfilledQty:    p.Parse(order.UnFilledQty),
+			FilledQty:      utils.Fixed{},
+			UnfilledAmount: p.Parse(order.UnFilledAmount),
+			PostOnly:       order.PostOnly,
+			TriggerStatus:  order.TriggerStatus,
+			STPMode:        order.StpMode,
+			MakerFeeRate:   p.Parse(order.MakerFeeRate),
+			TakerFeeRate:   p.Parse(order.TakerFeeRate),
+			Fee:            p.Parse(order.Fee),
+			DisplayQty:     p.Parse(order.DisplayQty),
+			VisibleQty:     utils.Fixed{},
+			ExpireTime:     order.ExpireTime,
+			MaxSlippage:    p.Parse(order.MaxSlippage),
+			ProtectPrice:   p.Parse(order.ProtectionPrice),
+			QueueId:        order.QueueId,
+		}
+		if order.TriggerStatus != enum.TriggerStatus_UnknownTriggerStatus {
+			o.TriggerPrice = p.Parse(order.TriggerPrice)
 		}
+		//订单服务中按照金额的市价买单数量为0
+		o.ByQty = o.OrderType == enum.OrderType_MO && o.Side == enum.Side_Buy && o.Qty.IsPositive()
 		if order.SequenceId > maxOrderPrimary {
 			maxOrderPrimary = order.SequenceId
 		}
-		sc.MatchEngine.HandleOrder(o)
+		if p.Err != nil {
+			logx.Severef("invalid pending order sequenceId = %v err = %v", order.SequenceId, p.Err)
+			continue
+		}
+		s.MatchEngine.ReloadOrder(o)
 
 	}
 }
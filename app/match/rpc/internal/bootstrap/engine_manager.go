@@ -0,0 +1,211 @@
+package bootstrap
+
+import (
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/common/pkg/confx"
+	"github.com/luxun9527/gex/common/pkg/etcd"
+	"github.com/luxun9527/gex/common/proto/define"
+	logger "github.com/luxun9527/zlog"
+	"github.com/yitter/idgenerator-go/idgen"
+	"github.com/zeromicro/go-zero/core/logx"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/attributes"
+	"gopkg.in/yaml.v3"
+	"strings"
+	"time"
+)
+
+// engineManager 一个进程运行多个交易对的撮合引擎。
+// 监听etcd中Symbol/开头的交易对配置，新增交易对时启动撮合引擎并注册到etcd，删除交易对时取消注册并停止撮合引擎，
+// 修改配置时更新运行中的交易对配置。每个交易对注册的key为matchRpc/交易对，元数据带有交易对，symbol_lb按照交易对选择连接。
+// 交易对的启动和停止都在run协程中执行，symbols只在这个协程中访问。
+type engineManager struct {
+	sc *svc.ServiceContext
+	//运行中的交易对配置，etcd中的配置修改之后更新
+	symbols      map[string]*define.SymbolInfo
+	idGenInitted bool
+	events       chan symbolEvent
+}
+
+// symbolEvent 交易对配置的变化或者主节点租约失效
+type symbolEvent struct {
+	ev   *clientv3.Event
+	lost *svc.SymbolContext
+}
+
+func newEngineManager(sc *svc.ServiceContext) *engineManager {
+	return &engineManager{
+		sc:      sc,
+		symbols: make(map[string]*define.SymbolInfo),
+		events:  make(chan symbolEvent),
+	}
+}
+
+// watch 启动etcd中已有的交易对，之后监听交易对的变化，交由run协程处理
+func (m *engineManager) watch() {
+	confx.MustLoadFromEtcd(define.EtcdSymbolPrefix, m.sc.Config.SymbolEtcdConfig, nil, confx.WithCustomInitLoadFunc(func(kvs []*mvccpb.KeyValue, target any) {
+		for _, v := range kvs {
+			m.put(v)
+		}
+	}), confx.WithCustomWatchFunc(func(evs []*clientv3.Event, target any) {
+		for _, v := range evs {
+			m.events <- symbolEvent{ev: v}
+		}
+	}))
+	go m.run()
+}
+
+// run 依次处理交易对配置的变化和主节点租约失效
+func (m *engineManager) run() {
+	for e := range m.events {
+		if e.lost != nil {
+			m.restart(e.lost)
+			continue
+		}
+		switch e.ev.Type {
+		case mvccpb.PUT: //修改或者新增
+			m.put(e.ev.Kv)
+		case mvccpb.DELETE: //删除
+			m.stop(strings.TrimPrefix(string(e.ev.Kv.Key), define.EtcdSymbolPrefix))
+		}
+	}
+}
+
+// put 交易对新增或者修改
+func (m *engineManager) put(kv *mvccpb.KeyValue) {
+	symbol := strings.TrimPrefix(string(kv.Key), define.EtcdSymbolPrefix)
+	if !m.sc.Config.HostSymbol(symbol) {
+		return
+	}
+	//运行中的交易对只更新配置，最大下单金额超出撮合引擎金额的范围则保留原来的配置
+	if symbolInfo, ok := m.symbols[symbol]; ok {
+		var changed define.SymbolInfo
+		if err := yaml.Unmarshal(kv.Value, &changed); err != nil {
+			logx.Errorw("unmarshal symbol config failed", logger.ErrorField(err), logx.Field("symbol", symbol))
+			return
+		}
+		if err := define.CheckMaxNotional(changed.BaseCoinPrecValue, changed.QuoteCoinPrecValue, changed.MaxNotionalValue); err != nil {
+			logx.Errorw("invalid symbol config", logger.ErrorField(err), logx.Field("symbol", symbol))
+			return
+		}
+		if err := yaml.Unmarshal(kv.Value, symbolInfo); err != nil {
+			logx.Errorw("unmarshal symbol config failed", logger.ErrorField(err), logx.Field("symbol", symbol))
+			return
+		}
+		symbolInfo.StoreValues()
+		logx.Infow("symbol config changed", logx.Field("symbol", symbolInfo))
+		return
+	}
+	var symbolInfo define.SymbolInfo
+	if err := yaml.Unmarshal(kv.Value, &symbolInfo); err != nil {
+		logx.Errorw("unmarshal symbol config failed", logger.ErrorField(err), logx.Field("symbol", symbol))
+		return
+	}
+	if symbolInfo.SymbolName != symbol || symbolInfo.BaseCoinPrecValue <= 0 || symbolInfo.QuoteCoinPrecValue <= 0 {
+		logx.Errorw("invalid symbol config", logx.Field("symbol", symbol), logx.Field("config", &symbolInfo))
+		return
+	}
+	if err := define.CheckMaxNotional(symbolInfo.BaseCoinPrecValue, symbolInfo.QuoteCoinPrecValue, symbolInfo.MaxNotionalValue); err != nil {
+		logx.Errorw("invalid symbol config", logger.ErrorField(err), logx.Field("symbol", symbol))
+		return
+	}
+	symbolInfo.StoreValues()
+	m.start(&symbolInfo)
+}
+
+// start 启动交易对的撮合引擎，加载完订单之后注册到etcd
+func (m *engineManager) start(symbolInfo *define.SymbolInfo) {
+	m.initIdGenerator(symbolInfo)
+	s, err := m.sc.NewSymbolContext(symbolInfo)
+	if err != nil {
+		logx.Errorw("start symbol failed", logger.ErrorField(err), logx.Field("symbol", symbolInfo.SymbolName))
+		return
+	}
+	startSymbol(m.sc, s)
+	m.sc.StoreSymbolContext(s)
+	m.symbols[symbolInfo.SymbolName] = symbolInfo
+
+	conf := m.sc.Config.EtcdRegisterConf
+	conf.Key += "/" + symbolInfo.SymbolName
+	conf.MetaData = attributes.New("symbol", symbolInfo.SymbolName)
+	//主备部署时只有主节点注册，查询深度等请求只路由到主节点
+	if s.Standby != nil {
+		go m.campaign(s, conf)
+	} else {
+		etcd.RegisterWithContext(s.Ctx, conf)
+	}
+	logx.Infow("symbol started", logx.Field("symbol", symbolInfo.SymbolName))
+}
+
+// campaign 主备部署时选主，成为主节点之后切换并注册到etcd。
+// 租约失效之后立即停止这个交易对，避免两个主节点同时发送撮合结果，之后由run协程作为备节点重启。
+func (m *engineManager) campaign(s *svc.SymbolContext, conf etcd.EtcdRegisterConf) {
+	electionConf := etcd.ElectionConf{
+		EtcdConf: m.sc.Config.SymbolEtcdConfig,
+		Key:      "matchLeader/" + s.Config.Symbol,
+		Value:    s.Config.StandbyConf.Name,
+		TTL:      s.Config.StandbyConf.TTL,
+	}
+	var lost <-chan struct{}
+	for {
+		var err error
+		if lost, err = etcd.Campaign(s.Ctx, electionConf); err == nil {
+			break
+		}
+		if s.Ctx.Err() != nil {
+			return
+		}
+		logx.Errorw("campaign failed", logger.ErrorField(err), logx.Field("symbol", s.Config.Symbol))
+		time.Sleep(5 * time.Second)
+	}
+	s.Standby.Elect()
+	etcd.RegisterWithContext(s.Ctx, conf)
+	<-lost
+	if s.Ctx.Err() != nil {
+		return
+	}
+	logx.Errorw("leader lease lost, symbol stopped", logx.Field("symbol", s.Config.Symbol))
+	s.Stop()
+	m.events <- symbolEvent{lost: s}
+}
+
+// restart 主节点租约失效之后，等待撮合引擎关闭，作为备节点重新启动。
+// 交易对已经删除或者已经重启时不处理。
+func (m *engineManager) restart(s *svc.SymbolContext) {
+	symbol := s.Config.Symbol
+	if current, ok := m.sc.GetSymbolContext(symbol); !ok || current != s {
+		return
+	}
+	m.sc.DeleteSymbolContext(symbol)
+	symbolInfo := m.symbols[symbol]
+	delete(m.symbols, symbol)
+	//消费消息的协程处理完当前的消息之后关闭，之后才能重新订阅match_source
+	<-s.Closed()
+	logx.Infow("restart symbol as standby", logx.Field("symbol", symbol))
+	m.start(symbolInfo)
+}
+
+// stop 停止交易对的撮合引擎，取消注册之后新的请求不会路由到这个实例
+func (m *engineManager) stop(symbol string) {
+	s, ok := m.sc.DeleteSymbolContext(symbol)
+	if !ok {
+		return
+	}
+	delete(m.symbols, symbol)
+	s.Stop()
+	logx.Infow("symbol stopped", logx.Field("symbol", symbol))
+}
+
+// initIdGenerator 撮合id使用雪花算法，没有配置workId时使用第一个交易对的id
+func (m *engineManager) initIdGenerator(symbolInfo *define.SymbolInfo) {
+	if m.idGenInitted {
+		return
+	}
+	workerId := m.sc.Config.WorkerID
+	if workerId == 0 {
+		workerId = uint16(symbolInfo.SymbolID)
+	}
+	idgen.SetIdGenerator(idgen.NewIdGeneratorOptions(workerId % 64))
+	m.idGenInitted = true
+}
@@ -16,10 +16,51 @@ type Config struct {
 	LoggerConfig     logger.Config
 	GormConf         commongorm.GormConf
 	WsConf           zrpc.RpcClientConf
-	Symbol           string
+	Symbol           string   `json:",optional"` //只运行一个交易对，兼容之前一个实例一个交易对的部署
+	Symbols          []string `json:",optional"` //运行的交易对，和Symbol都为空则运行etcd中所有的交易对
+	WorkerID         uint16   `json:",optional"` //雪花算法的workId，为零时使用第一个交易对的id，多个实例需要配置不同的值
 	OrderRpcConf     zrpc.RpcClientConf
 	RedisConf        redis.RedisConf
 	SymbolEtcdConfig etcd.EtcdConfig
 	SymbolInfo       *define.SymbolInfo    `json:",optional"`
 	EtcdRegisterConf etcd.EtcdRegisterConf `json:",optional"`
+	SnapshotConf     SnapshotConf          `json:",optional"`
+	DepthGroups      []string              `json:",optional"` //合并深度的档位,例如0.01 0.1 1 10,每个档位推送到depth@交易对@档位
+	StandbyConf      StandbyConf           `json:",optional"`
+}
+
+// HostSymbol 是否运行这个交易对
+func (c *Config) HostSymbol(symbol string) bool {
+	if c.Symbol == "" && len(c.Symbols) == 0 {
+		return true
+	}
+	if c.Symbol == symbol {
+		return true
+	}
+	for _, v := range c.Symbols {
+		if v == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// SnapshotConf 订单簿快照配置
+type SnapshotConf struct {
+	//是否开启快照，开启后重启时从最新的快照恢复订单簿，只重放快照之后的消息
+	Enable bool `json:",optional"`
+	//快照存放目录
+	Dir string `json:",default=data/snapshot"`
+	//快照间隔 单位秒
+	Interval int64 `json:",default=60"`
+}
+
+// StandbyConf 主备部署配置
+type StandbyConf struct {
+	//是否开启主备，同一个交易对运行两个实例，通过etcd选主，只有主节点发送撮合结果和推送行情
+	Enable bool `json:",optional"`
+	//实例名称，每个实例不同，每个实例用自己的订阅消费match_source
+	Name string `json:",optional"`
+	//选主的租约时间 单位秒，主节点故障之后最多经过这个时间备节点成为主节点
+	TTL int `json:",default=10"`
 }
@@ -2,71 +2,183 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
 	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
-	"github.com/luxun9527/gex/common/proto/enum"
 	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
-	"github.com/luxun9527/gex/common/utils"
 	logger "github.com/luxun9527/zlog"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/protobuf/proto"
+	"time"
 )
 
-func InitMatchConsumer(sc *svc.ServiceContext) {
-	ctx := context.Background()
+// InitMatchConsumer 消费交易对的撮合消息，交易对下线之后退出并关闭撮合引擎
+func InitMatchConsumer(sc *svc.SymbolContext) {
+	var writer *snapshotWriter
+	if sc.Config.SnapshotConf.Enable {
+		writer = newSnapshotWriter(sc)
+	}
 	go func() {
+		defer func() {
+			if writer != nil {
+				writer.close()
+			}
+			sc.Close()
+			logx.Infow("match consumer stopped", logx.Field("symbol", sc.Config.Symbol))
+		}()
+		lastSnapshotTime := time.Now()
+		var timer timerSender
 		for {
-			message, err := sc.MatchConsumer.Receive(ctx)
+			//备节点选主成功之后切换为主节点
+			if st := sc.Standby; st != nil && !st.Sink.Leader() && st.FollowCtx.Err() != nil && sc.Ctx.Err() == nil {
+				promote(sc)
+			}
+			message, err := receive(sc, &timer)
 			if err != nil {
+				if sc.Ctx.Err() != nil {
+					return
+				}
+				//集合竞价或者限时单到时间了，下一次循环的时候发送定时消息，选主成功之后下一次循环的时候切换
+				if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+					continue
+				}
 				logx.Errorw("receive message fail", logger.ErrorField(err))
 				continue
 			}
-			var matchReq matchMq.MatchReq
-			if err := proto.Unmarshal(message.Payload(), &matchReq); err != nil {
-				logx.Errorw("unmarshal message fail", logger.ErrorField(err))
+			//快照中已经处理过的消息
+			if sc.SnapshotMessageID != nil && sameMessageID(message.ID(), sc.SnapshotMessageID) {
+				if err := sc.MatchConsumer.Ack(message); err != nil {
+					logx.Errorw("consumer message failed", logger.ErrorField(err))
+				}
 				continue
 			}
-			logx.Infow("receive message failed", logx.Field("data", &matchReq))
-			switch operate := matchReq.Operate.(type) {
-			case *matchMq.MatchReq_NewOrder:
-				if operate.NewOrder.SequenceId <= sc.InitOrderPrimaryID {
-					logx.Sloww("receive invalid order ", logx.Field("currentSequenceId", operate.NewOrder.SequenceId), logx.Field("InitOrderPrimaryID", sc.InitOrderPrimaryID))
-					continue
-				}
-				order := &engine.Order{
-					Uid:            operate.NewOrder.Uid,
-					OrderID:        operate.NewOrder.OrderId,
-					SequenceId:     operate.NewOrder.SequenceId,
-					CreateTime:     0,
-					IsCancel:       false,
-					Price:          utils.NewFromStringMaxPrec(operate.NewOrder.Price),
-					Qty:            utils.NewFromStringMaxPrec(operate.NewOrder.Qty),
-					OrderType:      operate.NewOrder.OrderType,
-					Amount:         utils.NewFromStringMaxPrec(operate.NewOrder.Amount),
-					Side:           operate.NewOrder.Side,
-					OrderStatus:    enum.OrderStatus_NewCreated,
-					UnfilledQty:    utils.NewFromStringMaxPrec(operate.NewOrder.Qty),
-					FilledQty:      utils.DecimalZeroMaxPrec,
-					UnfilledAmount: utils.NewFromStringMaxPrec(operate.NewOrder.Amount),
-					FilledAmount:   utils.DecimalZeroMaxPrec,
-				}
-				sc.MatchEngine.HandleOrder(order)
-			case *matchMq.MatchReq_Cancel:
-				order := &engine.Order{
-					OrderID:    "",
-					SequenceId: operate.Cancel.Id,
-					CreateTime: 0,
-					IsCancel:   true,
-					Side:       operate.Cancel.Side,
-					Uid:        0,
-					OrderType:  operate.Cancel.OrderType,
-					Price:      utils.NewFromStringMaxPrec(operate.Cancel.Price),
-				}
-				sc.MatchEngine.HandleOrder(order)
+			if !handleMessage(sc, message) {
+				continue
 			}
 			if err := sc.MatchConsumer.Ack(message); err != nil {
 				logx.Errorw("consumer message failed", logger.ErrorField(err))
 			}
+			//备节点跟随主节点发送的撮合结果
+			if sc.Standby != nil && !sc.Standby.Sink.Leader() {
+				follow(sc)
+			}
+			//定时生成订单簿快照
+			if writer != nil && time.Since(lastSnapshotTime) >= time.Duration(sc.Config.SnapshotConf.Interval)*time.Second {
+				writer.take(sc, message.ID())
+				lastSnapshotTime = time.Now()
+			}
 		}
 	}()
 }
+
+// handleMessage 撮合引擎的时间推进到消息的发送时间之后处理消息，无效的消息返回false。
+// 撮合引擎不使用本地的时间，主备节点处理相同的消息得到相同的结果。
+func handleMessage(sc *svc.SymbolContext, message pulsar.Message) bool {
+	var matchReq matchMq.MatchReq
+	if err := proto.Unmarshal(message.Payload(), &matchReq); err != nil {
+		logx.Errorw("unmarshal message fail", logger.ErrorField(err))
+		return false
+	}
+	logx.Debugw("receive match request", logx.Field("data", &matchReq))
+	if sc.Standby != nil {
+		sc.Standby.Sink.Begin(message.ID())
+	}
+	sc.EngineLock.Lock()
+	defer sc.EngineLock.Unlock()
+	sc.AdvanceClock(message.PublishTime())
+	return handleMatchReq(sc, &matchReq)
+}
+
+// handleMatchReq 撮合引擎处理一条消息，无效的消息返回false
+func handleMatchReq(sc *svc.SymbolContext, matchReq *matchMq.MatchReq) bool {
+	switch operate := matchReq.Operate.(type) {
+	case *matchMq.MatchReq_NewOrder:
+		if operate.NewOrder.SequenceId <= sc.InitOrderPrimaryID {
+			logx.Sloww("receive invalid order ", logx.Field("currentSequenceId", operate.NewOrder.SequenceId), logx.Field("InitOrderPrimaryID", sc.InitOrderPrimaryID))
+			return false
+		}
+		order, err := engine.NewOrderFromOperate(operate.NewOrder)
+		if err != nil {
+			logx.Errorw("invalid new order", logger.ErrorField(err), logx.Field("data", operate.NewOrder))
+			return false
+		}
+		sc.MatchEngine.HandleOrder(order)
+	case *matchMq.MatchReq_Cancel:
+		order := engine.NewCancelOrderFromOperate(operate.Cancel)
+		sc.MatchEngine.HandleOrder(order)
+	case *matchMq.MatchReq_Amend:
+		amend, err := engine.NewAmendOrderFromOperate(operate.Amend)
+		if err != nil {
+			logx.Errorw("invalid amend order", logger.ErrorField(err), logx.Field("data", operate.Amend))
+			return false
+		}
+		sc.MatchEngine.HandleAmend(amend)
+	case *matchMq.MatchReq_CancelAll:
+		sc.MatchEngine.HandleCancelAll(engine.NewCancelAllFromOperate(operate.CancelAll))
+	case *matchMq.MatchReq_Halt:
+		sc.MatchEngine.HandleHalt(engine.NewHaltFromOperate(operate.Halt))
+	case *matchMq.MatchReq_Timer:
+		sc.AdvanceClock(time.Unix(0, operate.Timer.Time))
+		sc.MatchEngine.HandleTimer()
+	}
+	return true
+}
+
+// receive 接收撮合消息。主节点集合竞价期间最多等到集合竞价结束，有限时单时最多等到下一个订单过期，
+// 到时间之后发送定时消息到match_source，没有新的消息也能按时处理。备节点只处理收到的消息，不按照本地的时间处理。
+func receive(sc *svc.SymbolContext, timer *timerSender) (pulsar.Message, error) {
+	//备节点选主成功之后立即返回
+	baseCtx := sc.Ctx
+	var wait time.Duration
+	if sc.Standby != nil && !sc.Standby.Sink.Leader() {
+		baseCtx = sc.Standby.FollowCtx
+	} else {
+		sc.EngineLock.Lock()
+		next := sc.MatchEngine.NextTimer()
+		sc.EngineLock.Unlock()
+		wait = timer.check(sc, next, time.Now())
+	}
+	if wait <= 0 {
+		return sc.MatchConsumer.Receive(baseCtx)
+	}
+	ctx, cancel := context.WithTimeout(baseCtx, wait)
+	defer cancel()
+	return sc.MatchConsumer.Receive(ctx)
+}
+
+// timerSender 主节点发送定时消息，同一个到期时间只发送一次
+type timerSender struct {
+	sent time.Time
+}
+
+// due 返回距离到期的时间，到期之后需要发送定时消息返回true，没有到期时间或者已经发送过返回0
+func (t *timerSender) due(next, now time.Time) (time.Duration, bool) {
+	if next.IsZero() || next.Equal(t.sent) {
+		return 0, false
+	}
+	if wait := next.Sub(now); wait > 0 {
+		return wait, false
+	}
+	return 0, true
+}
+
+// check 到期之后发送定时消息，返回接收消息最多等待的时间，发送失败之后一秒后重试
+func (t *timerSender) check(sc *svc.SymbolContext, next, now time.Time) time.Duration {
+	wait, send := t.due(next, now)
+	if !send {
+		return wait
+	}
+	timerReq := &matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_Timer{
+			Timer: &matchMq.TimerOperate{Time: next.UnixNano()},
+		},
+	}
+	data, _ := proto.Marshal(timerReq)
+	if _, err := sc.SourceProducer.Send(sc.Ctx, &pulsar.ProducerMessage{Payload: data}); err != nil {
+		logx.Errorw("send timer message failed", logger.ErrorField(err), logx.Field("symbol", sc.Config.Symbol))
+		return time.Second
+	}
+	t.sent = next
+	return 0
+}
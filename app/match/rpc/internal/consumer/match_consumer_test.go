@@ -0,0 +1,228 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/app/match/rpc/internal/config"
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/common/proto/define"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// testMessage match_source的消息，只实现处理消息用到的方法
+type testMessage struct {
+	pulsar.Message
+	id          pulsar.MessageID
+	payload     []byte
+	publishTime time.Time
+}
+
+func (m *testMessage) ID() pulsar.MessageID {
+	return m.id
+}
+
+func (m *testMessage) Payload() []byte {
+	return m.payload
+}
+
+func (m *testMessage) PublishTime() time.Time {
+	return m.publishTime
+}
+
+func newTestMessage(req *matchMq.MatchReq, publishTime time.Time) *testMessage {
+	data, _ := proto.Marshal(req)
+	return &testMessage{payload: data, publishTime: publishTime}
+}
+
+// 创建一个节点的交易对，撮合引擎使用消息的时间，撮合id从1开始
+func newTestSymbolContext(start time.Time) (*svc.SymbolContext, *engine.MemoryResultSink) {
+	symbolInfo := &define.SymbolInfo{
+		SymbolID:           1,
+		SymbolName:         "BTC_USDT",
+		BaseCoinID:         1,
+		QuoteCoinID:        2,
+		BaseCoinPrecValue:  4,
+		QuoteCoinPrecValue: 4,
+	}
+	s := &svc.SymbolContext{
+		Config: &config.Config{Symbol: "BTC_USDT", SymbolInfo: symbolInfo},
+		Ctx:    context.Background(),
+	}
+	s.AdvanceClock(start)
+	var id int64
+	results := engine.NewMemoryResultSink()
+	s.MatchEngine = engine.NewMatchEngine(s.Config, results, engine.NewMemoryMarketDataSink(),
+		engine.WithClock(s.Clock),
+		engine.WithIdGenerator(func() int64 {
+			id++
+			return id
+		}),
+	)
+	return s, results
+}
+
+func newOrderReq(id int64, price, qty string, side enum.Side, expireTime int64) *matchMq.MatchReq {
+	amount := utils.RequireFixedFromString(price).Mul(utils.RequireFixedFromString(qty)).String()
+	return &matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_NewOrder{
+			NewOrder: &matchMq.NewOrderOperate{
+				SequenceId: id,
+				Side:       side,
+				Price:      price,
+				Qty:        qty,
+				Amount:     amount,
+				OrderType:  enum.OrderType_LO,
+				ExpireTime: expireTime,
+			},
+		},
+	}
+}
+
+// 测试主备节点的系统时间不同，处理相同的消息得到相同的撮合结果
+func TestHandleMessageClock(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	// 备节点的系统时间比主节点快一个小时
+	leader, leaderResults := newTestSymbolContext(base)
+	standby, standbyResults := newTestSymbolContext(base)
+	leaderNow, standbyNow := base, base.Add(time.Hour)
+	deliver := func(req *matchMq.MatchReq, publishTime time.Time) {
+		msg := newTestMessage(req, publishTime)
+		assert.True(t, handleMessage(leader, msg))
+		assert.True(t, handleMessage(standby, msg))
+	}
+
+	// 卖单5秒之后过期，部分成交
+	deliver(newOrderReq(1, "100", "2", enum.Side_Sell, base.Add(5*time.Second).Unix()), base)
+	deliver(newOrderReq(2, "100", "1", enum.Side_Buy, 0), base.Add(time.Second))
+
+	// 备节点的系统时间已经超过过期时间，不按照本地的时间撤销订单
+	leaderNow = leaderNow.Add(2 * time.Second)
+	standbyNow = standbyNow.Add(2 * time.Second)
+	assert.True(t, standbyNow.After(standby.MatchEngine.NextTimer()))
+	assert.Len(t, standbyResults.Results(), 1)
+
+	// 主节点还没有到过期时间，等待3秒
+	var timer timerSender
+	next := leader.MatchEngine.NextTimer()
+	wait, send := timer.due(next, leaderNow)
+	assert.Equal(t, 3*time.Second, wait)
+	assert.False(t, send)
+
+	// 主节点到时间之后发送定时消息，消息的发送时间晚于到期时间，主备节点都按照定时消息撤销
+	leaderNow = leaderNow.Add(3 * time.Second)
+	_, send = timer.due(next, leaderNow)
+	assert.True(t, send)
+	timer.sent = next
+	deliver(&matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_Timer{Timer: &matchMq.TimerOperate{Time: next.UnixNano()}},
+	}, leaderNow.Add(10*time.Millisecond))
+	// 同一个到期时间只发送一次
+	_, send = timer.due(next, leaderNow.Add(time.Second))
+	assert.False(t, send)
+	assert.True(t, leader.MatchEngine.NextTimer().IsZero())
+
+	got, want := standbyResults.Results(), leaderResults.Results()
+	if assert.Len(t, want, 2) && assert.Len(t, got, 2) {
+		assert.Equal(t, base.Add(time.Second).UnixNano(), want[0].GetMatchResult().GetMatchTime())
+		assert.True(t, want[1].GetCancel().GetExpired())
+		for i := range want {
+			assert.True(t, proto.Equal(want[i], got[i]), "result %v leader %v standby %v", i, want[i], got[i])
+		}
+	}
+}
+
+// testReader match_result的reader，依次返回主节点发送的撮合结果
+type testReader struct {
+	pulsar.Reader
+	messages []pulsar.Message
+}
+
+func (r *testReader) HasNext() bool {
+	return len(r.messages) > 0
+}
+
+func (r *testReader) Next(context.Context) (pulsar.Message, error) {
+	message := r.messages[0]
+	r.messages = r.messages[1:]
+	return message, nil
+}
+
+func (r *testReader) Close() {}
+
+// 创建主备部署的交易对，撮合结果先经过StandbySink
+func newTestStandbyContext(start time.Time) (*svc.SymbolContext, *engine.MemoryResultSink) {
+	s, _ := newTestSymbolContext(start)
+	results := engine.NewMemoryResultSink()
+	sink := engine.NewStandbySink(s.Config.Symbol, results, engine.NewMemoryMarketDataSink())
+	followCtx, elect := context.WithCancel(s.Ctx)
+	elect()
+	s.Standby = &svc.Standby{Sink: sink, ResultReader: &testReader{}, FollowCtx: followCtx}
+	var id int64
+	s.MatchEngine = engine.NewMatchEngine(s.Config, sink, sink,
+		engine.WithClock(s.Clock),
+		engine.WithIdGenerator(func() int64 {
+			id++
+			return id
+		}),
+	)
+	return s, results
+}
+
+// 测试备节点启动之后主节点没有发送过撮合结果，切换之后撮合结果的序号从主节点最后发送的序号继续
+func TestPromoteSeq(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	leader, leaderResults := newTestStandbyContext(base)
+	// 备节点启动的时间比主节点早，撮合引擎的序号比主节点小
+	follower, followerResults := newTestStandbyContext(base.Add(-time.Hour))
+	leader.Standby.NewLatestReader = func() (pulsar.Reader, error) {
+		return &testReader{}, nil
+	}
+	promote(leader)
+	assert.True(t, leader.Standby.Sink.Leader())
+
+	messages := make([]*testMessage, 0, 3)
+	for i, req := range []*matchMq.MatchReq{
+		newOrderReq(1, "100", "2", enum.Side_Sell, 0),
+		newOrderReq(2, "100", "1", enum.Side_Buy, 0),
+		newOrderReq(3, "100", "1", enum.Side_Buy, 0),
+	} {
+		msg := newTestMessage(req, base.Add(time.Duration(i)*time.Second))
+		msg.id = pulsar.NewMessageID(1, int64(i), 0, 0)
+		messages = append(messages, msg)
+	}
+	// 主节点处理前两条消息之后宕机
+	for _, msg := range messages[:2] {
+		assert.True(t, handleMessage(leader, msg))
+		assert.True(t, handleMessage(follower, msg))
+	}
+	sent := leaderResults.Results()
+	if !assert.Len(t, sent, 1) {
+		return
+	}
+	data, _ := proto.Marshal(sent[0])
+
+	// 跟随的reader没有读到主节点的撮合结果，选主成功之后读取最后一条撮合结果
+	follower.Standby.NewLatestReader = func() (pulsar.Reader, error) {
+		return &testReader{messages: []pulsar.Message{&testMessage{payload: data}}}, nil
+	}
+	promote(follower)
+	assert.True(t, follower.Standby.Sink.Leader())
+	assert.True(t, handleMessage(follower, messages[2]))
+
+	// 主节点已经发送的撮合结果不再发送，新的撮合结果的序号连续
+	resumed := followerResults.Results()
+	if assert.Len(t, resumed, 1) {
+		assert.Equal(t, sent[0].Seq+1, resumed[0].Seq)
+		assert.Equal(t, fmt.Sprintf("BTC_USDT_%v", sent[0].Seq+1), resumed[0].MessageId)
+		assert.Equal(t, int64(3), resumed[0].GetMatchResult().GetMatchedRecord()[0].GetTaker().GetId())
+	}
+}
@@ -0,0 +1,59 @@
+package consumer
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	logger "github.com/luxun9527/zlog"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// snapshotWriter 异步写快照文件，快照在撮合协程中生成，写文件不阻塞撮合。
+type snapshotWriter struct {
+	path string
+	data chan []byte
+}
+
+func newSnapshotWriter(sc *svc.SymbolContext) *snapshotWriter {
+	w := &snapshotWriter{
+		path: engine.SnapshotPath(sc.Config.SnapshotConf.Dir, sc.Config.Symbol),
+		data: make(chan []byte, 1),
+	}
+	go w.run()
+	return w
+}
+
+func (w *snapshotWriter) run() {
+	for data := range w.data {
+		if err := engine.SaveSnapshot(w.path, data); err != nil {
+			logx.Errorw("save snapshot failed", logger.ErrorField(err), logx.Field("path", w.path))
+			continue
+		}
+		logx.Infow("save snapshot success", logx.Field("path", w.path), logx.Field("size", len(data)))
+	}
+}
+
+// close 交易对下线，写完最后一个快照之后退出
+func (w *snapshotWriter) close() {
+	close(w.data)
+}
+
+// take 生成快照，上一个快照还没有写完则跳过这一次
+func (w *snapshotWriter) take(sc *svc.SymbolContext, messageId pulsar.MessageID) {
+	data, err := sc.MatchEngine.TakeSnapshot(messageId.Serialize()).Encode()
+	if err != nil {
+		logx.Errorw("encode snapshot failed", logger.ErrorField(err))
+		return
+	}
+	select {
+	case w.data <- data:
+	default:
+		logx.Sloww("snapshot is writing, skip this snapshot")
+	}
+}
+
+// 判断是否是同一条消息，seek之后会重新收到快照中记录的最后一条消息
+func sameMessageID(a, b pulsar.MessageID) bool {
+	return a.LedgerID() == b.LedgerID() && a.EntryID() == b.EntryID() &&
+		a.BatchIdx() == b.BatchIdx() && a.PartitionIdx() == b.PartitionIdx()
+}
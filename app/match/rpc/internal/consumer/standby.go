@@ -0,0 +1,74 @@
+package consumer
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	logger "github.com/luxun9527/zlog"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/protobuf/proto"
+)
+
+// follow 备节点读取主节点已经发送的撮合结果，丢弃缓存中主节点已经发送过的结果
+func follow(sc *svc.SymbolContext) {
+	reader := sc.Standby.ResultReader
+	for reader.HasNext() {
+		message, err := reader.Next(sc.Ctx)
+		if err != nil {
+			logx.Errorw("read match result failed", logger.ErrorField(err))
+			return
+		}
+		confirm(sc, message)
+	}
+}
+
+// confirm 记录主节点已经发送的撮合结果
+func confirm(sc *svc.SymbolContext, message pulsar.Message) {
+	var resp matchMq.MatchResp
+	if err := proto.Unmarshal(message.Payload(), &resp); err != nil {
+		logx.Errorw("unmarshal match result failed", logger.ErrorField(err))
+		return
+	}
+	var sourceId pulsar.MessageID
+	if len(resp.SourceId) > 0 {
+		var err error
+		if sourceId, err = pulsar.DeserializeMessageID(resp.SourceId); err != nil {
+			logx.Errorw("deserialize source message id failed", logger.ErrorField(err))
+			return
+		}
+	}
+	sc.Standby.Sink.Confirm(resp.Seq, sourceId, resp.SourceIndex)
+}
+
+// confirmLatest 读取match_result中最后一条撮合结果，备节点启动之后主节点没有发送过撮合结果时，
+// 跟随的reader读不到主节点之前发送的结果，成为主节点之后的序号从这条结果继续。
+func confirmLatest(sc *svc.SymbolContext) error {
+	reader, err := sc.Standby.NewLatestReader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if !reader.HasNext() {
+		return nil
+	}
+	message, err := reader.Next(sc.Ctx)
+	if err != nil {
+		return err
+	}
+	confirm(sc, message)
+	return nil
+}
+
+// promote 选主成功之后读完主节点发送的撮合结果，补发还没有发送的结果，之后发送撮合结果和推送行情
+func promote(sc *svc.SymbolContext) {
+	follow(sc)
+	if err := confirmLatest(sc); err != nil {
+		logx.Errorw("read latest match result failed", logger.ErrorField(err), logx.Field("symbol", sc.Config.Symbol))
+		return
+	}
+	if err := sc.Standby.Sink.Promote(); err != nil {
+		logx.Errorw("promote to leader failed", logger.ErrorField(err), logx.Field("symbol", sc.Config.Symbol))
+		return
+	}
+	logx.Infow("promote to leader", logx.Field("symbol", sc.Config.Symbol))
+}
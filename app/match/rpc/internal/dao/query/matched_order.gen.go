@@ -43,6 +43,8 @@ func newMatchedOrder(db *gorm.DB, opts ...gen.DOOption) matchedOrder {
 	_matchedOrder.MatchTime = field.NewInt64(tableName, "match_time")
 	_matchedOrder.CreatedAt = field.NewInt64(tableName, "created_at")
 	_matchedOrder.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_matchedOrder.TakerFee = field.NewString(tableName, "taker_fee")
+	_matchedOrder.MakerFee = field.NewString(tableName, "maker_fee")
 
 	_matchedOrder.fillFieldMap()
 
@@ -69,6 +71,8 @@ type matchedOrder struct {
 	MatchTime    field.Int64  // 撮合时间
 	CreatedAt    field.Int64  // 创建时间
 	UpdatedAt    field.Int64  // 修改时间
+	TakerFee     field.String // taker手续费 taker买为基础币 taker卖为计价币
+	MakerFee     field.String // maker手续费 maker买为基础币 maker卖为计价币
 
 	fieldMap map[string]field.Expr
 }
@@ -101,6 +105,8 @@ func (m *matchedOrder) updateTableName(table string) *matchedOrder {
 	m.MatchTime = field.NewInt64(table, "match_time")
 	m.CreatedAt = field.NewInt64(table, "created_at")
 	m.UpdatedAt = field.NewInt64(table, "updated_at")
+	m.TakerFee = field.NewString(table, "taker_fee")
+	m.MakerFee = field.NewString(table, "maker_fee")
 
 	m.fillFieldMap()
 
@@ -129,7 +135,7 @@ func (m *matchedOrder) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (m *matchedOrder) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 16)
+	m.fieldMap = make(map[string]field.Expr, 18)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["match_id"] = m.MatchID
 	m.fieldMap["match_sub_id"] = m.MatchSubID
@@ -146,6 +152,8 @@ func (m *matchedOrder) fillFieldMap() {
 	m.fieldMap["match_time"] = m.MatchTime
 	m.fieldMap["created_at"] = m.CreatedAt
 	m.fieldMap["updated_at"] = m.UpdatedAt
+	m.fieldMap["taker_fee"] = m.TakerFee
+	m.fieldMap["maker_fee"] = m.MakerFee
 }
 
 func (m matchedOrder) clone(db *gorm.DB) matchedOrder {
@@ -0,0 +1,119 @@
+package engine
+
+import (
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 运维接口：查询订单簿、订单和撮合引擎的状态，人工暂停和恢复交易。
+// 撮合引擎不是并发安全的，查询的方法在其他协程中调用时需要持有交易对的锁，和处理消息的协程互斥。
+// 暂停和恢复交易通过match_source发送，和订单按照相同的顺序处理，主备节点和重放的结果一致。
+
+// Halt 人工暂停或者恢复交易
+type Halt struct {
+	Halted bool
+}
+
+func NewHaltFromOperate(operate *matchMq.HaltOperate) *Halt {
+	return &Halt{Halted: operate.Halted}
+}
+
+// HandleHalt 人工暂停期间只接受撤单，新订单和修改订单直接拒绝，条件单不触发，集合竞价不结束。
+// 恢复之后如果熔断还没有结束继续暂停，熔断结束之后收到第一个订单时推送恢复的消息。
+func (m *MatchEngine) HandleHalt(h *Halt) {
+	if m.manualHalt == h.Halted {
+		return
+	}
+	m.manualHalt = h.Halted
+	logx.Sloww("trading halted by admin", logx.Field("halted", h.Halted), logx.Field("lastPrice", m.lastPrice))
+	if h.Halted || m.haltUntil == 0 {
+		m.pushHalt(h.Halted, m.lastPrice)
+	}
+}
+
+// BookData 订单簿中所有订单的副本
+type BookData struct {
+	Asks     []Order //卖盘，按照订单簿的顺序
+	Bids     []Order //买盘，按照订单簿的顺序
+	Triggers []Order //未触发的条件单，先买后卖，按照触发的顺序
+	Version  int64   //订单簿版本
+}
+
+// GetBook 获取订单簿中所有订单的副本，包括用户id和未成交的数量
+func (m *MatchEngine) GetBook() BookData {
+	book := BookData{
+		Asks:     make([]Order, 0, m.asks.size()),
+		Bids:     make([]Order, 0, m.bids.size()),
+		Triggers: make([]Order, 0, len(m.triggerOrders)),
+		Version:  m.currentSeqId,
+	}
+	for _, v := range m.asks.values() {
+		book.Asks = append(book.Asks, *v)
+	}
+	for _, v := range m.bids.values() {
+		book.Bids = append(book.Bids, *v)
+	}
+	for _, tb := range []*TriggerBook{m.buyTriggers, m.sellTriggers} {
+		for _, v := range tb.triggerBook.Values() {
+			book.Triggers = append(book.Triggers, *v.(*Order))
+		}
+	}
+	return book
+}
+
+// GetOrder 根据订单id查找订单簿中的订单和未触发的条件单，返回订单当前的副本
+func (m *MatchEngine) GetOrder(sequenceId int64) (Order, bool) {
+	if order, ok := m.asks.get(sequenceId); ok {
+		return *order, true
+	}
+	if order, ok := m.bids.get(sequenceId); ok {
+		return *order, true
+	}
+	if order, ok := m.triggerOrders[sequenceId]; ok {
+		return *order, true
+	}
+	return Order{}, false
+}
+
+// EngineStats 撮合引擎的运行状态
+type EngineStats struct {
+	CurrentSeqId   int64 //订单簿版本
+	ResultSeq      int64 //撮合结果的序号
+	AskOrders      int   //卖盘订单数量
+	BidOrders      int   //买盘订单数量
+	TriggerOrders  int   //未触发的条件单数量
+	AskLevels      int   //卖盘档位数量
+	BidLevels      int   //买盘档位数量
+	BestBid        utils.Fixed
+	BestAsk        utils.Fixed
+	LastPrice      utils.Fixed
+	ManualHalt     bool  //是否人工暂停交易
+	HaltUntil      int64 //熔断恢复交易的时间，为零表示没有熔断
+	AuctionUntil   int64 //集合竞价结束的时间，为零表示不在集合竞价
+	TickQueue      int   //等待推送的成交
+	DepthQueue     int   //等待更新的深度
+	DepthPushQueue int   //等待推送的深度
+}
+
+// GetStats 获取撮合引擎的运行状态，熔断时间结束之后还没有收到订单时HaltUntil不为零
+func (m *MatchEngine) GetStats() EngineStats {
+	return EngineStats{
+		CurrentSeqId:   m.currentSeqId,
+		ResultSeq:      m.resultSeq,
+		AskOrders:      m.asks.size(),
+		BidOrders:      m.bids.size(),
+		TriggerOrders:  len(m.triggerOrders),
+		AskLevels:      m.asks.levels.Size(),
+		BidLevels:      m.bids.levels.Size(),
+		BestBid:        m.bestBid,
+		BestAsk:        m.bestAsk,
+		LastPrice:      m.lastPrice,
+		ManualHalt:     m.manualHalt,
+		HaltUntil:      m.haltUntil,
+		AuctionUntil:   m.auctionUntil,
+		TickQueue:      len(m.tick),
+		DepthQueue:     len(m.depthHandler.paramChan),
+		DepthPushQueue: len(m.depthHandler.ChangedPosition),
+	}
+}
@@ -0,0 +1,234 @@
+package engine
+
+import (
+	"errors"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 修改订单只修改限价单的价格和数量。
+// 价格不变只减少数量时在原来的位置修改，保持排队的优先级；修改价格或者增加数量时从订单簿中删除，按照新的价格重新撮合，剩余的部分重新排队。
+// 订单服务发送修改消息之前可能额外冻结了资产，撮合根据修改之后需要冻结的数量计算解冻的数量，修改失败则全部解冻。
+
+// AmendOrder 修改订单的消息
+type AmendOrder struct {
+	SequenceId int64
+	Uid        int64
+	Side       enum.Side
+	Price      utils.Fixed //修改之前的价格，用于在订单簿中查找订单
+	NewPrice   utils.Fixed //修改之后的价格
+	NewQty     utils.Fixed //修改之后的订单数量，包括已经成交的数量
+	FrozenQty  utils.Fixed //订单服务额外冻结的数量 买单为计价币 卖单为基础币
+	QueueId    int64       //重新排队的序号
+}
+
+type AmendResp struct {
+	//修改订单的id
+	AmendId int64
+	//用户id
+	Uid int64
+	//解冻的币种id 买单为计价币id 卖单为基础币id
+	CoinId int32
+	//解冻的数量
+	UnfrozenQty string
+	//修改失败,订单不变
+	Rejected bool
+	//修改之后的订单
+	Price, Qty, Amount, UnfilledQty, UnfilledAmount string
+	OrderStatus                                     enum.OrderStatus
+	//修改之后排队的序号
+	QueueId int64
+}
+
+var (
+	errAmendNotFound = errors.New("order not found")
+	errAmendQty      = errors.New("qty less than filled qty")
+	errAmendHalted   = errors.New("trading halted")
+	errAmendFrozen   = errors.New("frozen qty not enough")
+)
+
+// NewAmendOrderFromOperate 修改订单消息转换为撮合引擎的修改订单，数值不是合法的定点数返回错误
+func NewAmendOrderFromOperate(operate *matchMq.AmendOperate) (*AmendOrder, error) {
+	var p utils.FixedParser
+	amend := &AmendOrder{
+		SequenceId: operate.Id,
+		Uid:        operate.Uid,
+		Side:       operate.Side,
+		Price:      p.Parse(operate.Price),
+		NewPrice:   p.Parse(operate.NewPrice),
+		NewQty:     p.Parse(operate.NewQty),
+		FrozenQty:  p.Parse(operate.FrozenQty),
+		QueueId:    operate.QueueId,
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return amend, nil
+}
+
+// HandleAmend 处理修改订单
+func (m *MatchEngine) HandleAmend(amend *AmendOrder) {
+	defer m.flushL3()
+	m.nextVersion(amend.SequenceId)
+	m.expireOrders()
+	auction := m.inAuction()
+	book := m.bids
+	if amend.Side == enum.Side_Sell {
+		book = m.asks
+	}
+	order, found := book.get(amend.SequenceId)
+	if !found {
+		m.rejectAmend(amend, errAmendNotFound)
+		return
+	}
+	if err := m.scaleAmend(amend); err != nil {
+		m.rejectAmend(amend, err)
+		return
+	}
+	newUnfilledQty := amend.NewQty.Sub(order.Qty.Sub(order.UnfilledQty))
+	unfrozenQty, err := m.checkAmend(order, amend, newUnfilledQty)
+	if err != nil {
+		m.rejectAmend(amend, err)
+		return
+	}
+
+	//价格不变只减少数量，保持排队的优先级，冰山单先减少隐藏的部分
+	if amend.NewPrice.Equal(order.Price) && newUnfilledQty.LessThanOrEqual(order.UnfilledQty) {
+		visible := order.depthQty()
+		amendOrderFields(order, amend, newUnfilledQty)
+		order.clampVisible()
+		book.modify(order)
+		m.depthHandler.updateDepth(&position{
+			price: order.Price,
+			qty:   visible.Sub(order.depthQty()),
+		}, order.Side, Delete, m.currentSeqId)
+		m.sendAmendResp(order, unfrozenQty)
+		if auction {
+			m.pushIndicative()
+		}
+		return
+	}
+
+	//修改价格或者增加数量，从订单簿中删除之后按照新的价格重新撮合
+	m.cancelOrder(order)
+	m.depthHandler.updateDepth(&position{
+		price: order.Price,
+		qty:   order.depthQty(),
+	}, order.Side, Delete, m.currentSeqId)
+	amendOrderFields(order, amend, newUnfilledQty)
+	order.QueueId = amend.QueueId
+	//先发送修改的结果，订单服务和账户服务按照修改之后的订单处理之后的撮合结果
+	m.sendAmendResp(order, unfrozenQty)
+	m.handleOrder(order, auction)
+}
+
+// checkAmend 校验修改之后的订单，返回需要解冻的数量
+func (m *MatchEngine) checkAmend(order *Order, amend *AmendOrder, newUnfilledQty utils.Fixed) (utils.Fixed, error) {
+	if !newUnfilledQty.IsPositive() {
+		return utils.Fixed{}, errAmendQty
+	}
+	//熔断期间的集合竞价可以修改订单，人工暂停期间不能修改
+	if m.isHalted() && (m.auctionUntil == 0 || m.manualHalt) {
+		return utils.Fixed{}, errAmendHalted
+	}
+	if err := m.tradingRule().checkLimitOrder(amend.NewPrice, amend.NewQty); err != nil {
+		return utils.Fixed{}, err
+	}
+	//增加数量之后订单簿汇总的数量不能超出定点数的范围
+	total, err := m.orderBook(order.Side).unfilled.SubChecked(order.UnfilledQty)
+	if err == nil {
+		_, err = total.AddChecked(newUnfilledQty)
+	}
+	if err != nil {
+		return utils.Fixed{}, err
+	}
+	//买单冻结未成交数量乘以价格的计价币，卖单冻结未成交数量的基础币
+	oldFrozen, newFrozen := order.UnfilledQty, newUnfilledQty
+	if order.Side == enum.Side_Buy {
+		oldFrozen, newFrozen = order.UnfilledAmount, newUnfilledQty.Mul(amend.NewPrice)
+	}
+	frozen, err := oldFrozen.AddChecked(amend.FrozenQty)
+	if err != nil {
+		return utils.Fixed{}, err
+	}
+	unfrozenQty, err := frozen.SubChecked(newFrozen)
+	if err != nil || unfrozenQty.IsNegative() {
+		return utils.Fixed{}, errAmendFrozen
+	}
+	return unfrozenQty, nil
+}
+
+// scaleAmend 修改之后的价格和数量转换为撮合引擎的指数，和新订单一样校验精度和成交金额的范围
+func (m *MatchEngine) scaleAmend(amend *AmendOrder) error {
+	price, err := amend.NewPrice.Rescale(m.priceExp)
+	if err != nil {
+		return err
+	}
+	qty, err := amend.NewQty.Rescale(m.qtyExp)
+	if err != nil {
+		return err
+	}
+	notionalPrice := price
+	if amend.Side == enum.Side_Sell && m.bestBid.GreaterThan(notionalPrice) {
+		notionalPrice = m.bestBid
+	}
+	if _, err := qty.MulChecked(notionalPrice); err != nil {
+		return err
+	}
+	amend.NewPrice, amend.NewQty = price, qty
+	return nil
+}
+
+// amendOrderFields 修改订单的价格和数量
+func amendOrderFields(order *Order, amend *AmendOrder, newUnfilledQty utils.Fixed) {
+	order.Price = amend.NewPrice
+	order.Qty = amend.NewQty
+	order.Amount = amend.NewQty.Mul(amend.NewPrice)
+	order.UnfilledQty = newUnfilledQty
+	order.UnfilledAmount = newUnfilledQty.Mul(amend.NewPrice)
+}
+
+// rejectAmend 修改失败，订单不变，解冻订单服务额外冻结的数量
+func (m *MatchEngine) rejectAmend(amend *AmendOrder, err error) {
+	logx.Infow("amend order rejected", logx.Field("sequenceId", amend.SequenceId), logx.Field("err", err))
+	coinId := m.c.SymbolInfo.BaseCoinID
+	if amend.Side == enum.Side_Buy {
+		coinId = m.c.SymbolInfo.QuoteCoinID
+	}
+	m.SendMatchResult(&MatchResult{
+		AmendResp: &AmendResp{
+			AmendId:     amend.SequenceId,
+			Uid:         amend.Uid,
+			CoinId:      coinId,
+			UnfrozenQty: amend.FrozenQty.String(),
+			Rejected:    true,
+		},
+		MatchTime: m.now().UnixNano(),
+	})
+}
+
+// sendAmendResp 发送修改之后的订单
+func (m *MatchEngine) sendAmendResp(order *Order, unfrozenQty utils.Fixed) {
+	coinId := m.c.SymbolInfo.BaseCoinID
+	if order.Side == enum.Side_Buy {
+		coinId = m.c.SymbolInfo.QuoteCoinID
+	}
+	m.SendMatchResult(&MatchResult{
+		AmendResp: &AmendResp{
+			AmendId:        order.SequenceId,
+			Uid:            order.Uid,
+			CoinId:         coinId,
+			UnfrozenQty:    unfrozenQty.String(),
+			Price:          order.Price.String(),
+			Qty:            order.Qty.String(),
+			Amount:         order.Amount.String(),
+			UnfilledQty:    order.UnfilledQty.String(),
+			UnfilledAmount: order.UnfilledAmount.String(),
+			OrderStatus:    order.OrderStatus,
+			QueueId:        order.QueueId,
+		},
+		MatchTime: m.now().UnixNano(),
+	})
+}
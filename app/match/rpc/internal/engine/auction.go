@@ -0,0 +1,296 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	commonWs "github.com/luxun9527/gex/common/proto/ws"
+	"github.com/luxun9527/gex/common/utils"
+	logger "github.com/luxun9527/zlog"
+	"github.com/spf13/cast"
+	"github.com/zeromicro/go-zero/core/logx"
+	"sort"
+	"time"
+)
+
+// 集合竞价：新上线的交易对在开盘时间之前、配置了熔断集合竞价的交易对在熔断期间进入集合竞价。
+// 集合竞价期间限价单只进入订单簿不撮合，市价单、FOK、IOC直接撤销，每次订单簿变化之后推送参考成交价和成交量。
+// 集合竞价结束之后收到第一个消息或者定时消息时，按照参考成交价一次性撮合所有可以成交的订单。
+// 参考成交价：成交量最大，其次未成交的数量最小，其次最接近最新成交价(没有成交价使用配置的参考价格)，最后选择较低的价格。
+// 撮合时买单按照价格优先、时间优先依次作为taker，每个买单的成交作为一个撮合结果发送。
+// 买单按照下单价格冻结，成交价格较低时多冻结的部分和限价单taker一样由账户服务解冻。
+
+// auctionLevel 集合竞价计算参考成交价时每个价格的未成交数量
+type auctionLevel struct {
+	price utils.Fixed
+	qty   utils.Fixed
+}
+
+// CheckAuction 检查集合竞价是否结束，返回距离集合竞价结束的时间，不在集合竞价返回0。
+// 必须和HandleOrder在同一个协程中调用。
+func (m *MatchEngine) CheckAuction() time.Duration {
+	defer m.flushL3()
+	if !m.inAuction() {
+		return 0
+	}
+	return time.Duration(m.auctionUntil - m.now().UnixNano())
+}
+
+// inAuction 是否处于集合竞价，集合竞价结束时先撮合集合竞价期间的订单。
+// 开盘时间只能推迟集合竞价的结束时间，不能提前。
+func (m *MatchEngine) inAuction() bool {
+	now := m.now()
+	if open := m.c.SymbolInfo.GetAuction().OpenTime; open.After(now) && open.UnixNano() > m.auctionUntil {
+		m.startAuction(open.UnixNano())
+	}
+	if m.auctionUntil == 0 {
+		return false
+	}
+	//人工暂停期间不撮合，恢复之后再结束集合竞价
+	if now.UnixNano() < m.auctionUntil || m.manualHalt {
+		return true
+	}
+	//熔断期间的集合竞价先恢复交易
+	m.isHalted()
+	price, qty := m.auctionPrice()
+	logx.Sloww("call auction ended", logx.Field("price", price), logx.Field("qty", qty))
+	m.pushAuction(false, price, qty)
+	m.auctionUntil = 0
+	m.uncross(price, qty)
+	return false
+}
+
+// startAuction 开始集合竞价或者推迟集合竞价的结束时间
+func (m *MatchEngine) startAuction(until int64) {
+	if m.auctionUntil == 0 {
+		logx.Sloww("call auction started", logx.Field("until", until))
+	}
+	m.auctionUntil = until
+	price, qty := m.auctionPrice()
+	m.pushAuction(true, price, qty)
+}
+
+// addAuctionOrder 集合竞价期间的新订单，限价单进入订单簿不撮合，其他订单直接撤销
+func (m *MatchEngine) addAuctionOrder(order *Order) {
+	if order.OrderType != enum.OrderType_LO {
+		logx.Infow("order rejected by call auction", logx.Field("sequenceId", order.SequenceId))
+		m.cancelUnfilled(order)
+		return
+	}
+	if err := m.checkTradingRule(order); err != nil {
+		logx.Infow("order rejected by trading rule", logx.Field("sequenceId", order.SequenceId), logx.Field("err", err))
+		m.cancelUnfilled(order)
+		return
+	}
+	m.addOrder(order)
+	m.depthHandler.updateDepth(&position{
+		price: order.Price,
+		qty:   order.depthQty(),
+	}, order.Side, Add, m.currentSeqId)
+	m.pushIndicative()
+}
+
+// pushIndicative 订单簿变化之后推送新的参考成交价和成交量
+func (m *MatchEngine) pushIndicative() {
+	price, qty := m.auctionPrice()
+	m.pushAuction(true, price, qty)
+}
+
+// auctionPrice 计算参考成交价和成交量，没有可以成交的订单都为0
+func (m *MatchEngine) auctionPrice() (price, qty utils.Fixed) {
+	price, qty = utils.Fixed{}, utils.Fixed{}
+	if m.bids.size() == 0 || m.asks.size() == 0 || m.bestBid.LessThan(m.bestAsk) {
+		return price, qty
+	}
+	//买盘价格从高到低，卖盘价格从低到高
+	bids, asks := auctionLevels(m.bids), auctionLevels(m.asks)
+	//可以成交的价格在卖一价和买一价之间
+	candidates := make([]utils.Fixed, 0, len(bids)+len(asks))
+	buy := utils.Fixed{}
+	for _, v := range bids {
+		buy = buy.Add(v.qty)
+		if v.price.GreaterThanOrEqual(m.bestAsk) {
+			candidates = append(candidates, v.price)
+		}
+	}
+	for _, v := range asks {
+		if v.price.LessThanOrEqual(m.bestBid) {
+			candidates = append(candidates, v.price)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].LessThan(candidates[j])
+	})
+	ref := m.lastPrice
+	if !ref.IsPositive() {
+		ref = m.priceProtection().referencePrice
+	}
+
+	//价格从低到高，价格以下的买单不能成交，价格以下的卖单可以成交
+	sell, bi, ai := utils.Fixed{}, len(bids)-1, 0
+	var bestImbalance, bestDistance utils.Fixed
+	for _, p := range candidates {
+		for bi >= 0 && bids[bi].price.LessThan(p) {
+			buy = buy.Sub(bids[bi].qty)
+			bi--
+		}
+		for ai < len(asks) && asks[ai].price.LessThanOrEqual(p) {
+			sell = sell.Add(asks[ai].qty)
+			ai++
+		}
+		volume := utils.MinFixed(buy, sell)
+		imbalance := buy.Sub(sell).Abs()
+		distance := p.Sub(ref).Abs()
+		var better bool
+		switch {
+		case !volume.Equal(qty):
+			better = volume.GreaterThan(qty)
+		case !imbalance.Equal(bestImbalance):
+			better = imbalance.LessThan(bestImbalance)
+		default:
+			//价格从低到高遍历，距离相同时保留较低的价格
+			better = distance.LessThan(bestDistance)
+		}
+		if better {
+			price, qty, bestImbalance, bestDistance = p, volume, imbalance, distance
+		}
+	}
+	return price, qty
+}
+
+// auctionLevels 按照订单簿的顺序返回每个价格的未成交数量，冰山单包括隐藏的部分
+func auctionLevels(book *OrderBook) []auctionLevel {
+	levels := make([]auctionLevel, 0, 8)
+	for _, v := range book.priceLevels() {
+		levels = append(levels, auctionLevel{price: v.price, qty: v.unfilled})
+	}
+	return levels
+}
+
+// uncross 集合竞价结束，按照参考成交价撮合价格不低于成交价的买单和价格不高于成交价的卖单，一共成交qty
+func (m *MatchEngine) uncross(price, qty utils.Fixed) {
+	remaining := qty
+	for remaining.IsPositive() && m.bids.size() > 0 {
+		takerOrder := m.bids.first()
+		if takerOrder.Price.LessThan(price) {
+			break
+		}
+		//买单从订单簿中取出作为taker撮合，没有成交完的部分放回订单簿，排队的位置不变
+		m.cancelOrder(takerOrder)
+		m.depthHandler.updateDepth(&position{
+			price: takerOrder.Price,
+			qty:   takerOrder.depthQty(),
+		}, enum.Side_Buy, Delete, m.currentSeqId)
+		remaining = remaining.Sub(m.uncrossBuyOrder(takerOrder, price, remaining))
+		//自成交保护撤销剩余的部分，继续撮合下一个买单
+		if takerOrder.OrderStatus == enum.OrderStatus_Canceled {
+			m.cancelUnfilled(takerOrder)
+			continue
+		}
+		//买单没有成交完说明可以成交的卖单已经没有了
+		if takerOrder.UnfilledQty.IsPositive() {
+			m.addOrder(takerOrder)
+			m.depthHandler.updateDepth(&position{
+				price: takerOrder.Price,
+				qty:   takerOrder.depthQty(),
+			}, enum.Side_Buy, Add, m.currentSeqId)
+			break
+		}
+	}
+	//撮合之后最新成交价可能发生变化，检查条件单是否触发
+	//集合竞价已经结束，触发的条件单直接撮合
+	m.checkTriggerOrders(false)
+}
+
+// uncrossBuyOrder 买单按照参考成交价和卖单成交，发送一个撮合结果，返回成交的数量
+func (m *MatchEngine) uncrossBuyOrder(takerOrder *Order, price, remaining utils.Fixed) utils.Fixed {
+	matchedResult := &MatchResult{
+		MatchedRecords: make([]*MatchedRecord, 0, 2),
+		TakerIsBuy:     true,
+	}
+	filled := utils.Fixed{}
+	for takerOrder.UnfilledQty.IsPositive() && filled.LessThan(remaining) && m.asks.size() > 0 {
+		makerOrder := m.asks.first()
+		if makerOrder.Price.GreaterThan(price) {
+			break
+		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				m.cancelOrder(makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
+		//冰山单只和显示的部分成交
+		qty := utils.MinFixed(takerOrder.UnfilledQty, makerOrder.depthQty(), remaining.Sub(filled))
+		amount := qty.Mul(price)
+		fillAuctionOrder(takerOrder, qty, amount)
+		fillAuctionOrder(makerOrder, qty, amount)
+		if makerOrder.isIceberg() {
+			makerOrder.VisibleQty = makerOrder.VisibleQty.Sub(qty)
+		}
+		m.asks.modify(makerOrder)
+		matchedRecord := &MatchedRecord{
+			Price:  price,
+			Qty:    qty,
+			Amount: amount,
+		}
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
+		matchedRecord.Taker = *takerOrder
+		matchedRecord.Maker = *makerOrder
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
+		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//卖单在自己的价格上减少深度
+		m.depthHandler.updateDepth(&position{
+			price: makerOrder.Price,
+			qty:   qty,
+		}, enum.Side_Sell, Delete, m.currentSeqId)
+		switch {
+		case makerOrder.OrderStatus == enum.OrderStatus_ALLFilled:
+			m.cancelOrder(makerOrder)
+		case makerOrder.needReplenish():
+			m.replenishIceberg(m.asks, makerOrder, nil, matchedRecord)
+		}
+		filled = filled.Add(qty)
+	}
+	if len(matchedResult.MatchedRecords) > 0 {
+		matchedResult.MatchTime = m.now().UnixNano()
+		matchedResult.MatchID = cast.ToString(m.nextId())
+		m.SendMatchResult(matchedResult)
+	}
+	m.sendSTPCancels()
+	return filled
+}
+
+// fillAuctionOrder 集合竞价成交之后更新订单，未成交金额按照下单价格减少，成交金额按照成交价格计算
+func fillAuctionOrder(order *Order, qty, amount utils.Fixed) {
+	order.UnfilledQty = order.UnfilledQty.Sub(qty)
+	order.UnfilledAmount = order.UnfilledAmount.Sub(qty.Mul(order.Price))
+	order.FilledQty = order.FilledQty.Add(qty)
+	order.FilledAmount = order.FilledAmount.Add(amount)
+	order.OrderStatus = enum.OrderStatus_PartFilled
+	if !order.UnfilledQty.IsPositive() {
+		order.OrderStatus = enum.OrderStatus_ALLFilled
+	}
+}
+
+// pushAuction 推送集合竞价的参考成交价和成交量
+func (m *MatchEngine) pushAuction(auction bool, price, qty utils.Fixed) {
+	data := commonWs.Auction{
+		Symbol:    m.c.SymbolInfo.SymbolName,
+		Auction:   auction,
+		Price:     price.StringFixedBank(m.c.SymbolInfo.QuoteCoinPrec.Load()),
+		Qty:       qty.StringFixedBank(m.c.SymbolInfo.BaseCoinPrec.Load()),
+		Until:     m.auctionUntil / 1e9,
+		TimeStamp: m.now().Unix(),
+	}
+	msg := commonWs.Message[commonWs.Auction]{
+		Topic:   commonWs.AuctionPrefix.WithParam(m.c.SymbolInfo.SymbolName),
+		Payload: data,
+	}
+	if err := m.marketDataSink.PushMarketData(msg.Topic, msg.ToBytes()); err != nil {
+		logx.Errorw("push auction websocket data failed", logger.ErrorField(err), logx.Field("data", data))
+	}
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/zeromicro/go-zero/core/logx"
+	"sort"
+)
+
+// 批量撤单：撤销一个用户在订单簿中的订单和未触发的条件单，可以只撤销一个方向。
+// 每个订单发送一个撤单消息，订单服务和账户服务按照单个撤单处理，解冻的资产和单个撤单完全一致。
+
+// CancelAll 批量撤单
+type CancelAll struct {
+	Uid  int64
+	Side enum.Side //为空则撤销两个方向的订单
+}
+
+func NewCancelAllFromOperate(operate *matchMq.CancelAllOperate) *CancelAll {
+	return &CancelAll{
+		Uid:  operate.Uid,
+		Side: operate.Side,
+	}
+}
+
+// HandleCancelAll 处理批量撤单，遍历一次订单簿找出用户的订单之后统一删除
+func (m *MatchEngine) HandleCancelAll(c *CancelAll) {
+	defer m.flushL3()
+	//批量撤单没有订单id，版本号加一
+	m.nextVersion(0)
+	m.expireOrders()
+	auction := m.inAuction()
+	canceled := 0
+	for _, book := range []*OrderBook{m.bids, m.asks} {
+		if c.Side != enum.Side_UnknownSide && c.Side != book.side {
+			continue
+		}
+		orders := make([]*Order, 0, 8)
+		iterator := book.iterator()
+		for iterator.Next() {
+			if order := iterator.Order(); order.Uid == c.Uid {
+				orders = append(orders, order)
+			}
+		}
+		for _, order := range orders {
+			book.remove(order)
+			m.depthHandler.updateDepth(&position{
+				price: order.Price,
+				qty:   order.depthQty(),
+			}, order.Side, Delete, m.currentSeqId)
+			m.cancelUnfilled(order)
+		}
+		canceled += len(orders)
+	}
+	m.updateBestBid()
+	m.updateBestAsk()
+
+	//未触发的条件单按照订单id的顺序撤销，保证重放的时候撤单消息的顺序一致
+	triggers := make([]*Order, 0, 8)
+	for _, order := range m.triggerOrders {
+		if order.Uid == c.Uid && (c.Side == enum.Side_UnknownSide || c.Side == order.Side) {
+			triggers = append(triggers, order)
+		}
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].SequenceId < triggers[j].SequenceId
+	})
+	for _, order := range triggers {
+		m.cancelTriggerOrder(order)
+	}
+	logx.Infow("cancel all orders", logx.Field("uid", c.Uid), logx.Field("side", c.Side),
+		logx.Field("orders", canceled), logx.Field("triggers", len(triggers)))
+	if auction && canceled > 0 {
+		m.pushIndicative()
+	}
+}
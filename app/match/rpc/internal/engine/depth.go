@@ -1,22 +1,29 @@
 package engine
 
 import (
-	"context"
+	"fmt"
 	rbt "github.com/emirpasic/gods/trees/redblacktree"
 	"github.com/luxun9527/gex/app/match/rpc/internal/config"
 	"github.com/luxun9527/gex/common/proto/enum"
 	commonWs "github.com/luxun9527/gex/common/proto/ws"
 	"github.com/luxun9527/gex/common/utils"
-	gpush "github.com/luxun9527/gpush/proto"
-	ws "github.com/luxun9527/gpush/proto"
 	logger "github.com/luxun9527/zlog"
-	"github.com/shopspring/decimal"
 	"github.com/spf13/cast"
 	"github.com/zeromicro/go-zero/core/logx"
 	"sync"
 	"time"
 )
 
+// 增量深度：每秒推送变化的档位，档位的数量是变化之后的绝对值，数量为0表示删除这个档位。
+// 每次深度变化版本号严格递增，推送的上一个版本等于上一次推送的当前版本，同时带上应用之后前DepthChecksumLevel档的校验和。
+// 查询深度返回的版本号和深度数据在同一个锁里面读取，客户端维护本地深度的步骤：
+// 1. 先订阅depth@交易对并缓存推送的数据；
+// 2. 查询深度快照，档位数量不少于DepthChecksumLevel，快照的版本号为V；
+// 3. 丢弃当前版本小于等于V的推送，第一个应用的推送需要满足 上一个版本<=V<当前版本；
+// 4. 之后每个推送的上一个版本必须等于本地的版本，否则说明丢失了数据；
+// 5. 每次应用之后校验本地深度的校验和，丢失数据或者校验失败时回到第1步重新获取快照。
+// 撮合服务重启之后版本号不连续，客户端按照丢失数据处理。参考实现见common/ws/socket.DepthBook。
+
 type DepthHandler struct {
 	asks                *rbt.Tree
 	bids                *rbt.Tree
@@ -26,8 +33,10 @@ type DepthHandler struct {
 	plock               sync.RWMutex
 	ChangedPosition     chan DepthData
 	paramChan           chan *param
-	proxyClient         ws.ProxyClient
+	marketDataSink      MarketDataSink
 	c                   *config.Config
+	done                chan struct{} //关闭之后停止更新和推送深度
+	groups              []*depthGroup //合并深度
 	currentVersion,     //当前版本
 	lastVersion int64 //上一个版本
 }
@@ -37,9 +46,11 @@ type DepthData struct {
 	Bids           []*Position
 	LastVersion    int64
 	CurrentVersion int64
+	Group          string //合并深度的档位,为空是原始深度
+	Checksum       uint32 //前DepthChecksumLevel档的校验和
 }
 
-func NewDepthHandler(version int64, c *config.Config, proxyClient ws.ProxyClient) *DepthHandler {
+func NewDepthHandler(version int64, c *config.Config, marketDataSink MarketDataSink) *DepthHandler {
 	dh := &DepthHandler{
 		asks:                rbt.NewWith(DepthComparator),
 		bids:                rbt.NewWith(DepthComparator),
@@ -49,8 +60,10 @@ func NewDepthHandler(version int64, c *config.Config, proxyClient ws.ProxyClient
 		plock:               sync.RWMutex{},
 		paramChan:           make(chan *param, 10),
 		ChangedPosition:     make(chan DepthData, 10),
-		proxyClient:         proxyClient,
+		marketDataSink:      marketDataSink,
 		c:                   c,
+		done:                make(chan struct{}),
+		groups:              newDepthGroups(c.DepthGroups),
 		currentVersion:      version,
 		lastVersion:         version,
 	}
@@ -78,22 +91,28 @@ type Position struct {
 	Amount string
 }
 type position struct {
-	price decimal.Decimal
-	qty   decimal.Decimal
+	price utils.Fixed
+	qty   utils.Fixed
 }
 
 func (p *position) castToPosition(baseCoinPrec, quoteCoinPrec int32) *Position {
 	return &Position{
-		Qty:    p.qty.StringFixedBank(baseCoinPrec),
-		Price:  p.price.StringFixedBank(quoteCoinPrec),
-		Amount: p.price.Mul(p.qty).StringFixedBank(quoteCoinPrec),
+		Qty:   p.qty.StringFixedBank(baseCoinPrec),
+		Price: p.price.StringFixedBank(quoteCoinPrec),
+		//档位汇总的金额可能超出定点数的范围，使用decimal计算
+		Amount: p.price.Decimal().Mul(p.qty.Decimal()).StringFixedBank(quoteCoinPrec),
 	}
 }
 
+// String 打印日志的时候才格式化
+func (p *position) String() string {
+	return fmt.Sprintf("price=%v qty=%v", p.price, p.qty)
+}
+
 // DepthComparator 存储为从大到小
 func DepthComparator(a, b interface{}) int {
-	aAsserted := a.(decimal.Decimal)
-	bAsserted := b.(decimal.Decimal)
+	aAsserted := a.(utils.Fixed)
+	bAsserted := b.(utils.Fixed)
 	result := aAsserted.Cmp(bAsserted)
 	return -result
 }
@@ -121,7 +140,7 @@ func (d *DepthHandler) handeUpdateDepth() {
 					if found {
 						pos := value.(*position)
 						pos.qty = pos.qty.Sub(par.p.qty)
-						if pos.qty.Equal(utils.DecimalZeroMaxPrec) {
+						if pos.qty.Equal(utils.Fixed{}) {
 							d.asks.Remove(par.p.price)
 						}
 						changedPosition = pos
@@ -145,13 +164,33 @@ func (d *DepthHandler) handeUpdateDepth() {
 					if found {
 						pos := value.(*position)
 						pos.qty = pos.qty.Sub(par.p.qty)
-						if pos.qty.Equal(utils.DecimalZeroMaxPrec) {
+						if pos.qty.Equal(utils.Fixed{}) {
 							d.bids.Remove(par.p.price)
 						}
 						changedPosition = pos
 					}
 				}
 			}
+			//原始深度变化之后更新合并深度
+			if changedPosition != nil {
+				for _, g := range d.groups {
+					pos := g.update(par.p.price, par.p.qty, par.side, par.op)
+					if pos == nil {
+						continue
+					}
+					if par.side == enum.Side_Buy {
+						g.bidsChangedPosition[pos.price.String()] = pos.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load())
+					} else {
+						g.asksChangedPosition[pos.price.String()] = pos.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load())
+					}
+				}
+			}
+			//撤单等操作的版本号可能不大于当前版本，深度的版本号需要严格递增
+			if par.version > d.currentVersion {
+				d.currentVersion = par.version
+			} else {
+				d.currentVersion++
+			}
 			d.plock.Unlock()
 			if par.side == enum.Side_Buy && changedPosition != nil {
 				d.bidsChangedPosition[par.p.price.String()] = changedPosition.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load())
@@ -160,7 +199,6 @@ func (d *DepthHandler) handeUpdateDepth() {
 			if par.side == enum.Side_Sell && changedPosition != nil {
 				d.asksChangedPosition[par.p.price.String()] = changedPosition.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load())
 			}
-			d.currentVersion = par.version
 		case <-d.t.C:
 			//定时发送改变的档位前端及时更新
 			if len(d.asksChangedPosition) == 0 && len(d.bidsChangedPosition) == 0 {
@@ -188,14 +226,37 @@ func (d *DepthHandler) handeUpdateDepth() {
 			depthData.CurrentVersion = d.currentVersion
 			depthData.Asks = askPositionList
 			depthData.Bids = bidPositionList
+			//只有这个协程修改深度，读取不需要加锁
+			depthData.Checksum = d.checksum(d.asks, d.bids)
 			d.ChangedPosition <- depthData
+			for _, g := range d.groups {
+				if asks, bids, ok := g.changed(); ok {
+					d.ChangedPosition <- DepthData{
+						Asks:           asks,
+						Bids:           bids,
+						LastVersion:    d.lastVersion,
+						CurrentVersion: d.currentVersion,
+						Group:          g.name,
+						Checksum:       d.checksum(g.asks, g.bids),
+					}
+				}
+			}
 			d.bidsChangedPosition = make(map[string]*Position, 10)
 			d.asksChangedPosition = make(map[string]*Position, 10)
 			d.lastVersion = d.currentVersion
+		case <-d.done:
+			d.t.Stop()
+			close(d.ChangedPosition)
+			return
 		}
 	}
 
 }
+
+// close 停止更新和推送深度
+func (d *DepthHandler) close() {
+	close(d.done)
+}
 func (d *DepthHandler) updateDepth(p *position, side enum.Side, op opType, version int64) {
 	par := &param{
 		p:       p,
@@ -203,38 +264,98 @@ func (d *DepthHandler) updateDepth(p *position, side enum.Side, op opType, versi
 		op:      op,
 		version: version,
 	}
-	logx.Debugf("updateDepth %+v op=%v side=%v", p.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load()), op, side)
+	logx.Debugf("updateDepth %v op=%v side=%v", p, op, side)
 	d.paramChan <- par
 }
 
+// 从快照恢复深度，覆盖当前的深度数据
+func (d *DepthHandler) restore(asks, bids []*position, version int64) {
+	d.plock.Lock()
+	defer d.plock.Unlock()
+	d.asks.Clear()
+	d.bids.Clear()
+	for _, p := range asks {
+		d.asks.Put(p.price, p)
+	}
+	for _, p := range bids {
+		d.bids.Put(p.price, p)
+	}
+	for _, g := range d.groups {
+		g.restore(asks, bids)
+	}
+	d.currentVersion = version
+	d.lastVersion = version
+}
+
 // 获取实时深度
 func (d *DepthHandler) getDepth(level int32) DepthData {
 	d.plock.RLock()
 	defer d.plock.RUnlock()
 	var depthData DepthData
-	asksIter := d.asks.Iterator()
-	a := make([]*Position, 0, d.asks.Size())
-	b := make([]*Position, 0, d.bids.Size())
-	for i := int32(0); asksIter.Next(); i++ {
-		if i >= level {
-			break
-		}
-		p := asksIter.Value().(*position)
-		a = append(a, p.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load()))
+	depthData.Bids = d.levels(d.bids, level, enum.Side_Buy)
+	depthData.Asks = d.levels(d.asks, level, enum.Side_Sell)
+	depthData.CurrentVersion = d.currentVersion
+	depthData.Checksum = d.checksum(d.asks, d.bids)
+	return depthData
+}
+
+// 获取合并深度，档位没有配置返回false
+func (d *DepthHandler) getGroupDepth(level int32, step string) (DepthData, bool) {
+	g, ok := d.group(step)
+	if !ok {
+		return DepthData{}, false
+	}
+	d.plock.RLock()
+	defer d.plock.RUnlock()
+	var depthData DepthData
+	depthData.Bids = d.levels(g.bids, level, enum.Side_Buy)
+	depthData.Asks = d.levels(g.asks, level, enum.Side_Sell)
+	depthData.CurrentVersion = d.currentVersion
+	depthData.Checksum = d.checksum(g.asks, g.bids)
+	depthData.Group = g.name
+	return depthData, true
+}
 
+// levels 深度的前level个档位，按照价格从大到小排列，卖盘取价格最低的level个档位
+func (d *DepthHandler) levels(tree *rbt.Tree, level int32, side enum.Side) []*Position {
+	positions := make([]*Position, 0, tree.Size())
+	iter := tree.Iterator()
+	next := iter.Next
+	if side == enum.Side_Sell {
+		iter.End()
+		next = iter.Prev
 	}
-	bidsIter := d.bids.Iterator()
-	for i := int32(0); bidsIter.Next(); i++ {
+	for i := int32(0); next(); i++ {
 		if i >= level {
 			break
 		}
-		p := bidsIter.Value().(*position)
-		b = append(b, p.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load()))
+		p := iter.Value().(*position)
+		positions = append(positions, p.castToPosition(d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load()))
 	}
-	depthData.Bids = b
-	depthData.Asks = a
-	depthData.CurrentVersion = d.lastVersion
-	return depthData
+	if side == enum.Side_Sell {
+		for i, j := 0, len(positions)-1; i < j; i, j = i+1, j-1 {
+			positions[i], positions[j] = positions[j], positions[i]
+		}
+	}
+	return positions
+}
+
+// checksum 深度前DepthChecksumLevel档的校验和，树是从大到小存储的，卖盘从后往前遍历
+func (d *DepthHandler) checksum(asks, bids *rbt.Tree) uint32 {
+	baseCoinPrec, quoteCoinPrec := d.c.SymbolInfo.BaseCoinPrec.Load(), d.c.SymbolInfo.QuoteCoinPrec.Load()
+	askLevels := make([][]string, 0, commonWs.DepthChecksumLevel)
+	askIter := asks.Iterator()
+	for askIter.End(); len(askLevels) < commonWs.DepthChecksumLevel && askIter.Prev(); {
+		p := askIter.Value().(*position).castToPosition(baseCoinPrec, quoteCoinPrec)
+		askLevels = append(askLevels, []string{p.Price, p.Qty})
+	}
+	bidLevels := make([][]string, 0, commonWs.DepthChecksumLevel)
+	bidIter := bids.Iterator()
+	for len(bidLevels) < commonWs.DepthChecksumLevel && bidIter.Next() {
+		p := bidIter.Value().(*position).castToPosition(baseCoinPrec, quoteCoinPrec)
+		bidLevels = append(bidLevels, []string{p.Price, p.Qty})
+	}
+	return commonWs.DepthChecksum(bidLevels, askLevels)
 }
 
 // 推送变化的档位
@@ -262,18 +383,18 @@ func (d *DepthHandler) pushChangedPosition() {
 			Symbol:         d.c.SymbolInfo.SymbolName,
 			Asks:           asks,
 			Bids:           bids,
+			Checksum:       data.Checksum,
+		}
+		//合并深度推送到单独的topic
+		topic := commonWs.DepthPrefix.WithParam(d.c.SymbolInfo.SymbolName)
+		if data.Group != "" {
+			topic = commonWs.DepthPrefix.WithParam(d.c.SymbolInfo.SymbolName, data.Group)
 		}
 		msg := commonWs.Message[commonWs.Depth]{
-			Topic:   commonWs.DepthPrefix.WithParam(d.c.SymbolInfo.SymbolName),
+			Topic:   topic,
 			Payload: depth,
 		}
-
-		d1 := &gpush.Data{
-			Uid:   "",
-			Topic: msg.Topic,
-			Data:  msg.ToBytes(),
-		}
-		if _, err := d.proxyClient.PushData(context.Background(), d1); err != nil {
+		if err := d.marketDataSink.PushMarketData(msg.Topic, msg.ToBytes()); err != nil {
 			logx.Errorw("push websocket data failed", logger.ErrorField(err))
 		}
 	}
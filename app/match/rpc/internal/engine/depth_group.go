@@ -0,0 +1,123 @@
+package engine
+
+import (
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	"github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 合并深度：按照配置的档位把相邻的价格合并成一个档位，每个档位单独维护一棵红黑树，和原始深度同时更新。
+// 卖盘向上取整，买盘向下取整，合并之后的买一价不会高于原来的买一价，卖一价不会低于原来的卖一价。
+// 合并深度推送到depth@交易对@档位，版本号和原始深度一致。
+
+type depthGroup struct {
+	step                utils.Fixed
+	name                string
+	asks                *rbt.Tree
+	bids                *rbt.Tree
+	asksChangedPosition map[string]*Position
+	bidsChangedPosition map[string]*Position
+}
+
+// newDepthGroups 根据配置创建合并深度，无效的档位忽略
+func newDepthGroups(steps []string) []*depthGroup {
+	groups := make([]*depthGroup, 0, len(steps))
+	for _, v := range steps {
+		step, err := utils.NewFixedFromString(v)
+		if err != nil || !step.IsPositive() {
+			logx.Errorw("invalid depth group", logx.Field("step", v))
+			continue
+		}
+		groups = append(groups, &depthGroup{
+			step:                step,
+			name:                step.String(),
+			asks:                rbt.NewWith(DepthComparator),
+			bids:                rbt.NewWith(DepthComparator),
+			asksChangedPosition: make(map[string]*Position, 10),
+			bidsChangedPosition: make(map[string]*Position, 10),
+		})
+	}
+	return groups
+}
+
+// groupPrice 价格合并之后的档位
+func (g *depthGroup) groupPrice(price utils.Fixed, side enum.Side) utils.Fixed {
+	n := price.DivRoundDown(g.step, 0)
+	if side == enum.Side_Sell && n.Mul(g.step).LessThan(price) {
+		n = n.Add(utils.NewFixed(1, 0))
+	}
+	return n.Mul(g.step)
+}
+
+// update 原始深度变化的数量累加到合并之后的档位，返回变化之后的档位
+func (g *depthGroup) update(price, qty utils.Fixed, side enum.Side, op opType) *position {
+	tree := g.bids
+	if side == enum.Side_Sell {
+		tree = g.asks
+	}
+	groupPrice := g.groupPrice(price, side)
+	value, found := tree.Get(groupPrice)
+	if !found {
+		if op != Add {
+			return nil
+		}
+		pos := &position{price: groupPrice, qty: qty}
+		tree.Put(groupPrice, pos)
+		return pos
+	}
+	pos := value.(*position)
+	if op == Add {
+		pos.qty = pos.qty.Add(qty)
+	} else {
+		pos.qty = pos.qty.Sub(qty)
+		if pos.qty.Equal(utils.Fixed{}) {
+			tree.Remove(groupPrice)
+		}
+	}
+	return pos
+}
+
+// changed 取出变化的档位，没有变化返回false
+func (g *depthGroup) changed() (asks, bids []*Position, ok bool) {
+	if len(g.asksChangedPosition) == 0 && len(g.bidsChangedPosition) == 0 {
+		return nil, nil, false
+	}
+	asks = make([]*Position, 0, len(g.asksChangedPosition))
+	for _, v := range g.asksChangedPosition {
+		asks = append(asks, v)
+	}
+	bids = make([]*Position, 0, len(g.bidsChangedPosition))
+	for _, v := range g.bidsChangedPosition {
+		bids = append(bids, v)
+	}
+	g.asksChangedPosition = make(map[string]*Position, 10)
+	g.bidsChangedPosition = make(map[string]*Position, 10)
+	return asks, bids, true
+}
+
+// restore 从原始深度重新计算合并深度
+func (g *depthGroup) restore(asks, bids []*position) {
+	g.asks.Clear()
+	g.bids.Clear()
+	for _, p := range asks {
+		g.update(p.price, p.qty, enum.Side_Sell, Add)
+	}
+	for _, p := range bids {
+		g.update(p.price, p.qty, enum.Side_Buy, Add)
+	}
+}
+
+// group 根据档位查找合并深度
+func (d *DepthHandler) group(step string) (*depthGroup, bool) {
+	s, err := utils.NewFixedFromString(step)
+	if err != nil {
+		return nil, false
+	}
+	for _, g := range d.groups {
+		if g.step.Equal(s) {
+			return g, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"github.com/zeromicro/go-zero/core/logx"
+	"time"
+)
+
+// 限时单：限价单可以指定过期时间，到期之后撮合引擎撤销订单未成交的部分，撤单消息标记为过期，订单服务把订单状态改为过期。
+// 订单簿按照过期时间维护索引，处理每个消息之前检查一次，没有新的消息时主节点到时间之后发送定时消息到match_source，处理定时消息时检查。
+// 过期时间以撮合引擎的时间为准，撮合服务中撮合引擎的时间是消息的发送时间，主备节点和重放在相同的消息撤销相同的订单。
+// 重启之后从订单服务加载的订单如果已经过期立即撤销。
+
+// expiryKey 限时单在过期时间索引中的key，过期时间相同按照订单id排序
+type expiryKey struct {
+	expireTime int64
+	seq        int64
+}
+
+func expiryComparator(a, b interface{}) int {
+	aAsserted := a.(*expiryKey)
+	bAsserted := b.(*expiryKey)
+	switch {
+	case aAsserted.expireTime > bAsserted.expireTime:
+		return 1
+	case aAsserted.expireTime < bAsserted.expireTime:
+		return -1
+	case aAsserted.seq > bAsserted.seq:
+		return 1
+	case aAsserted.seq < bAsserted.seq:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// isExpired 订单是否已经过期
+func (o *Order) isExpired(now time.Time) bool {
+	return o.ExpireTime != 0 && o.ExpireTime <= now.Unix()
+}
+
+// nextExpiry 订单簿中最早的过期时间，没有限时单返回0
+func (m *MatchEngine) nextExpiry() int64 {
+	var next int64
+	for _, book := range []*OrderBook{m.bids, m.asks} {
+		if node := book.expiry.Left(); node != nil {
+			if t := node.Key.(*expiryKey).expireTime; next == 0 || t < next {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+// NextTimer 下一个订单过期或者集合竞价结束的时间，都没有返回零值。
+// 主节点到时间之后发送定时消息，不修改订单簿，必须和HandleOrder在同一个协程中调用。
+func (m *MatchEngine) NextTimer() time.Time {
+	var next time.Time
+	if t := m.nextExpiry(); t != 0 {
+		next = time.Unix(t, 0)
+	}
+	if m.auctionUntil != 0 {
+		if t := time.Unix(0, m.auctionUntil); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	return next
+}
+
+// HandleTimer 处理定时消息，撤销已经过期的订单，集合竞价结束时统一撮合。
+// 调用之前撮合引擎的时间要推进到定时消息的时间。
+func (m *MatchEngine) HandleTimer() {
+	defer m.flushL3()
+	m.expireOrders()
+	m.inAuction()
+}
+
+// expireOrders 撤销订单簿中已经过期的订单
+func (m *MatchEngine) expireOrders() {
+	now := m.now()
+	expired := 0
+	for _, book := range []*OrderBook{m.bids, m.asks} {
+		for node := book.expiry.Left(); node != nil; node = book.expiry.Left() {
+			order := node.Value.(*Order)
+			if !order.isExpired(now) {
+				break
+			}
+			m.cancelOrder(order)
+			m.depthHandler.updateDepth(&position{
+				price: order.Price,
+				qty:   order.depthQty(),
+			}, order.Side, Delete, m.currentSeqId)
+			m.expireUnfilled(order)
+			expired++
+		}
+	}
+	if expired == 0 {
+		return
+	}
+	logx.Infow("expire orders", logx.Field("orders", expired))
+	if m.auctionUntil != 0 && now.UnixNano() < m.auctionUntil {
+		m.pushIndicative()
+	}
+}
+
+// expireUnfilled 过期撤销订单未成交的部分
+func (m *MatchEngine) expireUnfilled(order *Order) {
+	resp := m.newCancelResp(order)
+	resp.Expired = true
+	m.SendMatchResult(&MatchResult{
+		CancelResp: resp,
+		MatchTime:  m.now().UnixNano(),
+	})
+}
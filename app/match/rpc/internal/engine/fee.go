@@ -0,0 +1,25 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+)
+
+// 手续费按照下单时确定的费率收取，从收到的币中扣除，买单收取基础币，卖单收取计价币。
+// 手续费保留到收到的币的精度，买单为基础币精度，卖单为计价币精度，更小的部分舍去，不多收用户的手续费。
+
+// chargeFee 计算本次匹配taker和maker的手续费，累加到订单的手续费中
+func (m *MatchEngine) chargeFee(taker, maker *Order, record *MatchedRecord) {
+	record.TakerFee = m.orderFee(taker.Side, taker.TakerFeeRate, record)
+	record.MakerFee = m.orderFee(maker.Side, maker.MakerFeeRate, record)
+	taker.Fee = taker.Fee.Add(record.TakerFee)
+	maker.Fee = maker.Fee.Add(record.MakerFee)
+}
+
+func (m *MatchEngine) orderFee(side enum.Side, rate utils.Fixed, record *MatchedRecord) utils.Fixed {
+	if side == enum.Side_Buy {
+		return record.Qty.MulRoundDown(rate, m.qtyExp)
+	}
+	//计价币的精度和价格的精度相同
+	return record.Amount.MulRoundDown(rate, m.priceExp)
+}
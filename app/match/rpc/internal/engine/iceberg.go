@@ -0,0 +1,87 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+)
+
+// 冰山单只在深度中显示一部分数量，显示的部分全部成交之后从隐藏的部分补充，补充之后排到相同价格的订单后面。
+// 订单的未成交数量包括隐藏的部分，冻结、撤单、修改订单和撮合结果都按照全部的数量处理，作为maker撮合时只和显示的部分成交。
+
+// isIceberg 是否是冰山单
+func (o *Order) isIceberg() bool {
+	return o.DisplayQty.IsPositive()
+}
+
+// depthQty 订单在深度中显示的数量
+func (o *Order) depthQty() utils.Fixed {
+	if o.isIceberg() {
+		return o.VisibleQty
+	}
+	return o.UnfilledQty
+}
+
+// resetVisible 从隐藏的部分补充显示的数量
+func (o *Order) resetVisible() {
+	if o.isIceberg() {
+		o.VisibleQty = utils.MinFixed(o.DisplayQty, o.UnfilledQty)
+	}
+}
+
+// clampVisible 未成交数量减少之后，显示的数量不能超过未成交数量
+func (o *Order) clampVisible() {
+	if o.isIceberg() {
+		o.VisibleQty = utils.MinFixed(o.VisibleQty, o.UnfilledQty)
+	}
+}
+
+// hideIceberg maker撮合之前隐藏冰山单没有显示的部分，只和显示的部分成交，返回隐藏的数量
+func (o *Order) hideIceberg() utils.Fixed {
+	if !o.isIceberg() {
+		return utils.Fixed{}
+	}
+	hidden := o.UnfilledQty.Sub(o.VisibleQty)
+	o.UnfilledQty = o.VisibleQty
+	o.UnfilledAmount = o.UnfilledAmount.Sub(hidden.Mul(o.Price))
+	return hidden
+}
+
+// showIceberg maker撮合之后恢复隐藏的数量，显示的部分成交完但是还有隐藏的数量，订单是部分成交
+func (o *Order) showIceberg(hidden utils.Fixed) {
+	if !o.isIceberg() {
+		return
+	}
+	o.VisibleQty = o.UnfilledQty
+	if !hidden.IsPositive() {
+		return
+	}
+	o.UnfilledQty = o.UnfilledQty.Add(hidden)
+	o.UnfilledAmount = o.UnfilledAmount.Add(hidden.Mul(o.Price))
+	if o.OrderStatus == enum.OrderStatus_ALLFilled {
+		o.OrderStatus = enum.OrderStatus_PartFilled
+	}
+}
+
+// needReplenish 显示的部分全部成交，还有隐藏的数量
+func (o *Order) needReplenish() bool {
+	return o.isIceberg() && !o.VisibleQty.IsPositive() && o.UnfilledQty.IsPositive()
+}
+
+// replenishIceberg 冰山单补充显示的数量，排到相同价格的订单后面。
+// 遍历订单簿的时候不能修改订单簿，先删除已经撮合完的订单再重新加入冰山单，调用方重新开始遍历，返回清空之后的待删除的订单。
+// 新的排队序号和改单一样使用id生成器，比档位中已有订单的序号大，快照中保存订单的排队序号，
+// 同时放到这次成交的maker中，订单服务保存之后重启可以恢复冰山单的位置。
+func (m *MatchEngine) replenishIceberg(book *OrderBook, order *Order, deletedOrders []*Order, record *MatchedRecord) []*Order {
+	for _, v := range deletedOrders {
+		book.remove(v)
+	}
+	book.remove(order)
+	order.QueueId = m.nextId()
+	record.Maker.QueueId = order.QueueId
+	m.addOrder(order)
+	m.depthHandler.updateDepth(&position{
+		price: order.Price,
+		qty:   order.VisibleQty,
+	}, order.Side, Add, m.currentSeqId)
+	return deletedOrders[:0]
+}
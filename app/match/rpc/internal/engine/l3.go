@@ -0,0 +1,205 @@
+package engine
+
+import (
+	"github.com/luxun9527/gex/app/match/rpc/internal/config"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	commonWs "github.com/luxun9527/gex/common/proto/ws"
+	"github.com/luxun9527/gex/common/utils"
+	logger "github.com/luxun9527/zlog"
+	"github.com/zeromicro/go-zero/core/logx"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 逐笔委托：订单进入订单簿、订单簿中的数量变化、离开订单簿时按照订单id推送新增、修改、删除事件，事件的序号连续递增。
+// 一次操作产生的事件在操作结束之后统一应用到逐笔委托的订单簿再推送，快照的序号和订单簿的版本一致。
+// 客户端先订阅逐笔委托并缓存，再获取快照，丢弃序号小于等于快照序号的事件，序号不连续时重新获取快照。
+// 修改订单重新排队、冰山单补充显示的数量都是先删除再新增，相同价格的订单按照排队的序号排序。
+
+type l3Event int8
+
+const (
+	l3Add l3Event = iota + 1
+	l3Modify
+	l3Delete
+)
+
+// L3Order 逐笔委托中的订单，不包含用户id
+type L3Order struct {
+	Id    int64
+	Side  enum.Side
+	Price utils.Fixed
+	Qty   utils.Fixed //冰山单只有显示的数量
+	Queue int64       //排队的序号，和订单簿中的顺序一致
+}
+
+// L3Data 逐笔委托的快照
+type L3Data struct {
+	Asks    []*L3Order
+	Bids    []*L3Order
+	Version int64 //订单簿版本
+	Seq     int64 //最后一个逐笔委托的序号
+}
+
+type l3Change struct {
+	event l3Event
+	order L3Order
+}
+
+type L3Handler struct {
+	lock           sync.RWMutex
+	orders         map[int64]*L3Order
+	seq            int64
+	version        int64
+	pending        []l3Change //当前操作产生的事件，操作结束之后统一应用
+	marketDataSink MarketDataSink
+	c              *config.Config
+}
+
+func NewL3Handler(c *config.Config, marketDataSink MarketDataSink) *L3Handler {
+	return &L3Handler{
+		orders:         make(map[int64]*L3Order),
+		pending:        make([]l3Change, 0, 8),
+		marketDataSink: marketDataSink,
+		c:              c,
+	}
+}
+
+// record 记录订单在订单簿中的变化，必须和HandleOrder在同一个协程中调用
+func (l *L3Handler) record(event l3Event, order *Order) {
+	qty := order.depthQty()
+	switch {
+	case event == l3Delete:
+		qty = utils.Fixed{}
+	//全部成交或者冰山单需要补充的订单之后会删除
+	case event == l3Modify && !qty.IsPositive():
+		return
+	}
+	l.pending = append(l.pending, l3Change{
+		event: event,
+		order: newL3Order(order, qty),
+	})
+}
+
+func newL3Order(order *Order, qty utils.Fixed) L3Order {
+	queue := order.QueueId
+	if queue == 0 {
+		queue = order.SequenceId
+	}
+	return L3Order{
+		Id:    order.SequenceId,
+		Side:  order.Side,
+		Price: order.Price,
+		Qty:   qty,
+		Queue: queue,
+	}
+}
+
+// flush 操作结束之后应用这次操作的事件并推送
+func (l *L3Handler) flush(version int64, now time.Time) {
+	if len(l.pending) == 0 {
+		return
+	}
+	baseCoinPrec, quoteCoinPrec := l.c.SymbolInfo.BaseCoinPrec.Load(), l.c.SymbolInfo.QuoteCoinPrec.Load()
+	data := make([]commonWs.L3, 0, len(l.pending))
+	l.lock.Lock()
+	for _, v := range l.pending {
+		l.seq++
+		switch v.event {
+		case l3Add:
+			order := v.order
+			l.orders[order.Id] = &order
+		case l3Modify:
+			if order, ok := l.orders[v.order.Id]; ok {
+				order.Qty = v.order.Qty
+			}
+		case l3Delete:
+			delete(l.orders, v.order.Id)
+		}
+		data = append(data, commonWs.L3{
+			Symbol:    l.c.SymbolInfo.SymbolName,
+			Seq:       l.seq,
+			Version:   version,
+			Event:     int8(v.event),
+			Id:        v.order.Id,
+			Side:      int8(v.order.Side),
+			Price:     v.order.Price.StringFixedBank(quoteCoinPrec),
+			Qty:       v.order.Qty.StringFixedBank(baseCoinPrec),
+			Queue:     v.order.Queue,
+			TimeStamp: now.Unix(),
+		})
+	}
+	l.version = version
+	l.pending = l.pending[:0]
+	l.lock.Unlock()
+
+	for _, v := range data {
+		msg := commonWs.Message[commonWs.L3]{
+			Topic:   commonWs.L3Prefix.WithParam(l.c.SymbolInfo.SymbolName),
+			Payload: v,
+		}
+		if err := l.marketDataSink.PushMarketData(msg.Topic, msg.ToBytes()); err != nil {
+			logx.Errorw("push l3 websocket data failed", logger.ErrorField(err), logx.Field("data", v))
+		}
+	}
+}
+
+// restore 从快照恢复，丢弃恢复订单簿时记录的事件
+func (l *L3Handler) restore(seq, version int64, books ...*OrderBook) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.orders = make(map[int64]*L3Order)
+	l.pending = l.pending[:0]
+	l.seq = seq
+	l.version = version
+	for _, book := range books {
+		for _, v := range book.values() {
+			order := newL3Order(v, v.depthQty())
+			l.orders[order.Id] = &order
+		}
+	}
+}
+
+// snapshot 按照价格和排队的序号排序的逐笔委托快照
+func (l *L3Handler) snapshot() L3Data {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	data := L3Data{
+		Asks:    make([]*L3Order, 0, len(l.orders)),
+		Bids:    make([]*L3Order, 0, len(l.orders)),
+		Version: l.version,
+		Seq:     l.seq,
+	}
+	for _, v := range l.orders {
+		order := *v
+		if order.Side == enum.Side_Sell {
+			data.Asks = append(data.Asks, &order)
+		} else {
+			data.Bids = append(data.Bids, &order)
+		}
+	}
+	sort.Slice(data.Asks, func(i, j int) bool {
+		if c := data.Asks[i].Price.Cmp(data.Asks[j].Price); c != 0 {
+			return c < 0
+		}
+		return data.Asks[i].Queue < data.Asks[j].Queue || (data.Asks[i].Queue == data.Asks[j].Queue && data.Asks[i].Id < data.Asks[j].Id)
+	})
+	sort.Slice(data.Bids, func(i, j int) bool {
+		if c := data.Bids[i].Price.Cmp(data.Bids[j].Price); c != 0 {
+			return c > 0
+		}
+		return data.Bids[i].Queue < data.Bids[j].Queue || (data.Bids[i].Queue == data.Bids[j].Queue && data.Bids[i].Id < data.Bids[j].Id)
+	})
+	return data
+}
+
+// GetL3Snapshot 获取逐笔委托的快照，可以在其他协程中调用
+func (m *MatchEngine) GetL3Snapshot() L3Data {
+	return m.l3.snapshot()
+}
+
+// flushL3 每次操作结束之后推送逐笔委托
+func (m *MatchEngine) flushL3() {
+	m.l3.flush(m.currentSeqId, m.now())
+}
@@ -0,0 +1,145 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/spf13/cast"
+)
+
+// 市价单的滑点保护：订单可以带最大滑点或者保护价格，最大滑点按照订单撮合时对手盘一档的价格计算边界，
+// 和交易对的价格保护一起取最严格的边界，超出边界的部分不成交，剩余的撤销。
+// 按照数量的市价买单：下单时按照深度冻结最坏情况下的计价币金额，撮合时同时受剩余数量和冻结金额的限制，
+// 全部成交之后没有用完的金额在结算时退回，没有全部成交时剩余的金额在撤单消息中解冻。
+
+// isMarketBuyByQty 是否是按照基础币数量的市价买单
+func (o *Order) isMarketBuyByQty() bool {
+	return o.ByQty && o.OrderType == enum.OrderType_MO && o.Side == enum.Side_Buy
+}
+
+// marketPriceLimit 市价单可以成交的价格边界，买单为上限，卖单为下限，取价格保护、订单的保护价格和最大滑点中最严格的。
+// 最大滑点的边界按照价格的精度取整到范围之内，买单向下取整，卖单向上取整。
+func (m *MatchEngine) marketPriceLimit(order *Order) (utils.Fixed, bool) {
+	limit, ok := m.priceBandLimit(order.Side)
+	tighten := func(price utils.Fixed) {
+		if !price.IsPositive() {
+			return
+		}
+		if !ok || (order.Side == enum.Side_Buy && price.LessThan(limit)) || (order.Side == enum.Side_Sell && price.GreaterThan(limit)) {
+			limit, ok = price, true
+		}
+	}
+	tighten(order.ProtectPrice)
+	best := m.bestAsk
+	if order.Side == enum.Side_Sell {
+		best = m.bestBid
+	}
+	if order.MaxSlippage.IsPositive() && best.IsPositive() {
+		d := best.MulRoundDown(order.MaxSlippage, m.priceExp)
+		if order.Side == enum.Side_Buy {
+			tighten(best.Add(d))
+		} else {
+			tighten(best.Sub(d))
+		}
+	}
+	return limit, ok
+}
+
+// matchMarketOrderBuyByQty 按照基础币数量的市价买单，每次成交的数量取taker剩余的数量、maker剩余的数量和剩余金额能买的数量中最小的。
+// 剩余金额按照maker的价格不够买一个最小单位时停止撮合，剩余的部分撤销。
+func (m *MatchEngine) matchMarketOrderBuyByQty(takerOrder *Order) {
+	matchedResult := &MatchResult{
+		MatchedRecords: make([]*MatchedRecord, 0, 2),
+		TakerIsBuy:     true,
+	}
+	iterator := m.asks.iterator()
+	deletedOrders := make([]*Order, 0, 2)
+	limit, hasLimit := m.marketPriceLimit(takerOrder)
+	for iterator.Next() {
+		makerOrder := iterator.Order()
+		//超出价格保护范围或者订单的滑点保护的部分不成交，剩余的撤销
+		if hasLimit && makerOrder.Price.GreaterThan(limit) {
+			break
+		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				deletedOrders = append(deletedOrders, makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
+		//冰山单只和显示的部分成交
+		hidden := makerOrder.hideIceberg()
+		qty := utils.MinFixed(takerOrder.UnfilledQty, makerOrder.UnfilledQty, takerOrder.UnfilledAmount.DivRoundDown(makerOrder.Price, m.qtyExp))
+		if !qty.IsPositive() {
+			makerOrder.showIceberg(hidden)
+			break
+		}
+		amount := qty.Mul(makerOrder.Price)
+		takerOrder.UnfilledQty = takerOrder.UnfilledQty.Sub(qty)
+		takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)
+		takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(amount)
+		takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
+		makerOrder.UnfilledQty = makerOrder.UnfilledQty.Sub(qty)
+		makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)
+		makerOrder.UnfilledAmount = makerOrder.UnfilledAmount.Sub(amount)
+		makerOrder.FilledAmount = makerOrder.FilledAmount.Add(amount)
+		takerOrder.OrderStatus = enum.OrderStatus_PartFilled
+		if !takerOrder.UnfilledQty.IsPositive() {
+			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
+		}
+		makerOrder.OrderStatus = enum.OrderStatus_PartFilled
+		if !makerOrder.UnfilledQty.IsPositive() {
+			makerOrder.OrderStatus = enum.OrderStatus_ALLFilled
+			makerOrder.UnfilledAmount = utils.Fixed{}
+			deletedOrders = append(deletedOrders, makerOrder)
+		}
+		matchedRecord := &MatchedRecord{
+			Price:  makerOrder.Price,
+			Qty:    qty,
+			Amount: amount,
+		}
+		makerOrder.showIceberg(hidden)
+		m.asks.modify(makerOrder)
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
+		matchedRecord.Taker = *takerOrder
+		matchedRecord.Maker = *makerOrder
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
+		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//冰山单显示的部分成交完，补充之后重新排队，从头开始遍历
+		if makerOrder.needReplenish() {
+			deletedOrders = m.replenishIceberg(m.asks, makerOrder, deletedOrders, matchedRecord)
+			iterator = m.asks.iterator()
+		}
+		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled {
+			break
+		}
+	}
+	//删除卖盘中被匹配完的订单，更新卖一价
+	if len(deletedOrders) > 0 {
+		for _, v := range deletedOrders {
+			m.asks.remove(v)
+		}
+		m.updateBestAsk()
+	}
+	//更新深度数据
+	for _, record := range matchedResult.MatchedRecords {
+		m.depthHandler.updateDepth(&position{
+			price: record.Price,
+			qty:   record.Qty,
+		}, enum.Side_Sell, Delete, m.currentSeqId)
+	}
+	matchedResult.MatchTime = m.now().UnixNano()
+	matchedResult.MatchID = cast.ToString(m.nextId())
+	if len(matchedResult.MatchedRecords) > 0 {
+		m.SendMatchResult(matchedResult)
+	}
+	m.sendSTPCancels()
+	//全部成交时剩余的金额随撮合结果退回，否则撤销剩余的部分解冻剩余的金额
+	if takerOrder.OrderStatus != enum.OrderStatus_ALLFilled {
+		m.cancelUnfilled(takerOrder)
+	}
+}
@@ -1,24 +1,16 @@
 package engine
 
 import (
-	"context"
 	"fmt"
-	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/luxun9527/gex/app/match/rpc/internal/config"
 	enum "github.com/luxun9527/gex/common/proto/enum"
 	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
 	commonWs "github.com/luxun9527/gex/common/proto/ws"
 	"github.com/luxun9527/gex/common/utils"
-	ws "github.com/luxun9527/gpush/proto"
 	logger "github.com/luxun9527/zlog"
-	"github.com/shopspring/decimal"
 	"github.com/spf13/cast"
 	"github.com/yitter/idgenerator-go/idgen"
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stringx"
-	"google.golang.org/protobuf/proto"
-	"log"
-	"math"
 	"time"
 )
 
@@ -30,17 +22,27 @@ import (
 	-判断价格是否匹配(买单价格>=卖单价格)
 	-按照时间优先、价格优先原则撮合
 	-部分成交的订单继续留在订单簿中
+	-FOK撮合前检查对手盘数量是否足够全部成交,不够则整单撤销
+	-IOC未成交的部分立即撤销,不进入订单簿
+	-PostOnly(只做maker)的限价单如果会立即成交则整单撤销,保证只作为maker进入订单簿
+	-条件单(止损限价、止损市价)在触发之前放在条件单簿中,最新成交价达到触发价后按照限价单或市价单撮合
+	-修改订单只减少数量时保持排队的优先级,修改价格或者增加数量时重新撮合并排到相同价格的订单后面
+	-冰山单在深度中只显示一部分数量,作为maker只和显示的部分成交,显示的部分成交完之后补充并排到相同价格的订单后面
+	-开盘之前和熔断期间的集合竞价只接受限价单不撮合,结束时按照成交量最大的价格统一撮合
+	-限时单到期之后撤销订单簿中未成交的部分,订单状态为过期
 2.市价单撮合:
 	-市价买单按金额撮合,从卖一价开始往上吃单
 	-市价卖单按数量撮合,从买一价开始往下吃单
 	-直到完全成交或无对手方
+	-配置了价格保护时，超出最新成交价上下一定比例的部分不成交，剩余的撤销
 
 3.撮合结果处理:
 	-更新订单状态
 	-发送撮合结果消息
 	-更新深度数据
 	-推送行情数据
-整个撮合引擎基于内存撮合,使用红黑树存储订单簿,保证了订单按价格排序的高效性。撮合结果通过消息队列异步处理,保证了撮合的高性能。
+	-推送逐笔委托,按照订单id推送订单簿中每个订单的新增、修改、删除
+整个撮合引擎基于内存撮合,订单簿用红黑树按价格排序档位,每个档位是按排队顺序的链表,订单id索引订单,撤单O(1)。撮合结果通过消息队列异步处理,保证了撮合的高性能。
 
 这是一个典型的交易所撮合引擎实现,包含了订单簿管理、价格撮合、深度维护等核心功能。代码结构清晰,性能优化合理。	
 
@@ -62,24 +64,58 @@ import (
 type MatchEngine struct {
 	asks             *OrderBook      //卖盘
 	bids             *OrderBook      //买盘
-	bestBid          decimal.Decimal //买一价
-	bestAsk          decimal.Decimal //卖一价
-	baseCoinMinUnit  decimal.Decimal
-	quoteCoinMinUnit decimal.Decimal
+	bestBid          utils.Fixed     //买一价
+	bestAsk          utils.Fixed     //卖一价
+	priceExp         int32           //价格的指数，计价币精度的负数
+	qtyExp           int32           //数量的指数，基础币精度的负数，金额和手续费的指数是两者之和
 	depthHandler     *DepthHandler
+	l3               *L3Handler
 	c                *config.Config
-	producer         pulsar.Producer
-	proxyClient      ws.ProxyClient
+	resultSink       ResultSink     //撮合结果的输出
+	marketDataSink   MarketDataSink //行情数据的输出
 	tick             chan *MatchResult
 	currentSeqId     int64
+	buyTriggers      *TriggerBook     //买方向条件单
+	sellTriggers     *TriggerBook     //卖方向条件单
+	triggerOrders    map[int64]*Order //未触发的条件单,用于撤单时查找
+	lastPrice        utils.Fixed  //最新成交价
+	priceWindow      []PricePoint     //熔断统计窗口内的成交价
+	haltUntil        int64            //熔断恢复交易的时间,为零表示没有熔断
+	manualHalt       bool             //人工暂停交易,恢复之前只接受撤单
+	rule             *fixedTradingRule     //撮合引擎指数下的交易规则
+	protection       *fixedPriceProtection //撮合引擎指数下的价格保护配置
+	auctionUntil     int64            //集合竞价结束的时间,为零表示不在集合竞价
+	stpCancels       []stpCancel      //自成交保护产生的撤单消息
+	resultSeq        int64            //撮合结果序号，从快照恢复后重放产生的消息id不变，下游根据消息id去重
+	nextId           func() int64     //生成撮合id,默认使用雪花算法
+	now              func() time.Time //撮合时间,默认使用系统时间
+}
+
+// Option 撮合引擎的可选配置,重放的时候注入id和时间，保证每次重放的结果一致。
+type Option func(m *MatchEngine)
+
+// WithIdGenerator 自定义撮合id的生成
+func WithIdGenerator(nextId func() int64) Option {
+	return func(m *MatchEngine) {
+		m.nextId = nextId
+	}
+}
+
+// WithClock 自定义撮合时间
+func WithClock(now func() time.Time) Option {
+	return func(m *MatchEngine) {
+		m.now = now
+	}
 }
 
 // MatchedRecord  一次撮合匹配的结果,一次撮合会多次匹配
 type MatchedRecord struct {
-	Price           decimal.Decimal
-	Qty             decimal.Decimal
-	Amount          decimal.Decimal
+	Price           utils.Fixed
+	Qty             utils.Fixed
+	Amount          utils.Fixed
 	MatchedRecordID string
+	//本次匹配taker和maker的手续费
+	TakerFee, MakerFee utils.Fixed
 	//最新的taker订单的状态
 	Taker Order
 	//最新的maker订单的状态
@@ -91,6 +127,10 @@ type MatchResult struct {
 	//本次撮合的id
 	MatchID    string
 	CancelResp *CancelResp
+	//条件单触发
+	TriggerResp *TriggerResp
+	//修改订单
+	AmendResp *AmendResp
 	//撮合时间
 	MatchTime int64
 	//taker是否是买单
@@ -105,6 +145,26 @@ type CancelResp struct {
 	Qty string
 	//用户id
 	Uid int64
+	//自成交保护减少的数量,不为空表示订单只是减少了数量,没有撤销
+	DecrementQty string
+	//减少之后的未成交数量和金额
+	UnfilledQty, UnfilledAmount string
+	//减少之后的订单数量和金额
+	OrderQty, OrderAmount string
+	//是否是限时单过期撤销
+	Expired bool
+}
+type TriggerResp struct {
+	//条件单的id
+	TriggerId int64
+	//用户id
+	Uid int64
+	//订单id
+	OrderId string
+	//触发价格
+	TriggerPrice utils.Fixed
+	//触发时的最新成交价
+	LastPrice utils.Fixed
 }
 
 func (mr *MatchResult) println() {
@@ -119,23 +179,43 @@ func (mr *MatchResult) println() {
 
 }
 
-func NewMatchEngine(c *config.Config, producer pulsar.Producer, proxyClient ws.ProxyClient) *MatchEngine {
+func NewMatchEngine(c *config.Config, resultSink ResultSink, marketDataSink MarketDataSink, opts ...Option) *MatchEngine {
+	l3 := NewL3Handler(c, marketDataSink)
 	me := &MatchEngine{
-		asks:         NewOrderBook(enum.Side_Sell),
-		bids:         NewOrderBook(enum.Side_Buy),
-		bestBid:      utils.DecimalZeroMaxPrec,
-		bestAsk:      utils.DecimalZeroMaxPrec,
-		depthHandler: NewDepthHandler(0, c, proxyClient),
-		c:            c,
-		producer:     producer,
-		proxyClient:  proxyClient,
-		tick:         make(chan *MatchResult, 10),
+		asks:           NewOrderBook(enum.Side_Sell, l3),
+		bids:           NewOrderBook(enum.Side_Buy, l3),
+		bestBid:        utils.Fixed{},
+		bestAsk:        utils.Fixed{},
+		depthHandler:   NewDepthHandler(0, c, marketDataSink),
+		l3:             l3,
+		c:              c,
+		resultSink:     resultSink,
+		marketDataSink: marketDataSink,
+		tick:           make(chan *MatchResult, 10),
+		buyTriggers:    NewTriggerBook(enum.Side_Buy),
+		sellTriggers:   NewTriggerBook(enum.Side_Sell),
+		triggerOrders:  make(map[int64]*Order),
+		lastPrice:      utils.Fixed{},
+		priceExp:       -c.SymbolInfo.QuoteCoinPrecValue,
+		qtyExp:         -c.SymbolInfo.BaseCoinPrecValue,
+		nextId:         idgen.NextId,
+		now:            time.Now,
 	}
+	for _, opt := range opts {
+		opt(me)
+	}
+	//金额是价格乘以数量，指数不能超出定点数的范围
+	if c.SymbolInfo.QuoteCoinPrecValue < 0 || c.SymbolInfo.BaseCoinPrecValue < 0 || me.priceExp+me.qtyExp < -utils.MaxPrec {
+		logx.Severef("invalid prec baseCoinPrec = %v quoteCoinPrec = %v", c.SymbolInfo.BaseCoinPrecValue, c.SymbolInfo.QuoteCoinPrecValue)
+	}
+	me.resultSeq = me.now().UnixNano()
 	go me.sendTick()
 	return me
 }
 
 func (m *MatchEngine) addOrder(order *Order) {
+	//冰山单进入订单簿时显示新的一部分
+	order.resetVisible()
 	if order.Side == enum.Side_Buy {
 		m.bids.add(order)
 		m.updateBestBid()
@@ -155,22 +235,22 @@ func (m *MatchEngine) cancelOrder(order *Order) {
 	}
 }
 
+// orderBook 订单所在方向的订单簿
+func (m *MatchEngine) orderBook(side enum.Side) *OrderBook {
+	if side == enum.Side_Buy {
+		return m.bids
+	}
+	return m.asks
+}
+
 // 更新买一价
 func (m *MatchEngine) updateBestBid() {
-	if m.bids.orderBook.Size() == 0 {
-		m.bestBid = utils.DecimalZeroMaxPrec
-	} else {
-		m.bestBid = m.bids.orderBook.Left().Key.(*Key).price
-	}
+	m.bestBid, _ = m.bids.bestPrice()
 }
 
 // 更新卖一价
 func (m *MatchEngine) updateBestAsk() {
-	if m.asks.orderBook.Size() == 0 {
-		m.bestAsk = utils.DecimalZeroMaxPrec
-	} else {
-		m.bestAsk = m.asks.orderBook.Left().Key.(*Key).price
-	} 
+	m.bestAsk, _ = m.asks.bestPrice()
 }
 
 // 匹配市价单卖单
@@ -181,7 +261,7 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 		TakerIsBuy:     false,
 	}
 	//如果没有买盘，直接取消订单
-	if m.bids.orderBook.Size() == 0 {
+	if m.bids.size() == 0 {
 		matchedResult.CancelResp = &CancelResp{
 			CancelId: takerOrder.SequenceId,
 			CoinId:   m.c.SymbolInfo.BaseCoinID,
@@ -192,11 +272,33 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 		return
 	}
 
-	iterator := m.bids.orderBook.Iterator()
+	iterator := m.bids.iterator()
 	var matchedRecord *MatchedRecord
-	deletedKeys := make([]*Key, 0, 2)
+	deletedOrders := make([]*Order, 0, 2)
+	bandLimit, hasBand := m.marketPriceLimit(takerOrder)
 	for iterator.Next() {
-		makerOrder := iterator.Value().(*Order)
+		//taker全部成交之后结束，不再和之后的订单做自成交保护
+		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled {
+			break
+		}
+		makerOrder := iterator.Order()
+		//超出价格保护范围或者订单的滑点保护的部分不成交，剩余的撤销
+		if hasBand && makerOrder.Price.LessThan(bandLimit) {
+			break
+		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				deletedOrders = append(deletedOrders, makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
+		//冰山单只和显示的部分成交
+		hidden := makerOrder.hideIceberg()
 		result := takerOrder.UnfilledQty.Cmp(makerOrder.UnfilledQty)
 		switch {
 		case result == 1:
@@ -207,8 +309,8 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 			amount := makerOrder.UnfilledAmount
 			takerOrder.UnfilledQty = takerOrder.UnfilledQty.Sub(qty)
 			//takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(amount)
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 			takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
@@ -220,8 +322,8 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case result == 0:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			makerOrder.OrderStatus = enum.OrderStatus_ALLFilled
@@ -229,10 +331,10 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 			//更新订单的剩余数量
 			qty := makerOrder.UnfilledQty
 			amount := makerOrder.UnfilledAmount
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 			takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量
 
@@ -245,8 +347,8 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case result == -1:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			makerOrder.OrderStatus = enum.OrderStatus_PartFilled
@@ -255,8 +357,8 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 			qty := takerOrder.UnfilledQty
 			//	amount := takerOrder.UnfilledAmount
 			a := qty.Mul(makerOrder.Price)
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
 			makerOrder.UnfilledQty = makerOrder.UnfilledQty.Sub(qty)
 			makerOrder.UnfilledAmount = makerOrder.UnfilledAmount.Sub(a)
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(a)
@@ -272,10 +374,18 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 				Amount: a,
 			}
 		}
+		makerOrder.showIceberg(hidden)
+		m.bids.modify(makerOrder)
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
 		matchedRecord.Taker = *takerOrder
 		matchedRecord.Maker = *makerOrder
-		matchedRecord.MatchedRecordID = cast.ToString(idgen.NextId())
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
 		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//冰山单显示的部分成交完，补充之后重新排队，从头开始遍历
+		if makerOrder.needReplenish() {
+			deletedOrders = m.replenishIceberg(m.bids, makerOrder, deletedOrders, matchedRecord)
+			iterator = m.bids.iterator()
+		}
 		//订单全部成交退出，或者小于下一个订单的价格。不再循环匹配。
 		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled {
 			break
@@ -283,9 +393,9 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 
 	}
 	//删除买盘被匹配过的订单，更新买一价
-	if len(deletedKeys) > 0 {
-		for _, v := range deletedKeys {
-			m.bids.orderBook.Remove(v)
+	if len(deletedOrders) > 0 {
+		for _, v := range deletedOrders {
+			m.bids.remove(v)
 		}
 		m.updateBestBid()
 	}
@@ -297,9 +407,13 @@ func (m *MatchEngine) matchMarketOrderSell(takerOrder *Order) {
 		}
 		m.depthHandler.updateDepth(p, enum.Side_Buy, Delete, m.currentSeqId)
 	}
-	matchedResult.MatchTime = time.Now().UnixNano()
-	matchedResult.MatchID = cast.ToString(idgen.NextId())
-	m.SendMatchResult(matchedResult)
+	matchedResult.MatchTime = m.now().UnixNano()
+	matchedResult.MatchID = cast.ToString(m.nextId())
+	//自成交保护可能一条都没有成交
+	if len(matchedResult.MatchedRecords) > 0 {
+		m.SendMatchResult(matchedResult)
+	}
+	m.sendSTPCancels()
 
 	if takerOrder.OrderStatus != enum.OrderStatus_ALLFilled {
 		r := &MatchResult{
@@ -324,7 +438,7 @@ func (m *MatchEngine) matchMarkerOrderBuy(takerOrder *Order) {
 		TakerIsBuy:     true,
 	}
 	//如果没有卖盘，直接取消订单
-	if m.asks.orderBook.Size() == 0 {
+	if m.asks.size() == 0 {
 		matchedResult.CancelResp = &CancelResp{
 			CancelId: takerOrder.SequenceId,
 			CoinId:   m.c.SymbolInfo.QuoteCoinID,
@@ -336,13 +450,35 @@ func (m *MatchEngine) matchMarkerOrderBuy(takerOrder *Order) {
 		return
 	}
 
-	iterator := m.asks.orderBook.Iterator()
-	//待被删除的key
-	deletedKeys := make([]*Key, 0, 2)
+	iterator := m.asks.iterator()
+	//待被删除的订单
+	deletedOrders := make([]*Order, 0, 2)
 	var matchedRecord *MatchedRecord
+	bandLimit, hasBand := m.marketPriceLimit(takerOrder)
 LOOP:
 	for iterator.Next() {
-		makerOrder := iterator.Value().(*Order)
+		//taker全部成交之后结束，不再和之后的订单做自成交保护
+		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled {
+			break
+		}
+		makerOrder := iterator.Order()
+		//超出价格保护范围或者订单的滑点保护的部分不成交，剩余的撤销
+		if hasBand && makerOrder.Price.GreaterThan(bandLimit) {
+			break
+		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				deletedOrders = append(deletedOrders, makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
+		//冰山单只和显示的部分成交
+		hidden := makerOrder.hideIceberg()
 		result := takerOrder.UnfilledAmount.Cmp(makerOrder.UnfilledAmount)
 		switch result {
 		case 1:
@@ -354,8 +490,8 @@ LOOP:
 			takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量
 			takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(amount)
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
 			makerOrder.FilledAmount = makerOrder.FilledAmount.Add(amount)
@@ -366,8 +502,8 @@ LOOP:
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case 0:
 			makerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
@@ -376,9 +512,9 @@ LOOP:
 			amount := makerOrder.UnfilledAmount
 			takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量	
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledAmount = utils.Fixed{}
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 		
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
 			makerOrder.FilledAmount = makerOrder.FilledAmount.Add(amount)
@@ -388,22 +524,20 @@ LOOP:
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 
 		case -1:
 			//taker金额比maker的金额要小，匹配结束
-			//按照taker的金额购买的话能买多少，小于最小的单位则结束。
-			qty := takerOrder.UnfilledAmount.Div(makerOrder.Price)
-			baseCoinMinUnit := utils.NewFromStringMaxPrec(cast.ToString(math.Pow10(int(-m.c.SymbolInfo.BaseCoinPrec.Load()))))
-			if qty.LessThan(baseCoinMinUnit) {
+			//按照taker的金额能买的数量向下取整到基础币的精度，不足一个最小单位则结束。
+			//剩余不足一个最小单位的金额是零头，在之后的撤单消息中解冻。
+			q := takerOrder.UnfilledAmount.DivRoundDown(makerOrder.Price, m.qtyExp)
+			if !q.IsPositive() {
+				makerOrder.showIceberg(hidden)
 				break LOOP
 			}
 			makerOrder.OrderStatus = enum.OrderStatus_PartFilled
 			takerOrder.OrderStatus = enum.OrderStatus_PartFilled
-			//去除余数
-			//数量
-			q := qty.Div(baseCoinMinUnit).Floor().Mul(baseCoinMinUnit)
 			//金额
 			a := q.Mul(makerOrder.Price)
 			//更新订单的剩余数量
@@ -412,7 +546,7 @@ LOOP:
 			takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(a)
 			makerOrder.UnfilledQty = makerOrder.UnfilledQty.Sub(q)
 			makerOrder.UnfilledAmount = makerOrder.UnfilledAmount.Sub(a)
-			if takerOrder.UnfilledAmount.Equal(utils.DecimalZeroMaxPrec) {
+			if takerOrder.UnfilledAmount.Equal(utils.Fixed{}) {
 				takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			}
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(a)
@@ -424,16 +558,24 @@ LOOP:
 				Amount: a,
 			}
 		}
+		makerOrder.showIceberg(hidden)
+		m.asks.modify(makerOrder)
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
 		matchedRecord.Taker = *takerOrder
 		matchedRecord.Maker = *makerOrder
-		matchedRecord.MatchedRecordID = cast.ToString(idgen.NextId())
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
 		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//冰山单显示的部分成交完，补充之后重新排队，从头开始遍历
+		if makerOrder.needReplenish() {
+			deletedOrders = m.replenishIceberg(m.asks, makerOrder, deletedOrders, matchedRecord)
+			iterator = m.asks.iterator()
+		}
 	}
-	matchedResult.MatchID = cast.ToString(idgen.NextId())
+	matchedResult.MatchID = cast.ToString(m.nextId())
 	//删除买盘中的被匹配完的订单，同时更新卖一价
-	if len(deletedKeys) > 0 {
-		for _, v := range deletedKeys {
-			m.asks.orderBook.Remove(v)
+	if len(deletedOrders) > 0 {
+		for _, v := range deletedOrders {
+			m.asks.remove(v)
 		}
 		m.updateBestAsk()
 	}
@@ -445,11 +587,12 @@ LOOP:
 		}
 		m.depthHandler.updateDepth(p, enum.Side_Sell, Delete, m.currentSeqId)
 	}
-	matchedResult.MatchTime = time.Now().UnixNano()
+	matchedResult.MatchTime = m.now().UnixNano()
 	//m.Next <- matchedResult
 	if len(matchedResult.MatchedRecords) > 0 {
 		m.SendMatchResult(matchedResult)
 	}
+	m.sendSTPCancels()
 
 	if takerOrder.OrderStatus != enum.OrderStatus_ALLFilled {
 		r := &MatchResult{
@@ -473,17 +616,30 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 		TakerIsBuy:     true,
 	}
 	//买单从卖盘中找
-	iterator := m.asks.orderBook.Iterator()
-	//待被删除的key
-	deletedKeys := make([]*Key, 0, 2)
+	iterator := m.asks.iterator()
+	//待被删除的订单
+	deletedOrders := make([]*Order, 0, 2)
 	for iterator.Next() {
-		makerOrder := iterator.Value().(*Order)
+		makerOrder := iterator.Order()
 
 		//订单全部成交退出，或者小于下一个订单的价格。不再循环匹配。
 		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled || makerOrder.Price.GreaterThan(takerOrder.Price) {
 			break
 		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				deletedOrders = append(deletedOrders, makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
 		//计较价格
+		//冰山单只和显示的部分成交
+		hidden := makerOrder.hideIceberg()
 		result := takerOrder.UnfilledQty.Cmp(makerOrder.UnfilledQty)
 		var matchedRecord *MatchedRecord
 		switch {
@@ -499,8 +655,8 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 			takerAmount := qty.Mul(takerOrder.Price)
 			takerOrder.UnfilledQty = takerOrder.UnfilledQty.Sub(qty)
 			takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(takerAmount)
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
             takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量			
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
@@ -512,8 +668,8 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 
 		case result == 0:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
@@ -522,10 +678,10 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 			//更新订单的剩余数量
 			qty := makerOrder.UnfilledQty
 			amount := makerOrder.UnfilledAmount
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
             takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量			
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
@@ -536,16 +692,16 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case result == -1:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			makerOrder.OrderStatus = enum.OrderStatus_PartFilled
 			//更新订单的剩余数量
 			qty := takerOrder.UnfilledQty
 			//amount := takerOrder.UnfilledAmount
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
 			makerOrder.UnfilledQty = makerOrder.UnfilledQty.Sub(qty)
 			makerAmount := makerOrder.Price.Mul(qty)
 			//成交的金额不能使用taker的金额,使用maker成交的数量乘以maker的价格 比如 maker卖 price222 qty2 taker 买 price333 qty 1
@@ -563,25 +719,34 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 			}
 		}
 		//加入到匹配的结果中
+		makerOrder.showIceberg(hidden)
+		m.asks.modify(makerOrder)
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
 		matchedRecord.Taker = *takerOrder
 		matchedRecord.Maker = *makerOrder
-		matchedRecord.MatchedRecordID = cast.ToString(idgen.NextId())
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
 		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//冰山单显示的部分成交完，补充之后重新排队，从头开始遍历
+		if makerOrder.needReplenish() {
+			deletedOrders = m.replenishIceberg(m.asks, makerOrder, deletedOrders, matchedRecord)
+			iterator = m.asks.iterator()
+		}
 
 	}
 	//删除卖盘被匹配过的订单，更新卖一价
-	if len(deletedKeys) > 0 {
-		for _, v := range deletedKeys {
-			m.asks.orderBook.Remove(v)
+	if len(deletedOrders) > 0 {
+		for _, v := range deletedOrders {
+			m.asks.remove(v)
 		}
 		m.updateBestAsk()
 	}
-	//如果taker还是部分匹配，将订单加入的买盘中
-	if takerOrder.OrderStatus == enum.OrderStatus_PartFilled {
+	//如果taker还是部分匹配，将订单加入的买盘中,FOK和IOC不进入订单簿
+	//自成交保护撤销maker之后taker可能没有成交
+	if (takerOrder.OrderStatus == enum.OrderStatus_PartFilled || takerOrder.OrderStatus == enum.OrderStatus_NewCreated) && takerOrder.OrderType == enum.OrderType_LO {
 		m.addOrder(takerOrder)
 		p := &position{
 			price: takerOrder.Price,
-			qty:   takerOrder.UnfilledQty,
+			qty:   takerOrder.depthQty(),
 		}
 		m.depthHandler.updateDepth(p, enum.Side_Buy, Add, m.currentSeqId)
 	}
@@ -594,11 +759,17 @@ func (m *MatchEngine) matchLimitOrderBuy(takerOrder *Order) {
 		m.depthHandler.updateDepth(p, enum.Side_Sell, Delete, m.currentSeqId)
 
 	}
-	matchedResult.MatchTime = time.Now().UnixNano()
-	matchedResult.MatchID = cast.ToString(idgen.NextId())
+	matchedResult.MatchTime = m.now().UnixNano()
+	matchedResult.MatchID = cast.ToString(m.nextId())
 	//发送撮合结果
-	m.SendMatchResult(matchedResult)
-
+	if len(matchedResult.MatchedRecords) > 0 {
+		m.SendMatchResult(matchedResult)
+	}
+	m.sendSTPCancels()
+	//自成交保护撤销的限价单，撤销剩余的部分
+	if takerOrder.OrderStatus == enum.OrderStatus_Canceled && takerOrder.OrderType == enum.OrderType_LO {
+		m.cancelUnfilled(takerOrder)
+	}
 }
 
 // 匹配限价卖单
@@ -608,16 +779,29 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 		TakerIsBuy:     false,
 	}
 	//遍历买盘
-	iterator := m.bids.orderBook.Iterator()
+	iterator := m.bids.iterator()
 	var matchedRecord *MatchedRecord
-	deletedKeys := make([]*Key, 0, 2)
+	deletedOrders := make([]*Order, 0, 2)
 	for iterator.Next() {
-		makerOrder := iterator.Value().(*Order)
+		makerOrder := iterator.Order()
 		//订单全部成交退出，或者小于下一个订单的价格。不再循环匹配。
 		if takerOrder.OrderStatus == enum.OrderStatus_ALLFilled || takerOrder.Price.GreaterThan(makerOrder.Price) {
 			break
 		}
+		//自成交保护
+		if m.isSelfTrade(takerOrder, makerOrder) {
+			stop, makerRemoved := m.preventSelfTrade(takerOrder, makerOrder)
+			if makerRemoved {
+				deletedOrders = append(deletedOrders, makerOrder)
+			}
+			if stop {
+				break
+			}
+			continue
+		}
 
+		//冰山单只和显示的部分成交
+		hidden := makerOrder.hideIceberg()
 		result := takerOrder.UnfilledQty.Cmp(makerOrder.UnfilledQty)
 		switch {
 		case result == 1:
@@ -630,8 +814,8 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 			takerAmount := qty.Mul(takerOrder.Price)
 			takerOrder.UnfilledQty = takerOrder.UnfilledQty.Sub(qty)
 			takerOrder.UnfilledAmount = takerOrder.UnfilledAmount.Sub(takerAmount)
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 
 			// makerOrder.FilledQty = qty
 			// takerOrder.FilledQty = qty
@@ -645,8 +829,8 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case result == 0:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			makerOrder.OrderStatus = enum.OrderStatus_ALLFilled
@@ -654,10 +838,10 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 			//更新订单的剩余数量
 			qty := makerOrder.UnfilledQty
 			amount := makerOrder.UnfilledAmount
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			makerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
+			makerOrder.UnfilledQty = utils.Fixed{}
+			makerOrder.UnfilledAmount = utils.Fixed{}
 			takerOrder.FilledQty = takerOrder.FilledQty.Add(qty)  // 更新已成交数量
             makerOrder.FilledQty = makerOrder.FilledQty.Add(qty)  // 更新已成交数量			
 			takerOrder.FilledAmount = takerOrder.FilledAmount.Add(amount)
@@ -669,8 +853,8 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 				Qty:    qty,
 				Amount: amount,
 			}
-			//将key加入的集合中
-			deletedKeys = append(deletedKeys, iterator.Key().(*Key))
+			//将订单加入的集合中
+			deletedOrders = append(deletedOrders, makerOrder)
 		case result == -1:
 			takerOrder.OrderStatus = enum.OrderStatus_ALLFilled
 			makerOrder.OrderStatus = enum.OrderStatus_PartFilled
@@ -678,8 +862,8 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 			//更新订单的剩余数量
 			qty := takerOrder.UnfilledQty
 			//amount := takerOrder.UnfilledAmount
-			takerOrder.UnfilledQty = utils.DecimalZeroMaxPrec
-			takerOrder.UnfilledAmount = utils.DecimalZeroMaxPrec
+			takerOrder.UnfilledQty = utils.Fixed{}
+			takerOrder.UnfilledAmount = utils.Fixed{}
 			makerOrder.UnfilledQty = makerOrder.UnfilledQty.Sub(qty)
 			makerAmount := makerOrder.Price.Mul(qty)
 			//成交的金额不能使用taker的金额
@@ -695,27 +879,36 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 				Amount: makerAmount,
 			}
 		}
+		makerOrder.showIceberg(hidden)
+		m.bids.modify(makerOrder)
+		m.chargeFee(takerOrder, makerOrder, matchedRecord)
 		matchedRecord.Taker = *takerOrder
 		matchedRecord.Maker = *makerOrder
-		matchedRecord.MatchedRecordID = cast.ToString(idgen.NextId())
+		matchedRecord.MatchedRecordID = cast.ToString(m.nextId())
 		matchedResult.MatchedRecords = append(matchedResult.MatchedRecords, matchedRecord)
+		//冰山单显示的部分成交完，补充之后重新排队，从头开始遍历
+		if makerOrder.needReplenish() {
+			deletedOrders = m.replenishIceberg(m.bids, makerOrder, deletedOrders, matchedRecord)
+			iterator = m.bids.iterator()
+		}
 
 	}
 	//删除买盘被匹配过的订单，更新卖一价
-	if len(deletedKeys) > 0 {
-		for _, v := range deletedKeys {
-			m.bids.orderBook.Remove(v)
+	if len(deletedOrders) > 0 {
+		for _, v := range deletedOrders {
+			m.bids.remove(v)
 		}
 		m.updateBestBid()
 
 	}
-	//如果taker还是部分匹配，将订单加入的卖盘中
-	if takerOrder.OrderStatus == enum.OrderStatus_PartFilled {
+	//如果taker还是部分匹配，将订单加入的卖盘中,FOK和IOC不进入订单簿
+	//自成交保护撤销maker之后taker可能没有成交
+	if (takerOrder.OrderStatus == enum.OrderStatus_PartFilled || takerOrder.OrderStatus == enum.OrderStatus_NewCreated) && takerOrder.OrderType == enum.OrderType_LO {
 
 		m.addOrder(takerOrder)
 		p := &position{
 			price: takerOrder.Price,
-			qty:   takerOrder.UnfilledQty,
+			qty:   takerOrder.depthQty(),
 		}
 		m.depthHandler.updateDepth(p, enum.Side_Sell, Add, m.currentSeqId)
 	}
@@ -725,34 +918,93 @@ func (m *MatchEngine) matchLimitOrderSell(takerOrder *Order) {
 			price: record.Price,
 			qty:   record.Qty,
 		}
-		log.Printf("%+v", p.castToPosition(5, 6))
 		m.depthHandler.updateDepth(p, enum.Side_Buy, Delete, m.currentSeqId)
 	}
-	matchedResult.MatchTime = time.Now().UnixNano()
-	matchedResult.MatchID = cast.ToString(idgen.NextId())
+	matchedResult.MatchTime = m.now().UnixNano()
+	matchedResult.MatchID = cast.ToString(m.nextId())
 	//m.Next <- matchedResult
-	m.SendMatchResult(matchedResult)
-
+	if len(matchedResult.MatchedRecords) > 0 {
+		m.SendMatchResult(matchedResult)
+	}
+	m.sendSTPCancels()
+	//自成交保护撤销的限价单，撤销剩余的部分
+	if takerOrder.OrderStatus == enum.OrderStatus_Canceled && takerOrder.OrderType == enum.OrderType_LO {
+		m.cancelUnfilled(takerOrder)
+	}
 }
 func (m *MatchEngine) HandleOrder(order *Order) {
+	defer m.flushL3()
+	m.nextVersion(order.SequenceId)
+	//先撤销已经过期的订单
+	m.expireOrders()
+	//集合竞价结束之后先撮合集合竞价期间的订单
+	auction := m.inAuction()
+	m.handleOrder(order, auction)
+}
+
+// ReloadOrder 重启之后恢复订单服务中未完成的订单。
+// 订单在下单时已经校验过交易规则，恢复时不再检查暂停交易和交易规则，限价单和未触发的条件单直接放回订单簿，
+// 过期的限时单留在过期索引中由定时消息撤销。其他类型的订单还没有撮合过，按照新订单处理。
+// 精度超过交易对当前的精度无法转换为定点数时撤销订单。
+func (m *MatchEngine) ReloadOrder(order *Order) {
+	if order.TriggerStatus != enum.TriggerStatus_Untriggered && order.OrderType != enum.OrderType_LO {
+		m.HandleOrder(order)
+		return
+	}
+	defer m.flushL3()
+	m.nextVersion(order.SequenceId)
+	if err := m.scaleOrder(order); err != nil {
+		logx.Errorw("reload order failed", logx.Field("sequenceId", order.SequenceId), logx.Field("err", err))
+		m.cancelUnfilled(order)
+		return
+	}
+	if order.TriggerStatus == enum.TriggerStatus_Untriggered {
+		m.addTriggerOrder(order)
+		return
+	}
+	if _, found := m.orderBook(order.Side).get(order.SequenceId); found {
+		return
+	}
+	m.addOrder(order)
+	m.depthHandler.updateDepth(&position{
+		price: order.Price,
+		qty:   order.depthQty(),
+	}, order.Side, Add, m.currentSeqId)
+}
 
-	//从接收输入的第一个订单开始，以后每次操作版本号加一
-	if m.currentSeqId != 0 {
-		m.currentSeqId = order.SequenceId
+// nextVersion 处理每个消息之前更新订单簿的版本号，版本号只增不减。
+// 从接收输入的第一个订单开始，新订单使用订单id作为版本号，撤单、修改订单和批量撤单版本号加一。
+func (m *MatchEngine) nextVersion(seqId int64) {
+	if m.currentSeqId != 0 && seqId > m.currentSeqId {
+		m.currentSeqId = seqId
 	} else {
 		m.currentSeqId++
 	}
-	k := &Key{
-		price: order.Price,
-		id:    order.SequenceId,
+}
+
+// handleOrder 处理订单，触发的条件单和修改之后重新撮合的订单在同一个消息中重入，不再更新版本号和检查过期、集合竞价
+func (m *MatchEngine) handleOrder(order *Order, auction bool) {
+	//条件单在触发之前不在订单簿中
+	if order.IsCancel {
+		if triggerOrder, ok := m.triggerOrders[order.SequenceId]; ok {
+			m.cancelTriggerOrder(triggerOrder)
+			return
+		}
+	} else if order.TriggerStatus == enum.TriggerStatus_Untriggered {
+		if m.rejectOrder(order) {
+			return
+		}
+		m.addTriggerOrder(order)
+		m.checkTriggerOrders(auction)
+		return
 	}
-	var o interface{}
+	var orderDetail *Order
 	var found bool
 	if order.OrderType == enum.OrderType_LO {
 		if order.Side == enum.Side_Sell {
-			o, found = m.asks.orderBook.Get(k)
+			orderDetail, found = m.asks.get(order.SequenceId)
 		} else {
-			o, found = m.bids.orderBook.Get(k)
+			orderDetail, found = m.bids.get(order.SequenceId)
 		}
 	}
 	//判断订单是否存在
@@ -762,17 +1014,16 @@ func (m *MatchEngine) HandleOrder(order *Order) {
 
 	// 1. 判断是否取消订单
 	if order.IsCancel {
-		orderDetail := o.(*Order)
 		order.UnfilledQty = orderDetail.UnfilledQty
 		order.UnfilledAmount = orderDetail.UnfilledAmount
 		order.Amount = orderDetail.Amount
 		order.Qty = orderDetail.Qty
 		//订单簿删除订单
 		m.cancelOrder(order)
-		//更新盘口深度
+		//更新盘口深度，撤单按照订单id查找，使用订单簿中订单的价格
 		m.depthHandler.updateDepth(&position{
-			price: order.Price,
-			qty:   order.UnfilledQty,
+			price: orderDetail.Price,
+			qty:   orderDetail.depthQty(),
 		}, order.Side, Delete, m.currentSeqId)
 		//发送取消订单消息
 
@@ -788,26 +1039,51 @@ func (m *MatchEngine) HandleOrder(order *Order) {
 				Qty:      qty,
 				Uid:      orderDetail.Uid,
 			},
-			MatchTime: time.Now().UnixNano(),
+			MatchTime: m.now().UnixNano(),
 		})
+		if auction {
+			m.pushIndicative()
+		}
 	} else {
 		logx.Debugf("order = %+v bestBid = %v bestAsk=%v", order, m.bestBid, m.bestAsk)
+		//重启之后加载的限时单可能已经过期
+		if order.isExpired(m.now()) {
+			m.expireUnfilled(order)
+			return
+		}
+		//人工暂停期间集合竞价也不接受新订单
+		if auction && !m.manualHalt {
+			m.addAuctionOrder(order)
+			return
+		}
+		//触发的条件单在进入触发簿之前已经校验过
+		if order.TriggerStatus != enum.TriggerStatus_Triggered && m.rejectOrder(order) {
+			return
+		}
 		// 2. 根据订单类型和方向进行撮合
 		switch {
+		//按照数量的市价买单
+		case order.isMarketBuyByQty():
+			m.matchMarketOrderBuyByQty(order)
 		//买单市价单
 		case order.Side == enum.Side_Buy && order.OrderType == enum.OrderType_MO:
 			m.matchMarkerOrderBuy(order)  // 市价买单
 		//买单限价单
 		case order.Side == enum.Side_Buy && order.OrderType == enum.OrderType_LO:
 			//价格大于卖一价，同时卖一价不为零
-			if order.Price.GreaterThanOrEqual(m.bestAsk) && m.bestAsk.GreaterThan(utils.DecimalZeroMaxPrec) {
+			if order.Price.GreaterThanOrEqual(m.bestAsk) && m.bestAsk.GreaterThan(utils.Fixed{}) {
+				//只做maker的订单会立即成交，直接撤销
+				if order.PostOnly {
+					m.cancelUnfilled(order)
+					break
+				}
 				m.matchLimitOrderBuy(order)   // 限价买单
 			} else {
 				m.addOrder(order)
 				//更新盘口深度
 				m.depthHandler.updateDepth(&position{
 					price: order.Price,
-					qty:   order.UnfilledQty,
+					qty:   order.depthQty(),
 				}, order.Side, Add, m.currentSeqId)
 			}
 		//卖单市价单
@@ -815,25 +1091,177 @@ func (m *MatchEngine) HandleOrder(order *Order) {
 			m.matchMarketOrderSell(order) // 市价卖单
 		//卖单限价单
 		case order.Side == enum.Side_Sell && order.OrderType == enum.OrderType_LO:
-			if order.Price.LessThanOrEqual(m.bestBid) && m.bestBid.GreaterThan(utils.DecimalZeroMaxPrec) {
+			if order.Price.LessThanOrEqual(m.bestBid) && m.bestBid.GreaterThan(utils.Fixed{}) {
+				//只做maker的订单会立即成交，直接撤销
+				if order.PostOnly {
+					m.cancelUnfilled(order)
+					break
+				}
 				m.matchLimitOrderSell(order)  // 限价卖单
 			} else {
 				m.addOrder(order)
 				//更新盘口深度
 				m.depthHandler.updateDepth(&position{
 					price: order.Price,
-					qty:   order.UnfilledQty,
+					qty:   order.depthQty(),
 				}, order.Side, Add, m.currentSeqId)
 			}
+		//FOK IOC
+		case order.OrderType == enum.OrderType_FOK || order.OrderType == enum.OrderType_IOC:
+			m.matchImmediateOrder(order)
+		default:
+			//未知的订单类型直接撤销，解冻下单时冻结的资产
+			logx.Errorw("unknown order type", logx.Field("order", order))
+			m.cancelUnfilled(order)
 		}
 		logx.Debugf(" bestBid = %v bestAsk=%v", m.bestBid, m.bestAsk)
+		//撮合之后最新成交价可能发生变化，检查条件单是否触发
+		m.checkTriggerOrders(auction)
 	}
 
 }
+
+// 添加条件单到条件单簿
+func (m *MatchEngine) addTriggerOrder(order *Order) {
+	if order.Side == enum.Side_Buy {
+		m.buyTriggers.add(order)
+	} else {
+		m.sellTriggers.add(order)
+	}
+	m.triggerOrders[order.SequenceId] = order
+}
+
+// 撤销未触发的条件单，解冻下单时冻结的资产
+func (m *MatchEngine) cancelTriggerOrder(order *Order) {
+	if order.Side == enum.Side_Buy {
+		m.buyTriggers.remove(order)
+	} else {
+		m.sellTriggers.remove(order)
+	}
+	delete(m.triggerOrders, order.SequenceId)
+	m.cancelUnfilled(order)
+}
+
+// 检查条件单是否触发,触发的条件单通知订单服务后按照限价单或市价单撮合。
+// 触发的订单撮合后可能继续改变最新成交价，handleOrder中会继续检查。
+func (m *MatchEngine) checkTriggerOrders(auction bool) {
+	//熔断期间条件单不触发
+	if m.lastPrice.LessThanOrEqual(utils.Fixed{}) || m.isHalted() {
+		return
+	}
+	triggered := append(m.buyTriggers.popTriggered(m.lastPrice), m.sellTriggers.popTriggered(m.lastPrice)...)
+	for _, order := range triggered {
+		delete(m.triggerOrders, order.SequenceId)
+		order.TriggerStatus = enum.TriggerStatus_Triggered
+		m.SendMatchResult(&MatchResult{
+			TriggerResp: &TriggerResp{
+				TriggerId:    order.SequenceId,
+				Uid:          order.Uid,
+				OrderId:      order.OrderID,
+				TriggerPrice: order.TriggerPrice,
+				LastPrice:    m.lastPrice,
+			},
+			MatchTime: m.now().UnixNano(),
+		})
+		m.handleOrder(order, auction)
+	}
+}
+
+// 匹配FOK和IOC订单,这两种订单都不会进入订单簿。
+// FOK撮合前先检查对手盘在限价内的数量是否足够全部成交，不够则整单撤销。
+// IOC按照限价单撮合，未成交的部分撤销。
+func (m *MatchEngine) matchImmediateOrder(order *Order) {
+	if order.OrderType == enum.OrderType_FOK && !m.canFullyFill(order) {
+		m.cancelUnfilled(order)
+		return
+	}
+	if order.Side == enum.Side_Buy {
+		if order.Price.GreaterThanOrEqual(m.bestAsk) && m.bestAsk.GreaterThan(utils.Fixed{}) {
+			m.matchLimitOrderBuy(order)
+		}
+	} else {
+		if order.Price.LessThanOrEqual(m.bestBid) && m.bestBid.GreaterThan(utils.Fixed{}) {
+			m.matchLimitOrderSell(order)
+		}
+	}
+	if order.OrderStatus != enum.OrderStatus_ALLFilled {
+		m.cancelUnfilled(order)
+	}
+}
+
+// canFullyFill 对手盘在订单限价内的数量是否足够让订单全部成交
+func (m *MatchEngine) canFullyFill(order *Order) bool {
+	book := m.asks
+	if order.Side == enum.Side_Sell {
+		book = m.bids
+	}
+	available := utils.Fixed{}
+	iterator := book.iterator()
+	for iterator.Next() {
+		makerOrder := iterator.Order()
+		if order.Side == enum.Side_Buy && makerOrder.Price.GreaterThan(order.Price) {
+			break
+		}
+		if order.Side == enum.Side_Sell && makerOrder.Price.LessThan(order.Price) {
+			break
+		}
+		//自成交保护撤销maker之后可以继续撮合，其他模式taker会停止撮合或者减少数量，不能全部成交
+		if m.isSelfTrade(order, makerOrder) {
+			if m.stpMode(order) == enum.STPMode_CancelOldest {
+				continue
+			}
+			break
+		}
+		available = available.Add(makerOrder.UnfilledQty)
+		if available.GreaterThanOrEqual(order.UnfilledQty) {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelUnfilled 撤销订单未成交的部分
+func (m *MatchEngine) cancelUnfilled(order *Order) {
+	m.SendMatchResult(&MatchResult{
+		CancelResp: m.newCancelResp(order),
+		MatchTime:  m.now().UnixNano(),
+	})
+}
+
+// newCancelResp 撤销订单未成交的部分，买单解冻未成交的计价币，卖单解冻未成交的基础币
+func (m *MatchEngine) newCancelResp(order *Order) *CancelResp {
+	coinId, qty := m.c.SymbolInfo.BaseCoinID, order.UnfilledQty.String()
+	if order.Side == enum.Side_Buy {
+		coinId, qty = m.c.SymbolInfo.QuoteCoinID, order.UnfilledAmount.String()
+	}
+	return &CancelResp{
+		CancelId: order.SequenceId,
+		CoinId:   coinId,
+		Qty:      qty,
+		Uid:      order.Uid,
+	}
+}
+
+// Close 停止撮合引擎的后台协程，交易对下线时在处理订单的协程中调用，之后不能再处理订单。
+func (m *MatchEngine) Close() {
+	close(m.tick)
+	m.depthHandler.close()
+}
+
 func (m *MatchEngine) GetDepth(level int32) DepthData {
 	return m.depthHandler.getDepth(level)
 }
 
+// resultMessageId 撮合结果的消息id，下游根据消息id去重
+func resultMessageId(symbol string, seq int64) string {
+	return fmt.Sprintf("%v_%v", symbol, seq)
+}
+
+// GetGroupDepth 获取合并深度，档位没有配置返回false
+func (m *MatchEngine) GetGroupDepth(level int32, group string) (DepthData, bool) {
+	return m.depthHandler.getGroupDepth(level, group)
+}
+
 // SendMatchResult 发送撮合结果，这个操作不异步。
 /** 
 使用消息队列实现异步解耦的主要场景是在撮合引擎中使用 Pulsar 消息队列实现撮合结果的异步处理。
@@ -855,37 +1283,78 @@ func (m *MatchEngine) GetDepth(level int32) DepthData {
 func (m *MatchEngine) SendMatchResult(matchResult *MatchResult) {
 
 	var resp matchMq.MatchResp
-	resp.MessageId = stringx.Rand()
-	if matchResult.CancelResp != nil {
+	m.resultSeq++
+	resp.Seq = m.resultSeq
+	resp.MessageId = resultMessageId(m.c.SymbolInfo.SymbolName, m.resultSeq)
+	if matchResult.TriggerResp != nil {
+		resp.Resp = &matchMq.MatchResp_Trigger{
+			Trigger: &matchMq.TriggerResp{
+				Id:           matchResult.TriggerResp.TriggerId,
+				Uid:          matchResult.TriggerResp.Uid,
+				OrderId:      matchResult.TriggerResp.OrderId,
+				TriggerPrice: matchResult.TriggerResp.TriggerPrice.String(),
+				LastPrice:    matchResult.TriggerResp.LastPrice.String(),
+			},
+		}
+	} else if matchResult.AmendResp != nil {
+		resp.Resp = &matchMq.MatchResp_Amend{
+			Amend: &matchMq.AmendResp{
+				Id:             matchResult.AmendResp.AmendId,
+				Uid:            matchResult.AmendResp.Uid,
+				CoinId:         matchResult.AmendResp.CoinId,
+				UnFrozenQty:    matchResult.AmendResp.UnfrozenQty,
+				Rejected:       matchResult.AmendResp.Rejected,
+				Price:          matchResult.AmendResp.Price,
+				Qty:            matchResult.AmendResp.Qty,
+				Amount:         matchResult.AmendResp.Amount,
+				UnFilledQty:    matchResult.AmendResp.UnfilledQty,
+				UnFilledAmount: matchResult.AmendResp.UnfilledAmount,
+				OrderStatus:    matchResult.AmendResp.OrderStatus,
+				QueueId:        matchResult.AmendResp.QueueId,
+			},
+		}
+	} else if matchResult.CancelResp != nil {
 		resp.Resp = &matchMq.MatchResp_Cancel{
 			Cancel: &matchMq.CancelResp{
-				Id:     matchResult.CancelResp.CancelId,
-				CoinId: matchResult.CancelResp.CoinId,
-				Qty:    matchResult.CancelResp.Qty,
-				Uid:    matchResult.CancelResp.Uid,
+				Id:             matchResult.CancelResp.CancelId,
+				CoinId:         matchResult.CancelResp.CoinId,
+				Qty:            matchResult.CancelResp.Qty,
+				Uid:            matchResult.CancelResp.Uid,
+				DecrementQty:   matchResult.CancelResp.DecrementQty,
+				UnFilledQty:    matchResult.CancelResp.UnfilledQty,
+				UnFilledAmount: matchResult.CancelResp.UnfilledAmount,
+				OrderQty:       matchResult.CancelResp.OrderQty,
+				OrderAmount:    matchResult.CancelResp.OrderAmount,
+				Expired:        matchResult.CancelResp.Expired,
 			},
 		}
 	} else {
 		beginPrice, endPrice := matchResult.MatchedRecords[0].Price.String(), matchResult.MatchedRecords[len(matchResult.MatchedRecords)-1].Price.String()
+		//记录最新成交价，用于触发条件单
+		m.lastPrice = matchResult.MatchedRecords[len(matchResult.MatchedRecords)-1].Price
+		m.checkCircuitBreaker(m.lastPrice, matchResult.MatchTime)
 		lowPrice, highPrice := beginPrice, endPrice
 		if !matchResult.TakerIsBuy {
 			highPrice = beginPrice
 			lowPrice = endPrice
 		}
 		records := make([]*matchMq.MatchResult_MatchedRecord, 0, len(matchResult.MatchedRecords))
-		totalQty, totalAmount, takerUnFrozenAmount := utils.DecimalZeroMaxPrec, utils.DecimalZeroMaxPrec, utils.DecimalZeroMaxPrec
+		totalQty, totalAmount, takerUnFrozenAmount := utils.Fixed{}, utils.Fixed{}, utils.Fixed{}
 		for _, record := range matchResult.MatchedRecords {
 			//本次撮合一共撮合了多少
 			totalQty = totalQty.Add(record.Qty)
 			totalAmount = totalAmount.Add(record.Amount)
 			takerFilledQty := record.Taker.FilledQty.String()
 
-			if record.Taker.OrderType == enum.OrderType_LO {
+			if record.Taker.isLimitPrice() {
 				//taker解冻的金额，以taker的成交价格为准
 				a := record.Qty.Mul(record.Taker.Price)
 				takerUnFrozenAmount = takerUnFrozenAmount.Add(a)
 				takerFilledQty = record.Taker.Qty.Sub(record.Taker.UnfilledQty).String()
 
+			} else if record.Taker.isMarketBuyByQty() && record.Taker.OrderStatus == enum.OrderStatus_ALLFilled {
+				//按照数量的市价买单全部成交，解冻全部冻结的金额，没有用完的部分退回
+				takerUnFrozenAmount = record.Taker.Amount
 			} else {
 				takerUnFrozenAmount = record.Taker.FilledAmount
 			}
@@ -905,6 +1374,7 @@ func (m *MatchEngine) SendMatchResult(matchResult *MatchResult) {
 					Uid:            record.Taker.Uid,
 					Id:             record.Taker.SequenceId,
 					UnFrozenAmount: takerUnFrozenAmount.String(),
+					Fee:            record.Taker.Fee.String(),
 				},
 				Maker: &matchMq.OrderResp{
 					OrderId:        record.Maker.OrderID,
@@ -915,7 +1385,11 @@ func (m *MatchEngine) SendMatchResult(matchResult *MatchResult) {
 					UnFilledAmount: record.Maker.UnfilledAmount.String(),
 					Uid:            record.Maker.Uid,
 					Id:             record.Maker.SequenceId,
+					Fee:            record.Maker.Fee.String(),
+					QueueId:        record.Maker.QueueId,
 				},
+				TakerFee: record.TakerFee.String(),
+				MakerFee: record.MakerFee.String(),
 			}
 			records = append(records, r)
 		}
@@ -940,21 +1414,10 @@ func (m *MatchEngine) SendMatchResult(matchResult *MatchResult) {
 		}
 	}
 
-	logx.Debugw("send match result", logx.Field("data", &resp))
-	data, _ := proto.Marshal(&resp)
-	var err error
-	// 1. 撮合引擎发送撮合结果：撮合引擎通过 Pulsar Producer 将撮合结果发送到消息队列
-	for i := 0; i < 10; i++ {
-		if _, err = m.producer.Send(context.Background(), &pulsar.ProducerMessage{
-			Payload: data,
-		}); err != nil {
-			logx.Errorw("send message failed", logger.ErrorField(err), logx.Field("count", i+1))
-			time.Sleep(time.Second)
-			continue
-		}
-		break
-	}
-	if err != nil {
+	//logx.Field会立即序列化撮合结果，调试日志没有开启时也有开销
+	logx.Debugf("send match result %v", &resp)
+	// 1. 撮合引擎发送撮合结果：默认通过 Pulsar Producer 将撮合结果发送到消息队列
+	if err := m.resultSink.SendMatchResult(&resp); err != nil {
 		logx.Severef("send message failed err=%v", err)
 	}
 
@@ -976,11 +1439,7 @@ func (m *MatchEngine) sendTick() {
 				Topic:   commonWs.TickPrefix.WithParam(m.c.SymbolInfo.SymbolName),
 				Payload: tick,
 			}
-			if _, err := m.proxyClient.PushData(context.Background(), &ws.Data{
-				Uid:   "",
-				Topic: commonWs.TickPrefix.WithParam(m.c.SymbolInfo.SymbolName),
-				Data:  msg.ToBytes(),
-			}); err != nil {
+			if err := m.marketDataSink.PushMarketData(msg.Topic, msg.ToBytes()); err != nil {
 				logx.Errorw("push kline websocket data failed", logger.ErrorField(err), logx.Field("data", tick))
 			}
 		}
@@ -0,0 +1,122 @@
+package engine_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/luxun9527/gex/app/match/rpc/internal/config"
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	"github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/shopspring/decimal"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 撮合引擎的吞吐量，订单在计时之前创建，撮合结果和行情数据直接丢弃
+// go test -run=^$ -bench=Match -benchmem ./app/match/rpc/internal/engine
+
+type discardSink struct{}
+
+func (discardSink) SendMatchResult(*matchMq.MatchResp) error { return nil }
+
+func (discardSink) PushMarketData(string, []byte) error { return nil }
+
+func createBenchMatchEngine() *engine.MatchEngine {
+	//撮合过程中的调试日志不计入测试的时间
+	logx.SetLevel(logx.ErrorLevel)
+	c := &config.Config{
+		Symbol:     "BTC_USDT",
+		SymbolInfo: createTestSymbolInfo(),
+	}
+	me := engine.NewMatchEngine(c, discardSink{}, discardSink{},
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return testTime
+		}),
+	)
+	//订单簿中保留一定的深度
+	for i := 0; i < 1000; i++ {
+		me.HandleOrder(createLimitOrder(int64(i+1), strconv.Itoa(20000+i), "1.5", enum.Side_Sell))
+		me.HandleOrder(createLimitOrder(int64(i+1001), strconv.Itoa(10000-i), "1.5", enum.Side_Buy))
+	}
+	return me
+}
+
+// BenchmarkMatchLimitOrder 每次一个限价卖单挂单，一个限价买单和卖单全部成交
+func BenchmarkMatchLimitOrder(b *testing.B) {
+	me := createBenchMatchEngine()
+	orders := make([]*engine.Order, 0, 2*b.N)
+	for i := 0; i < b.N; i++ {
+		price := strconv.Itoa(15000 + i%100)
+		orders = append(orders,
+			createLimitOrder(int64(2*i+10001), price, "0.25", enum.Side_Sell),
+			createLimitOrder(int64(2*i+10002), price, "0.25", enum.Side_Buy),
+		)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for _, o := range orders {
+		me.HandleOrder(o)
+	}
+}
+
+// BenchmarkMatchMarketBuy 每次两个限价卖单挂单，一个市价买单按照金额和两个卖单成交，剩余的金额撤销
+func BenchmarkMatchMarketBuy(b *testing.B) {
+	me := createBenchMatchEngine()
+	orders := make([]*engine.Order, 0, 3*b.N)
+	for i := 0; i < b.N; i++ {
+		orders = append(orders,
+			createLimitOrder(int64(3*i+10001), "15000", "0.125", enum.Side_Sell),
+			createLimitOrder(int64(3*i+10002), "15000.5", "0.125", enum.Side_Sell),
+			createMarketOrder(int64(3*i+10003), "3800", "0", enum.Side_Buy),
+		)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for _, o := range orders {
+		me.HandleOrder(o)
+	}
+}
+
+// 撮合引擎每次成交的计算使用decimal和定点数的对比：成交数量取较小的未成交数量，成交金额等于数量乘以价格，
+// 更新两个订单的未成交数量，按照交易对的精度计算手续费。价格和数量都是4位小数，和createTestSymbolInfo一致。
+
+const benchExp = -4
+
+// 保存计算的结果，避免编译器优化掉
+var (
+	benchDecimal decimal.Decimal
+	benchFixed   utils.Fixed
+)
+
+// BenchmarkMatchFillDecimal 成交的计算使用decimal
+func BenchmarkMatchFillDecimal(b *testing.B) {
+	price, rate := decimal.RequireFromString("15000.5"), decimal.RequireFromString("0.002")
+	takerQty, makerQty := decimal.RequireFromString("0.3"), decimal.RequireFromString("0.125")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		qty := decimal.Min(takerQty, makerQty)
+		amount := qty.Mul(price)
+		takerUnfilled, makerUnfilled := takerQty.Sub(qty), makerQty.Sub(qty)
+		takerFee, makerFee := qty.Mul(rate).RoundDown(-benchExp), amount.Mul(rate).RoundDown(-benchExp)
+		benchDecimal = takerUnfilled.Add(makerUnfilled).Add(amount.Sub(takerFee).Sub(makerFee))
+	}
+}
+
+// BenchmarkMatchFillFixed 成交的计算使用定点数
+func BenchmarkMatchFillFixed(b *testing.B) {
+	price, rate := utils.RequireFixedFromString("15000.5"), utils.RequireFixedFromString("0.002")
+	takerQty, makerQty := utils.RequireFixedFromString("0.3"), utils.RequireFixedFromString("0.125")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		qty := utils.MinFixed(takerQty, makerQty)
+		amount := qty.Mul(price)
+		takerUnfilled, makerUnfilled := takerQty.Sub(qty), makerQty.Sub(qty)
+		takerFee, makerFee := qty.MulRoundDown(rate, benchExp), amount.MulRoundDown(rate, benchExp)
+		benchFixed = takerUnfilled.Add(makerUnfilled).Add(amount.Sub(takerFee).Sub(makerFee))
+	}
+}
@@ -1,22 +1,24 @@
 package engine_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
-	pulsar_client "github.com/apache/pulsar-client-go/pulsar"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/luxun9527/gex/app/match/rpc/internal/config"
 	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
-	pulsarConfig "github.com/luxun9527/gex/common/pkg/pulsar"
 	"github.com/luxun9527/gex/common/proto/define"
 	"github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	commonWs "github.com/luxun9527/gex/common/proto/ws"
 	"github.com/luxun9527/gex/common/utils"
-	gpush "github.com/luxun9527/gpush/proto"
-	"github.com/shopspring/decimal"
+	"github.com/luxun9527/gex/common/ws/socket"
+	"github.com/spf13/cast"
 	"github.com/stretchr/testify/assert"
-	"github.com/yitter/idgenerator-go/idgen"
-	"github.com/zeromicro/go-zero/core/discov"
-	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // 本测试文件包含以下主要测试用例:
@@ -28,9 +30,9 @@ import (
 // 每个测试用例都会:
 // 1.创建测试用的 MatchEngine 实例
 // 2.添加测试订单
-// 3.验证撮合结果
+// 3.验证撮合结果,包括发送给下游的每一条MatchResp
 
-// 确保测试环境中 Pulsar 和 Etcd 服务可访问,否则测试会失败。
+// 撮合结果和行情数据输出到内存,测试不依赖 Pulsar 和 Etcd 服务。
 // 测试过程中会创建撮合引擎实例、订单簿,并验证撮合结果的正确性。
 // cd /git/gex/app/match/rpc/internal/engine
 // go test -v match_engine_test.go
@@ -40,60 +42,121 @@ import (
 
 // go test -v app/match/rpc/internal/engine/match_engine_test.go
 
-// 创建测试用的MatchEngine实例
-func createTestMatchEngine() *engine.MatchEngine {
+// 测试时间,撮合结果的消息id和撮合时间都基于这个时间
+var testTime = time.Unix(1700000000, 0)
+
+// 创建测试用的MatchEngine实例,撮合结果保存在内存中,撮合id从1开始递增
+func createTestMatchEngine() (*engine.MatchEngine, *engine.MemoryResultSink) {
+	return createTestMatchEngineWithSymbol(createTestSymbolInfo())
+}
+
+// 测试用的交易对配置
+func createTestSymbolInfo() *define.SymbolInfo {
+	return &define.SymbolInfo{
+		SymbolID:           1,
+		SymbolName:         "BTC_USDT",
+		BaseCoinID:         1,
+		QuoteCoinID:        2,
+		BaseCoinPrecValue:  4,
+		QuoteCoinPrecValue: 4,
+		BaseCoinName:       "BTC",
+		QuoteCoinName:      "USDT",
+	}
+}
+
+// 使用指定的交易对配置创建MatchEngine实例，opts覆盖默认的选项
+func createTestMatchEngineWithSymbol(symbolInfo *define.SymbolInfo, opts ...engine.Option) (*engine.MatchEngine, *engine.MemoryResultSink) {
 	// 创建配置
 	c := &config.Config{
-		Symbol: "BTC_USDT",
-		SymbolInfo: &define.SymbolInfo{
-			SymbolID:           1,
-			SymbolName:        "BTC_USDT",
-			BaseCoinID:        1,
-			QuoteCoinID:       2,
-			BaseCoinPrecValue: 4,
-			QuoteCoinPrecValue: 4,
-			BaseCoinName:      "BTC",
-			QuoteCoinName:     "USDT",
-		},
-		PulsarConfig: pulsarConfig.PulsarConfig{
-			Hosts: []string{"pulsar:6650"},
-		},
-		WsConf:  zrpc.RpcClientConf{
-			Etcd: discov.EtcdConf{
-				Hosts: []string{"etcd:2379"},
-				Key: "proxy",
-			},
-		},
+		Symbol:     "BTC_USDT",
+		SymbolInfo: symbolInfo,
 	}
+	resultSink := engine.NewMemoryResultSink()
+	opts = append([]engine.Option{
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return testTime
+		}),
+	}, opts...)
+	me := engine.NewMatchEngine(c, resultSink, engine.NewMemoryMarketDataSink(), opts...)
+	return me, resultSink
+}
+
+// 撮合id从1开始递增，每个撮合引擎使用自己的计数，撮合结果中的id是确定的
+func testIdGenerator() engine.Option {
+	var id int64
+	return engine.WithIdGenerator(func() int64 {
+		id++
+		return id
+	})
+}
+
+// 第n条撮合结果的序号
+func resultSeq(n int64) int64 {
+	return testTime.UnixNano() + n
+}
 
-	// 创建Pulsar客户端
-	client, err := c.PulsarConfig.BuildClient()
-	if err != nil {
-		panic(err)
+// 第n条撮合结果的消息id
+func messageId(n int64) string {
+	return fmt.Sprintf("BTC_USDT_%v", resultSeq(n))
+}
+
+// 断言撮合结果的顺序和内容完全一致
+func assertMatchResp(t *testing.T, expected []*matchMq.MatchResp, actual []*matchMq.MatchResp) {
+	t.Helper()
+	if !assert.Equal(t, len(expected), len(actual)) {
+		for _, v := range actual {
+			t.Log(protojson.Format(v))
+		}
+		return
 	}
-	// 创建生产者
-	// 撮合引擎通过producer向Pulsar发送撮合结果
-	topic := pulsarConfig.Topic{
-		Tenant:    pulsarConfig.PublicTenant,  // public
-		Namespace: pulsarConfig.GexNamespace,  // trade
-		Topic:     pulsarConfig.MatchResultTopic + "_" + c.Symbol, // match_result_BTC_USDT
+	for i := range expected {
+		if !proto.Equal(expected[i], actual[i]) {
+			t.Errorf("match resp %v not equal\nexpected: %v\nactual: %v", i, protojson.Format(expected[i]), protojson.Format(actual[i]))
+		}
 	}
-	producer, err := client.CreateProducer(pulsar_client.ProducerOptions{
-		// Topic: "test_match_result",
-		Topic:           topic.BuildTopic(),
-		SendTimeout:     10 * time.Second,
-		DisableBatching: true, // 禁用批处理
-	})
-	if err != nil {
-		panic(err)
+}
+
+// 深度是异步更新的,等待卖盘深度的档位数量达到预期
+func assertAsksDepth(t *testing.T, me *engine.MatchEngine, levels int) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		return len(me.GetDepth(5).Asks) == levels
+	}, time.Second, 10*time.Millisecond)
+}
+
+// 撮合成交的结果,交易对和撮合时间是固定的
+func matchResultResp(n int64, result *matchMq.MatchResult) *matchMq.MatchResp {
+	result.SymbolId = 1
+	result.SymbolName = "BTC_USDT"
+	result.BaseCoinId = 1
+	result.QuoteCoinId = 2
+	result.MatchTime = testTime.UnixNano()
+	return &matchMq.MatchResp{
+		Resp:      &matchMq.MatchResp_MatchResult{MatchResult: result},
+		MessageId: messageId(n),
+		Seq:       resultSeq(n),
+	}
+}
+
+// 撤单的结果
+func cancelResp(n int64, cancel *matchMq.CancelResp) *matchMq.MatchResp {
+	return &matchMq.MatchResp{
+		Resp:      &matchMq.MatchResp_Cancel{Cancel: cancel},
+		MessageId: messageId(n),
+		Seq:       resultSeq(n),
 	}
-	// 创建WebSocket代理客户端
-	proxyClient := gpush.NewProxyClient(zrpc.MustNewClient(c.WsConf).Conn())
-    if err != nil {
-        panic(err)
-    }
-	return engine.NewMatchEngine(c, producer, proxyClient)
 }
+
+// 条件单触发的结果
+func triggerResp(n int64, trigger *matchMq.TriggerResp) *matchMq.MatchResp {
+	return &matchMq.MatchResp{
+		Resp:      &matchMq.MatchResp_Trigger{Trigger: trigger},
+		MessageId: messageId(n),
+		Seq:       resultSeq(n),
+	}
+}
+
 // 创建限价单
 func createLimitOrder(id int64, price string, qty string, side enum.Side) *engine.Order {
 	return &engine.Order{
@@ -101,11 +164,12 @@ func createLimitOrder(id int64, price string, qty string, side enum.Side) *engin
 		SequenceId:     id,
 		Side:          side,
 		OrderType:     enum.OrderType_LO,
-		Price:         utils.NewFromStringMaxPrec(price),
-		Qty:           utils.NewFromStringMaxPrec(qty),
-		UnfilledQty:   utils.NewFromStringMaxPrec(qty),
-		Amount:        utils.NewFromStringMaxPrec(price).Mul(utils.NewFromStringMaxPrec(qty)),
-		UnfilledAmount: utils.NewFromStringMaxPrec(price).Mul(utils.NewFromStringMaxPrec(qty)),
+		OrderStatus:   enum.OrderStatus_NewCreated,
+		Price:         utils.RequireFixedFromString(price),
+		Qty:           utils.RequireFixedFromString(qty),
+		UnfilledQty:   utils.RequireFixedFromString(qty),
+		Amount:        utils.RequireFixedFromString(price).Mul(utils.RequireFixedFromString(qty)),
+		UnfilledAmount: utils.RequireFixedFromString(price).Mul(utils.RequireFixedFromString(qty)),
 	}
 }
 // 创建市价单
@@ -115,25 +179,17 @@ func createMarketOrder(id int64, amount string, qty string, side enum.Side) *eng
 		SequenceId: id,
 		Side:       side,
 		OrderType:  enum.OrderType_MO,
-		Price:      decimal.Zero,
-		Qty:        utils.NewFromStringMaxPrec(qty),
-		UnfilledQty: utils.NewFromStringMaxPrec(qty),
-		Amount:     utils.NewFromStringMaxPrec(amount),
-		UnfilledAmount: utils.NewFromStringMaxPrec(amount),
+		OrderStatus: enum.OrderStatus_NewCreated,
+		Price:      utils.Fixed{},
+		Qty:        utils.RequireFixedFromString(qty),
+		UnfilledQty: utils.RequireFixedFromString(qty),
+		Amount:     utils.RequireFixedFromString(amount),
+		UnfilledAmount: utils.RequireFixedFromString(amount),
 	}
 }
 // 测试限价买单撮合
 func TestMatchLimitBuyOrder(t *testing.T) {
-	// 初始化idgen
-	idgen.SetIdGenerator(&idgen.IdGeneratorOptions{
-		WorkerId: 1, 
-		BaseTime: time.Now().UnixMilli(), 
-		WorkerIdBitLength: 6, 
-		SeqBitLength: 6, 
-		MaxSeqNumber: 0, 
-		MinSeqNumber: 5, 
-		TopOverCostCount: 2000})
-	me := createTestMatchEngine()
+	me, results := createTestMatchEngine()
 	
 	// 添加卖单
 	sellOrder := createLimitOrder(1, "100", "1", enum.Side_Sell)
@@ -154,19 +210,55 @@ func TestMatchLimitBuyOrder(t *testing.T) {
 	assert.Equal(t, "0.5", sellOrder.FilledQty.String())
 	assert.Equal(t, "50", sellOrder.FilledAmount.String())
 	assert.Equal(t, enum.OrderStatus_PartFilled, sellOrder.OrderStatus)
+
+	// 一次撮合,买单是taker
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
 }
 // 测试限价卖单撮合
 func TestMatchLimitSellOrder(t *testing.T) {
-	// 初始化idgen
-	idgen.SetIdGenerator(&idgen.IdGeneratorOptions{
-		WorkerId: 1, 
-		BaseTime: time.Now().UnixMilli(), 
-		WorkerIdBitLength: 6, 
-		SeqBitLength: 6, 
-		MaxSeqNumber: 0, 
-		MinSeqNumber: 5, 
-		TopOverCostCount: 2000})	
-	me := createTestMatchEngine()
+	me, results := createTestMatchEngine()
 	
 	// 添加买单
 	buyOrder := createLimitOrder(1, "100", "1", enum.Side_Buy)
@@ -184,19 +276,54 @@ func TestMatchLimitSellOrder(t *testing.T) {
 	assert.Equal(t, "0.5", buyOrder.FilledQty.String())
 	assert.Equal(t, "50", buyOrder.FilledAmount.String())
 	assert.Equal(t, enum.OrderStatus_PartFilled, buyOrder.OrderStatus)
+
+	// 一次撮合,卖单是taker
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
 }
 // 测试市价买单撮合
 func TestMatchMarketBuyOrder(t *testing.T) {
-	// 初始化idgen
-	idgen.SetIdGenerator(&idgen.IdGeneratorOptions{
-		WorkerId: 1, 
-		BaseTime: time.Now().UnixMilli(), 
-		WorkerIdBitLength: 6, 
-		SeqBitLength: 6, 
-		MaxSeqNumber: 0, 
-		MinSeqNumber: 5, 
-		TopOverCostCount: 2000})	
-	me := createTestMatchEngine()
+	me, results := createTestMatchEngine()
 	
 	// 添加卖单
 	sellOrder := createLimitOrder(1, "100", "1", enum.Side_Sell)
@@ -214,19 +341,128 @@ func TestMatchMarketBuyOrder(t *testing.T) {
 	assert.Equal(t, "1", sellOrder.FilledQty.String())
 	assert.Equal(t, "100", sellOrder.FilledAmount.String())
 	assert.Equal(t, enum.OrderStatus_ALLFilled, sellOrder.OrderStatus)
+
+	// 市价买单按金额撮合,未成交数量不变
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "1",
+			Amount:     "100",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "1",
+					Price:      "100",
+					Amount:     "100",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "1",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "100",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
+}
+// 测试市价买单的零头，按照金额能买的数量向下取整到基础币的精度，剩余的金额撤销
+func TestMatchMarketBuyDust(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	sellOrder := createLimitOrder(1, "30000.5", "1", enum.Side_Sell)
+	me.HandleOrder(sellOrder)
+
+	// 100/30000.5=0.00333322...,只能买0.0033,花费99.00165
+	buyOrder := createMarketOrder(2, "100", "0", enum.Side_Buy)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, "0.0033", buyOrder.FilledQty.String())
+	assert.Equal(t, "99.00165", buyOrder.FilledAmount.String())
+	assert.Equal(t, "0.99835", buyOrder.UnfilledAmount.String())
+	assert.Equal(t, enum.OrderStatus_PartFilled, buyOrder.OrderStatus)
+	assert.Equal(t, "0.9967", sellOrder.UnfilledQty.String())
+	assert.Equal(t, "29901.49835", sellOrder.UnfilledAmount.String())
+
+	// 零头不足一个最小单位，和下一个卖单也不能成交，撤销之后解冻
+	resp := results.Results()
+	if assert.Len(t, resp, 2) {
+		assert.True(t, proto.Equal(cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "0.99835"}), resp[1]))
+	}
+
+	// 金额不够买一个最小单位,不成交直接撤销
+	me.HandleOrder(createMarketOrder(3, "3", "0", enum.Side_Buy))
+	resp = results.Results()
+	if assert.Len(t, resp, 3) {
+		assert.True(t, proto.Equal(cancelResp(3, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "3"}), resp[2]))
+	}
+}
+
+// 测试精度超过交易对的精度、成交金额超出定点数范围的订单直接撤销
+func TestMatchFixedPointRejected(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 价格的精度是4位
+	me.HandleOrder(createLimitOrder(1, "100.12345", "1", enum.Side_Sell))
+	// 数量的精度是4位
+	me.HandleOrder(createLimitOrder(2, "100", "0.00001", enum.Side_Buy))
+	// 金额的指数是-8,超出int64的范围
+	me.HandleOrder(createLimitOrder(3, "1000000000", "1000000000", enum.Side_Buy))
+	// 卖单按照买一价校验成交金额
+	me.HandleOrder(createLimitOrder(4, "100000000", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(5, "1", "1000000000", enum.Side_Sell))
+
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1"}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "0.001"}),
+		cancelResp(3, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "1000000000000000000"}),
+		cancelResp(4, &matchMq.CancelResp{Id: 5, CoinId: 1, Qty: "1000000000"}),
+	}, results.Results())
+	assert.Len(t, me.GetDepth(5).Asks, 0)
 }
+
+// 测试订单簿中的汇总数量超出定点数范围的订单和修改直接拒绝，撮合引擎不会panic
+func TestMatchFixedPointOverflow(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 数量的指数是-4,两个订单的数量之和超出int64的范围
+	me.HandleOrder(createLimitOrder(1, "0.0001", "500000000000000", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "0.0001", "500000000000000", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "0.0002", "1", enum.Side_Sell))
+	me.HandleAmend(createAmendOrder(3, enum.Side_Sell, "0.0002", "0.0002", "500000000000000", "499999999999999", 10))
+
+	resp := results.Results()
+	if assert.Len(t, resp, 2) {
+		assert.True(t, proto.Equal(cancelResp(1, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "500000000000000"}), resp[0]))
+		assert.True(t, resp[1].GetAmend().GetRejected())
+	}
+	assertAsksDepth(t, me, 2)
+}
+
 // 测试市价卖单撮合
 func TestMatchMarketSellOrder(t *testing.T) {
-	// 初始化idgen
-	idgen.SetIdGenerator(&idgen.IdGeneratorOptions{
-		WorkerId: 1, 
-		BaseTime: time.Now().UnixMilli(), 
-		WorkerIdBitLength: 6, 
-		SeqBitLength: 6, 
-		MaxSeqNumber: 0, 
-		MinSeqNumber: 5, 
-		TopOverCostCount: 2000})	
-	me := createTestMatchEngine()
+	me, results := createTestMatchEngine()
 	
 	// 添加买单
 	buyOrder := createLimitOrder(1, "100", "1", enum.Side_Buy)
@@ -244,4 +480,2106 @@ func TestMatchMarketSellOrder(t *testing.T) {
 	assert.Equal(t, "0.5", buyOrder.FilledQty.String())
 	assert.Equal(t, "50", buyOrder.FilledAmount.String())
 	assert.Equal(t, enum.OrderStatus_PartFilled, buyOrder.OrderStatus)
-}
\ No newline at end of file
+
+	// 市价卖单按数量撮合
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
+}
+// 测试FOK订单对手盘数量不足时整单撤销
+func TestMatchFOKOrderKilled(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 添加卖单
+	sellOrder := createLimitOrder(1, "100", "0.5", enum.Side_Sell)
+	me.HandleOrder(sellOrder)
+
+	// FOK买单数量1,卖盘只有0.5,整单撤销
+	buyOrder := createLimitOrder(2, "100", "1", enum.Side_Buy)
+	buyOrder.OrderType = enum.OrderType_FOK
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, "0", buyOrder.FilledQty.String())
+	assert.Equal(t, "0", sellOrder.FilledQty.String())
+
+	// 只有撤单,解冻买单冻结的计价币
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "100"}),
+	}, results.Results())
+}
+
+// 测试FOK订单全部成交
+func TestMatchFOKOrderFilled(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	me.HandleOrder(createLimitOrder(1, "100", "0.5", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "101", "0.5", enum.Side_Sell))
+
+	// FOK买单价格101数量1,两档加起来刚好够
+	buyOrder := createLimitOrder(3, "101", "1", enum.Side_Buy)
+	buyOrder.OrderType = enum.OrderType_FOK
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, "1", buyOrder.FilledQty.String())
+	assert.Equal(t, "100.5", buyOrder.FilledAmount.String())
+	assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus)
+
+	// 一次撮合吃掉两档卖单
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "3",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "101",
+			Qty:        "1",
+			Amount:     "100.5",
+			HighPrice:  "101",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50.5",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						UnFrozenAmount: "50.5",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+				{
+					Qty:        "0.5",
+					Price:      "101",
+					Amount:     "50.5",
+					MatchSubId: "2",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100.5",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "101",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50.5",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
+}
+
+// 测试IOC订单部分成交,剩余部分不进入订单簿
+func TestMatchIOCOrder(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 添加买单
+	buyOrder := createLimitOrder(1, "100", "0.5", enum.Side_Buy)
+	me.HandleOrder(buyOrder)
+
+	// IOC卖单数量1,成交0.5,剩余0.5撤销
+	sellOrder := createLimitOrder(2, "100", "1", enum.Side_Sell)
+	sellOrder.OrderType = enum.OrderType_IOC
+	me.HandleOrder(sellOrder)
+
+	assert.Equal(t, "0.5", sellOrder.FilledQty.String())
+	assert.Equal(t, "0.5", sellOrder.UnfilledQty.String())
+	assert.Equal(t, enum.OrderStatus_PartFilled, sellOrder.OrderStatus)
+	assertAsksDepth(t, me, 0)
+
+	// 先撮合,再撤销剩余的0.5,解冻基础币
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "0.5"}),
+	}, results.Results())
+}
+
+func TestMatchPostOnlyOrder(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 添加买单
+	buyOrder := createLimitOrder(1, "100", "1", enum.Side_Buy)
+	me.HandleOrder(buyOrder)
+
+	// 只做maker的卖单会立即成交,整单撤销
+	sellOrder := createLimitOrder(2, "100", "1", enum.Side_Sell)
+	sellOrder.PostOnly = true
+	me.HandleOrder(sellOrder)
+
+	assert.Equal(t, "0", sellOrder.FilledQty.String())
+	assert.Equal(t, "1", buyOrder.UnfilledQty.String())
+	assertAsksDepth(t, me, 0)
+
+	// 不会立即成交的只做maker卖单进入订单簿
+	sellOrder = createLimitOrder(3, "101", "1", enum.Side_Sell)
+	sellOrder.PostOnly = true
+	me.HandleOrder(sellOrder)
+
+	assert.Equal(t, enum.OrderStatus_NewCreated, sellOrder.OrderStatus)
+	assertAsksDepth(t, me, 1)
+
+	// 只有第一个只做maker卖单的撤单
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "1"}),
+	}, results.Results())
+}
+
+func TestMatchStopLimitOrder(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	// 添加卖单
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "101", "1", enum.Side_Sell))
+
+	// 止损限价买单,最新成交价达到100触发,按照101的限价买入
+	stopOrder := createLimitOrder(3, "101", "1", enum.Side_Buy)
+	stopOrder.TriggerPrice = utils.RequireFixedFromString("100")
+	stopOrder.TriggerStatus = enum.TriggerStatus_Untriggered
+	me.HandleOrder(stopOrder)
+
+	assert.Equal(t, enum.TriggerStatus_Untriggered, stopOrder.TriggerStatus)
+	assertAsksDepth(t, me, 2)
+
+	// 成交价100,触发止损单
+	me.HandleOrder(createLimitOrder(4, "100", "1", enum.Side_Buy))
+
+	assert.Equal(t, enum.TriggerStatus_Triggered, stopOrder.TriggerStatus)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, stopOrder.OrderStatus)
+	assertAsksDepth(t, me, 0)
+
+	// 撮合,触发条件单,条件单撮合
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "1",
+			Amount:     "100",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "1",
+					Price:      "100",
+					Amount:     "100",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             4,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "100",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+		triggerResp(2, &matchMq.TriggerResp{Id: 3, OrderId: "test_order", TriggerPrice: "100", LastPrice: "100"}),
+		matchResultResp(3, &matchMq.MatchResult{
+			MatchId:    "4",
+			TakerIsBuy: true,
+			BeginPrice: "101",
+			EndPrice:   "101",
+			Qty:        "1",
+			Amount:     "101",
+			HighPrice:  "101",
+			LowPrice:   "101",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "1",
+					Price:      "101",
+					Amount:     "101",
+					MatchSubId: "3",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "101",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "101",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "101",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, results.Results())
+}
+
+func TestMatchEngineSnapshot(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "99", "1", enum.Side_Buy))
+
+	// 没有成交,不产生撮合结果
+	assert.Equal(t, 0, len(results.Results()))
+
+	// 保存快照后从快照恢复到新的撮合引擎
+	path := engine.SnapshotPath(t.TempDir(), "IKUN_USDT")
+	data, err := me.TakeSnapshot([]byte("message_id")).Encode()
+	assert.Nil(t, err)
+	assert.Nil(t, engine.SaveSnapshot(path, data))
+	snapshot, err := engine.LoadSnapshot(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(snapshot.Asks))
+	assert.Equal(t, 1, len(snapshot.DepthAsks))
+	assert.Equal(t, "3", snapshot.DepthAsks[0].Qty.String())
+	assert.Equal(t, []byte("message_id"), snapshot.MessageId)
+
+	restored, restoredResults := createTestMatchEngine()
+	restored.RestoreSnapshot(snapshot)
+	depth := restored.GetDepth(5)
+	assert.Equal(t, 1, len(depth.Asks))
+	assert.Equal(t, 1, len(depth.Bids))
+
+	// 恢复后的订单簿可以继续撮合
+	buyOrder := createLimitOrder(4, "100", "3", enum.Side_Buy)
+	restored.HandleOrder(buyOrder)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus)
+
+	// 恢复后撮合结果的消息id从快照中的序号继续
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "3",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "3",
+			Amount:     "300",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "1",
+					Price:      "100",
+					Amount:     "100",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             4,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "2",
+						FilledAmount:   "100",
+						UnFilledAmount: "200",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						UnFrozenAmount: "100",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+				{
+					Qty:        "2",
+					Price:      "100",
+					Amount:     "200",
+					MatchSubId: "2",
+					Taker: &matchMq.OrderResp{
+						Id:             4,
+						OrderId:        "test_order",
+						FilledQty:      "3",
+						UnFilledQty:    "0",
+						FilledAmount:   "300",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "300",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "2",
+						UnFilledQty:    "0",
+						FilledAmount:   "200",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+	}, restoredResults.Results())
+}
+
+// 创建用户的限价单,指定自成交保护模式
+func createSTPLimitOrder(id, uid int64, price string, qty string, side enum.Side, mode enum.STPMode) *engine.Order {
+	o := createLimitOrder(id, price, qty, side)
+	o.Uid = uid
+	o.STPMode = mode
+	return o
+}
+
+// 测试自成交保护,撤销新订单
+func TestMatchSTPCancelNewest(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	me.HandleOrder(createSTPLimitOrder(1, 1, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+	me.HandleOrder(createSTPLimitOrder(2, 2, "101", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+
+	// 买单遇到自己的卖单,买单撤销,不会和后面的卖单成交
+	buyOrder := createSTPLimitOrder(3, 1, "101", "2", enum.Side_Buy, enum.STPMode_CancelNewest)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, enum.OrderStatus_Canceled, buyOrder.OrderStatus)
+	assertAsksDepth(t, me, 2)
+
+	// 买单撤销解冻全部的计价币
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "202", Uid: 1}),
+	}, results.Results())
+}
+
+// 测试自成交保护,撤销旧订单
+func TestMatchSTPCancelOldest(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	sellOrder := createSTPLimitOrder(1, 1, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode)
+	me.HandleOrder(sellOrder)
+	me.HandleOrder(createSTPLimitOrder(2, 2, "101", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+
+	// 买单遇到自己的卖单,卖单撤销,买单继续和其他用户的卖单成交
+	buyOrder := createSTPLimitOrder(3, 1, "101", "1", enum.Side_Buy, enum.STPMode_CancelOldest)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, enum.OrderStatus_Canceled, sellOrder.OrderStatus)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus)
+	assertAsksDepth(t, me, 0)
+
+	// 先发送撮合结果,再发送卖单撤单
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "101",
+			EndPrice:   "101",
+			Qty:        "1",
+			Amount:     "101",
+			HighPrice:  "101",
+			LowPrice:   "101",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "1",
+					Price:      "101",
+					Amount:     "101",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						Uid:            1,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "101",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "101",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             2,
+						Uid:            2,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "101",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+		cancelResp(2, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1", Uid: 1}),
+	}, results.Results())
+}
+
+// 测试市价单全部成交之后不再和自己的订单做自成交保护,自己的订单留在订单簿中
+func TestMatchSTPMarketOrderFilled(t *testing.T) {
+	for _, mode := range []enum.STPMode{enum.STPMode_CancelNewest, enum.STPMode_CancelOldest, enum.STPMode_CancelBoth} {
+		me, results := createTestMatchEngine()
+		me.HandleOrder(createSTPLimitOrder(1, 2, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+		me.HandleOrder(createSTPLimitOrder(2, 1, "101", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+		me.HandleOrder(createSTPLimitOrder(3, 2, "99", "1", enum.Side_Buy, enum.STPMode_UnknownSTPMode))
+		me.HandleOrder(createSTPLimitOrder(4, 1, "98", "1", enum.Side_Buy, enum.STPMode_UnknownSTPMode))
+		results.Reset()
+
+		// 市价买单的金额刚好和卖一成交完
+		buyOrder := createMarketOrder(5, "100", "0", enum.Side_Buy)
+		buyOrder.Uid, buyOrder.STPMode = 1, mode
+		me.HandleOrder(buyOrder)
+		// 市价卖单的数量刚好和买一成交完
+		sellOrder := createMarketOrder(6, "0", "1", enum.Side_Sell)
+		sellOrder.Uid, sellOrder.STPMode = 1, mode
+		me.HandleOrder(sellOrder)
+
+		assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus, mode)
+		assert.Equal(t, enum.OrderStatus_ALLFilled, sellOrder.OrderStatus, mode)
+		assertAsksDepth(t, me, 1)
+		resp := results.Results()
+		if assert.Len(t, resp, 2, mode) {
+			assert.NotNil(t, resp[0].GetMatchResult(), mode)
+			assert.NotNil(t, resp[1].GetMatchResult(), mode)
+		}
+		assert.Eventually(t, func() bool {
+			depth := me.GetDepth(5)
+			return len(depth.Asks) == 1 && depth.Asks[0].Price == "101" && len(depth.Bids) == 1 && depth.Bids[0].Price == "98"
+		}, time.Second, 10*time.Millisecond, mode)
+	}
+}
+
+// 测试自成交保护,新旧订单都撤销,交易对默认的模式
+func TestMatchSTPCancelBoth(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.STPMode.Store(int32(enum.STPMode_CancelBoth))
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	sellOrder := createSTPLimitOrder(1, 1, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode)
+	me.HandleOrder(sellOrder)
+
+	buyOrder := createSTPLimitOrder(2, 1, "100", "2", enum.Side_Buy, enum.STPMode_UnknownSTPMode)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, enum.OrderStatus_Canceled, sellOrder.OrderStatus)
+	assert.Equal(t, enum.OrderStatus_Canceled, buyOrder.OrderStatus)
+	assertAsksDepth(t, me, 0)
+
+	// 卖单解冻基础币,买单解冻计价币
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1", Uid: 1}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "200", Uid: 1}),
+	}, results.Results())
+}
+
+// 测试自成交保护,减少数量,maker的数量较大
+func TestMatchSTPDecrementMaker(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	sellOrder := createSTPLimitOrder(1, 1, "100", "2", enum.Side_Sell, enum.STPMode_UnknownSTPMode)
+	me.HandleOrder(sellOrder)
+
+	// 两个订单都减少0.5,买单数量减为0撤销,卖单剩余1.5留在订单簿
+	buyOrder := createSTPLimitOrder(2, 1, "100", "0.5", enum.Side_Buy, enum.STPMode_DecrementAndCancel)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, enum.OrderStatus_Canceled, buyOrder.OrderStatus)
+	assert.Equal(t, "1.5", sellOrder.UnfilledQty.String())
+	assertAsksDepth(t, me, 1)
+
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "0.5", Uid: 1, DecrementQty: "0.5", UnFilledQty: "1.5", UnFilledAmount: "150", OrderQty: "1.5", OrderAmount: "150"}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "50", Uid: 1}),
+	}, results.Results())
+}
+
+// 测试自成交保护,减少数量,taker的数量较大,减少之后继续撮合
+func TestMatchSTPDecrementTaker(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	me.HandleOrder(createSTPLimitOrder(1, 1, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+	me.HandleOrder(createSTPLimitOrder(2, 2, "100", "1", enum.Side_Sell, enum.STPMode_UnknownSTPMode))
+
+	// 买单和自己的卖单都减少1,卖单撤销,买单剩余的0.5和其他用户的卖单成交
+	buyOrder := createSTPLimitOrder(3, 1, "100", "1.5", enum.Side_Buy, enum.STPMode_DecrementAndCancel)
+	me.HandleOrder(buyOrder)
+
+	assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus)
+	assert.Equal(t, "0.5", buyOrder.Qty.String())
+	assertAsksDepth(t, me, 1)
+
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						Uid:            1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             2,
+						Uid:            2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						Fee:            "0",
+					},
+					TakerFee: "0",
+					MakerFee: "0",
+				},
+			},
+		}),
+		cancelResp(2, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "100", Uid: 1, DecrementQty: "1", UnFilledQty: "0", UnFilledAmount: "0", OrderQty: "0.5", OrderAmount: "50"}),
+		cancelResp(3, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1", Uid: 1}),
+	}, results.Results())
+}
+
+// 测试手续费,买单收取基础币,卖单收取计价币,maker累计多次成交的手续费
+func TestMatchFee(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	sellOrder := createLimitOrder(1, "100", "1", enum.Side_Sell)
+	sellOrder.MakerFeeRate = utils.RequireFixedFromString("0.001")
+	sellOrder.TakerFeeRate = utils.RequireFixedFromString("0.002")
+	me.HandleOrder(sellOrder)
+
+	buyOrder := createLimitOrder(2, "100", "0.5", enum.Side_Buy)
+	buyOrder.MakerFeeRate = utils.RequireFixedFromString("0.001")
+	buyOrder.TakerFeeRate = utils.RequireFixedFromString("0.002")
+	me.HandleOrder(buyOrder)
+
+	// 第二个买单没有手续费率,不收手续费
+	me.HandleOrder(createLimitOrder(3, "100", "0.5", enum.Side_Buy))
+
+	assert.Equal(t, "0.001", buyOrder.Fee.String())
+	assert.Equal(t, "0.1", sellOrder.Fee.String())
+
+	assertMatchResp(t, []*matchMq.MatchResp{
+		matchResultResp(1, &matchMq.MatchResult{
+			MatchId:    "2",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "1",
+					Taker: &matchMq.OrderResp{
+						Id:             2,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0.001",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0.5",
+						FilledAmount:   "50",
+						UnFilledAmount: "50",
+						OrderStatus:    enum.OrderStatus_PartFilled,
+						Fee:            "0.05",
+					},
+					TakerFee: "0.001",
+					MakerFee: "0.05",
+				},
+			},
+		}),
+		matchResultResp(2, &matchMq.MatchResult{
+			MatchId:    "4",
+			TakerIsBuy: true,
+			BeginPrice: "100",
+			EndPrice:   "100",
+			Qty:        "0.5",
+			Amount:     "50",
+			HighPrice:  "100",
+			LowPrice:   "100",
+			MatchedRecord: []*matchMq.MatchResult_MatchedRecord{
+				{
+					Qty:        "0.5",
+					Price:      "100",
+					Amount:     "50",
+					MatchSubId: "3",
+					Taker: &matchMq.OrderResp{
+						Id:             3,
+						OrderId:        "test_order",
+						FilledQty:      "0.5",
+						UnFilledQty:    "0",
+						FilledAmount:   "50",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						UnFrozenAmount: "50",
+						Fee:            "0",
+					},
+					Maker: &matchMq.OrderResp{
+						Id:             1,
+						OrderId:        "test_order",
+						FilledQty:      "1",
+						UnFilledQty:    "0",
+						FilledAmount:   "100",
+						UnFilledAmount: "0",
+						OrderStatus:    enum.OrderStatus_ALLFilled,
+						Fee:            "0.1",
+					},
+					TakerFee: "0",
+					MakerFee: "0.05",
+				},
+			},
+		}),
+	}, results.Results())
+}
+
+// 测试手续费保留到收到的币的精度,不按照金额的精度
+func TestMatchFeePrecision(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	buyOrder := createLimitOrder(1, "100.0001", "1.0001", enum.Side_Buy)
+	buyOrder.MakerFeeRate = utils.RequireFixedFromString("0.001")
+	me.HandleOrder(buyOrder)
+	sellOrder := createLimitOrder(2, "100.0001", "1.0001", enum.Side_Sell)
+	sellOrder.TakerFeeRate = utils.RequireFixedFromString("0.001")
+	me.HandleOrder(sellOrder)
+
+	// 基础币和计价币的精度都是4位,成交金额100.01010001
+	assert.Equal(t, "0.001", buyOrder.Fee.String())
+	assert.Equal(t, "0.1", sellOrder.Fee.String())
+	resp := results.Results()
+	if assert.Len(t, resp, 1) {
+		record := resp[0].GetMatchResult().GetMatchedRecord()[0]
+		assert.Equal(t, "100.01010001", record.Amount)
+		assert.Equal(t, "0.001", record.MakerFee)
+		assert.Equal(t, "0.1", record.TakerFee)
+	}
+}
+
+func TestMatchTradingRule(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.TickSizeValue = "0.5"
+	symbolInfo.LotSizeValue = "0.1"
+	symbolInfo.MinQtyValue = "0.1"
+	symbolInfo.MaxQtyValue = "10"
+	symbolInfo.MinNotionalValue = "10"
+	symbolInfo.MaxNotionalValue = "1000"
+	symbolInfo.StoreTradingRule()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	// 价格不是0.5的整数倍
+	me.HandleOrder(createLimitOrder(1, "100.3", "1", enum.Side_Sell))
+	// 数量不是0.1的整数倍
+	me.HandleOrder(createLimitOrder(2, "100", "0.15", enum.Side_Sell))
+	// 数量超过最大下单数量
+	me.HandleOrder(createLimitOrder(3, "100", "11", enum.Side_Buy))
+	// 金额小于最小下单金额
+	me.HandleOrder(createLimitOrder(4, "5", "1", enum.Side_Buy))
+	// 符合规则的订单进入订单簿
+	me.HandleOrder(createLimitOrder(5, "100", "1", enum.Side_Sell))
+	// 市价买单金额小于最小下单金额
+	me.HandleOrder(createMarketOrder(6, "5", "0", enum.Side_Buy))
+	// 市价卖单数量不是0.1的整数倍
+	me.HandleOrder(createMarketOrder(7, "0", "0.05", enum.Side_Sell))
+
+	assertAsksDepth(t, me, 1)
+	// 不符合规则的订单整单撤销
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1"}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "0.15"}),
+		cancelResp(3, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "1100"}),
+		cancelResp(4, &matchMq.CancelResp{Id: 4, CoinId: 2, Qty: "5"}),
+		cancelResp(5, &matchMq.CancelResp{Id: 6, CoinId: 2, Qty: "5"}),
+		cancelResp(6, &matchMq.CancelResp{Id: 7, CoinId: 1, Qty: "0.05"}),
+	}, results.Results())
+}
+
+// 测试运行期间修改交易规则，撮合引擎使用修改之后的规则校验
+func TestMatchTradingRuleReload(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.MaxNotionalValue = "1000"
+	symbolInfo.StoreTradingRule()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	// 金额刚好等于最大下单金额
+	me.HandleOrder(createLimitOrder(1, "100", "10", enum.Side_Sell))
+	assertAsksDepth(t, me, 1)
+
+	symbolInfo.MaxNotionalValue = "500"
+	symbolInfo.TickSizeValue = "0.5"
+	symbolInfo.StoreTradingRule()
+	// 金额超过修改之后的最大下单金额
+	me.HandleOrder(createLimitOrder(2, "100", "6", enum.Side_Sell))
+	// 价格不是修改之后的最小变动单位的整数倍
+	me.HandleOrder(createLimitOrder(3, "100.1", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(4, "100.5", "1", enum.Side_Sell))
+	assertAsksDepth(t, me, 2)
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "6"}),
+		cancelResp(2, &matchMq.CancelResp{Id: 3, CoinId: 1, Qty: "1"}),
+	}, results.Results())
+}
+
+// 测试重启之后恢复的订单不再校验交易规则，修改规则之前下的订单仍然放回订单簿
+func TestMatchReloadOrder(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.MaxNotionalValue = "500"
+	symbolInfo.TickSizeValue = "0.5"
+	symbolInfo.StoreTradingRule()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	me.ReloadOrder(createLimitOrder(1, "100", "6", enum.Side_Sell))
+	me.ReloadOrder(createLimitOrder(2, "99.1", "1", enum.Side_Buy))
+	assertAsksDepth(t, me, 1)
+	assert.Eventually(t, func() bool {
+		return len(me.GetDepth(5).Bids) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, results.Results(), 0)
+	// 恢复的订单转换为交易对精度的定点数
+	if order, ok := me.GetOrder(2); assert.True(t, ok) {
+		assert.Equal(t, int32(-4), order.Price.Exp())
+		assert.Equal(t, int32(-8), order.UnfilledAmount.Exp())
+	}
+
+	// 新订单仍然按照规则校验
+	me.HandleOrder(createLimitOrder(3, "100.1", "1", enum.Side_Sell))
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 3, CoinId: 1, Qty: "1"}),
+	}, results.Results())
+
+	// 恢复的订单和新订单正常撮合
+	me.HandleOrder(createLimitOrder(4, "100", "1", enum.Side_Buy))
+	resp := results.Results()
+	if assert.Len(t, resp, 2) {
+		record := resp[1].GetMatchResult().GetMatchedRecord()[0]
+		assert.Equal(t, int64(1), record.Maker.Id)
+		assert.Equal(t, "100", record.Amount)
+		assert.Equal(t, "5", record.Maker.UnFilledQty)
+	}
+}
+
+func TestMatchPriceBand(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.PriceBandValue = "0.1"
+	symbolInfo.ReferencePriceValue = "100"
+	symbolInfo.StorePriceProtection()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "105", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "120", "1", enum.Side_Sell))
+
+	// 没有成交价时以参考价格100为准,市价买单只能成交到110
+	buyOrder := createMarketOrder(4, "1000", "0", enum.Side_Buy)
+	me.HandleOrder(buyOrder)
+	assert.Equal(t, "205", buyOrder.FilledAmount.String())
+	assert.Equal(t, "2", buyOrder.FilledQty.String())
+	assertAsksDepth(t, me, 1)
+
+	// 最新成交价105,市价卖单只能成交到94.5
+	me.HandleOrder(createLimitOrder(5, "100", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(6, "95", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(7, "80", "1", enum.Side_Buy))
+	sellOrder := createMarketOrder(8, "0", "3", enum.Side_Sell)
+	me.HandleOrder(sellOrder)
+	assert.Equal(t, "2", sellOrder.FilledQty.String())
+
+	// 超出范围的部分撤销
+	resp := results.Results()
+	if assert.Len(t, resp, 4) {
+		assert.True(t, proto.Equal(cancelResp(2, &matchMq.CancelResp{Id: 4, CoinId: 2, Qty: "795"}), resp[1]))
+		assert.True(t, proto.Equal(cancelResp(4, &matchMq.CancelResp{Id: 8, CoinId: 1, Qty: "1"}), resp[3]))
+	}
+}
+
+// 测试市价单的最大滑点和保护价格,超出的部分撤销
+func TestMatchMarketSlippage(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "103", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "110", "1", enum.Side_Sell))
+
+	// 卖一价100,最大滑点5%,只能成交到105
+	buyOrder := createMarketOrder(4, "1000", "0", enum.Side_Buy)
+	buyOrder.MaxSlippage = utils.RequireFixedFromString("0.05")
+	me.HandleOrder(buyOrder)
+	assert.Equal(t, "203", buyOrder.FilledAmount.String())
+	assert.Equal(t, "2", buyOrder.FilledQty.String())
+	assertAsksDepth(t, me, 1)
+
+	// 保护价格95,低于95的买单不成交
+	me.HandleOrder(createLimitOrder(5, "100", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(6, "98", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(7, "90", "1", enum.Side_Buy))
+	sellOrder := createMarketOrder(8, "0", "3", enum.Side_Sell)
+	sellOrder.ProtectPrice = utils.RequireFixedFromString("95")
+	me.HandleOrder(sellOrder)
+	assert.Equal(t, "2", sellOrder.FilledQty.String())
+	assert.Eventually(t, func() bool {
+		return len(me.GetDepth(5).Bids) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	resp := results.Results()
+	if assert.Len(t, resp, 4) {
+		assert.True(t, proto.Equal(cancelResp(2, &matchMq.CancelResp{Id: 4, CoinId: 2, Qty: "797"}), resp[1]))
+		assert.True(t, proto.Equal(cancelResp(4, &matchMq.CancelResp{Id: 8, CoinId: 1, Qty: "1"}), resp[3]))
+	}
+}
+
+// 测试按照数量的市价买单,全部成交时没有用完的金额随撮合结果解冻,金额不够时剩余的部分撤销
+func TestMatchMarketBuyByQty(t *testing.T) {
+	me, results := createTestMatchEngine()
+
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "102", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "105", "5", enum.Side_Sell))
+
+	// 按照深度冻结2*102=204,实际花费202
+	buyOrder := createMarketOrder(4, "204", "2", enum.Side_Buy)
+	buyOrder.ByQty = true
+	me.HandleOrder(buyOrder)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, buyOrder.OrderStatus)
+	assert.Equal(t, "202", buyOrder.FilledAmount.String())
+	assert.Equal(t, "2", buyOrder.UnfilledAmount.String())
+	resp := results.Results()
+	if assert.Len(t, resp, 1) {
+		records := resp[0].GetMatchResult().MatchedRecord
+		if assert.Len(t, records, 2) {
+			assert.Equal(t, "204", records[1].Taker.UnFrozenAmount)
+			assert.Equal(t, "202", records[1].Taker.FilledAmount)
+			assert.Equal(t, "2", records[1].Taker.FilledQty)
+		}
+	}
+
+	// 成交1@102之后剩余48,按照105只能买0.4571,剩余的金额撤销
+	buyOrder = createMarketOrder(5, "150", "2", enum.Side_Buy)
+	buyOrder.ByQty = true
+	me.HandleOrder(buyOrder)
+	assert.Equal(t, "1.4571", buyOrder.FilledQty.String())
+	assert.Equal(t, "149.9955", buyOrder.FilledAmount.String())
+	assertAsksDepth(t, me, 1)
+	resp = results.Results()
+	if assert.Len(t, resp, 3) {
+		records := resp[1].GetMatchResult().MatchedRecord
+		if assert.Len(t, records, 2) {
+			assert.Equal(t, "149.9955", records[1].Taker.UnFrozenAmount)
+		}
+		assert.True(t, proto.Equal(cancelResp(3, &matchMq.CancelResp{Id: 5, CoinId: 2, Qty: "0.0045"}), resp[2]))
+	}
+}
+
+func TestMatchCircuitBreaker(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.CircuitBreakerValue = "0.1"
+	symbolInfo.CircuitBreakerWindowValue = 60
+	symbolInfo.HaltDurationValue = 300
+	symbolInfo.StorePriceProtection()
+	now := testTime
+	results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: symbolInfo}, results, marketData,
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return now
+		}),
+	)
+
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(3, "130", "1", enum.Side_Sell))
+
+	// 窗口内价格上涨15%,超过10%的阈值,暂停交易
+	now = now.Add(10 * time.Second)
+	me.HandleOrder(createLimitOrder(4, "115", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(5, "115", "1", enum.Side_Buy))
+	results.Reset()
+
+	// 熔断期间新订单直接撤销,撤单正常处理
+	me.HandleOrder(createLimitOrder(6, "90", "1", enum.Side_Buy))
+	me.HandleOrder(&engine.Order{
+		SequenceId: 3,
+		Side:       enum.Side_Sell,
+		OrderType:  enum.OrderType_LO,
+		Price:      utils.RequireFixedFromString("130"),
+		IsCancel:   true,
+	})
+	assertAsksDepth(t, me, 0)
+	resp := results.Results()
+	if assert.Len(t, resp, 2) {
+		assert.Equal(t, int64(6), resp[0].GetCancel().GetId())
+		assert.Equal(t, "90", resp[0].GetCancel().GetQty())
+		assert.Equal(t, int64(3), resp[1].GetCancel().GetId())
+	}
+
+	// 熔断结束之后恢复交易
+	now = now.Add(301 * time.Second)
+	me.HandleOrder(createLimitOrder(7, "120", "1", enum.Side_Sell))
+	assertAsksDepth(t, me, 1)
+
+	halts := make([]string, 0, 2)
+	for _, v := range marketData.Data() {
+		if v.Topic == "halt@BTC_USDT" {
+			halts = append(halts, string(v.Data))
+		}
+	}
+	if assert.Len(t, halts, 2) {
+		assert.Contains(t, halts[0], `"h":true`)
+		assert.Contains(t, halts[1], `"h":false`)
+	}
+}
+
+// 测试价格波动刚好达到熔断阈值时暂停交易
+func TestMatchCircuitBreakerThreshold(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.CircuitBreakerValue = "0.1"
+	symbolInfo.CircuitBreakerWindowValue = 60
+	symbolInfo.HaltDurationValue = 300
+	symbolInfo.StorePriceProtection()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Buy))
+	// 波动9.99%没有达到阈值
+	me.HandleOrder(createLimitOrder(3, "90.01", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(4, "90.01", "1", enum.Side_Buy))
+	// 波动10%达到阈值
+	me.HandleOrder(createLimitOrder(5, "110", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(6, "110", "1", enum.Side_Buy))
+	matched := 0
+	for _, v := range results.Results() {
+		if v.GetMatchResult() != nil {
+			matched++
+		}
+	}
+	assert.Equal(t, 3, matched)
+	results.Reset()
+
+	me.HandleOrder(createLimitOrder(7, "110", "1", enum.Side_Sell))
+	assertAsksDepth(t, me, 0)
+	resp := results.Results()
+	if assert.Len(t, resp, 1) {
+		assert.Equal(t, int64(7), resp[0].GetCancel().GetId())
+	}
+}
+
+// 测试人工暂停交易，暂停期间只接受撤单，恢复之后正常撮合
+func TestMatchManualHalt(t *testing.T) {
+	results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo()}, results, marketData,
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return testTime
+		}),
+	)
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "101", "1", enum.Side_Sell))
+	me.HandleHalt(&engine.Halt{Halted: true})
+	assert.True(t, me.GetStats().ManualHalt)
+
+	// 暂停期间新订单和修改订单直接拒绝,撤单正常处理
+	me.HandleOrder(createLimitOrder(3, "100", "1", enum.Side_Buy))
+	me.HandleAmend(createAmendOrder(1, enum.Side_Sell, "100", "99", "1", "0", 10))
+	me.HandleOrder(&engine.Order{
+		SequenceId: 2,
+		Side:       enum.Side_Sell,
+		OrderType:  enum.OrderType_LO,
+		IsCancel:   true,
+	})
+	resp := results.Results()
+	if assert.Len(t, resp, 3) {
+		assert.True(t, proto.Equal(cancelResp(1, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "100"}), resp[0]))
+		assert.True(t, resp[1].GetAmend().GetRejected())
+		assert.Equal(t, int64(2), resp[2].GetCancel().GetId())
+	}
+
+	// 快照中保存暂停的状态
+	restored, _ := createTestMatchEngine()
+	restored.RestoreSnapshot(me.TakeSnapshot(nil))
+	assert.True(t, restored.GetStats().ManualHalt)
+
+	// 恢复之后正常撮合
+	me.HandleHalt(&engine.Halt{Halted: false})
+	me.HandleOrder(createLimitOrder(4, "100", "0.4", enum.Side_Buy))
+	resp = results.Results()
+	if assert.Len(t, resp, 4) {
+		assert.Equal(t, "0.4", resp[3].GetMatchResult().GetMatchedRecord()[0].GetQty())
+	}
+
+	// 查询订单和订单簿
+	order, ok := me.GetOrder(1)
+	if assert.True(t, ok) {
+		assert.Equal(t, "0.6", order.UnfilledQty.String())
+		assert.Equal(t, enum.OrderStatus_PartFilled, order.OrderStatus)
+	}
+	_, ok = me.GetOrder(2)
+	assert.False(t, ok)
+	book := me.GetBook()
+	if assert.Len(t, book.Asks, 1) {
+		assert.Equal(t, int64(1), book.Asks[0].SequenceId)
+	}
+	assert.Len(t, book.Bids, 0)
+	stats := me.GetStats()
+	assert.False(t, stats.ManualHalt)
+	assert.Equal(t, 1, stats.AskOrders)
+	assert.Equal(t, 1, stats.AskLevels)
+	assert.Equal(t, "100", stats.LastPrice.String())
+
+	halts := make([]string, 0, 2)
+	for _, v := range marketData.Data() {
+		if v.Topic == "halt@BTC_USDT" {
+			halts = append(halts, string(v.Data))
+		}
+	}
+	if assert.Len(t, halts, 2) {
+		assert.Contains(t, halts[0], `"h":true`)
+		assert.Contains(t, halts[1], `"h":false`)
+	}
+}
+
+// 测试暂停交易期间重启，订单服务中未完成的订单恢复到订单簿，不按照暂停和价格范围撤销
+func TestMatchReloadDuringHalt(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.PriceBandValue = "0.1"
+	symbolInfo.ReferencePriceValue = "100"
+	symbolInfo.StorePriceProtection()
+	me, results := createTestMatchEngineWithSymbol(symbolInfo)
+	me.HandleHalt(&engine.Halt{Halted: true})
+
+	// 价格超出参考价格10%的范围
+	me.ReloadOrder(createLimitOrder(1, "120", "1", enum.Side_Sell))
+	me.ReloadOrder(createLimitOrder(2, "80", "1", enum.Side_Buy))
+	stopOrder := createLimitOrder(3, "121", "1", enum.Side_Buy)
+	stopOrder.TriggerPrice = utils.RequireFixedFromString("120")
+	stopOrder.TriggerStatus = enum.TriggerStatus_Untriggered
+	me.ReloadOrder(stopOrder)
+	assertAsksDepth(t, me, 1)
+	assert.Len(t, results.Results(), 0)
+	stats := me.GetStats()
+	assert.True(t, stats.ManualHalt)
+	assert.Equal(t, 1, stats.AskOrders)
+	assert.Equal(t, 1, stats.BidOrders)
+	assert.Equal(t, 1, stats.TriggerOrders)
+
+	// 暂停期间新订单仍然拒绝
+	me.HandleOrder(createLimitOrder(4, "120", "1", enum.Side_Buy))
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 4, CoinId: 2, Qty: "120"}),
+	}, results.Results())
+
+	// 恢复交易之后和恢复的订单成交，成交价120触发恢复的条件单
+	me.HandleHalt(&engine.Halt{Halted: false})
+	me.HandleOrder(createLimitOrder(5, "120", "1", enum.Side_Buy))
+	resp := results.Results()
+	if assert.Len(t, resp, 3) {
+		record := resp[1].GetMatchResult().GetMatchedRecord()[0]
+		assert.Equal(t, int64(1), record.Maker.Id)
+		assert.Equal(t, "120", record.Price)
+		assert.Equal(t, int64(3), resp[2].GetTrigger().GetId())
+	}
+	assertAsksDepth(t, me, 0)
+}
+
+// 修改订单的结果
+func amendResp(n int64, amend *matchMq.AmendResp) *matchMq.MatchResp {
+	return &matchMq.MatchResp{
+		Resp:      &matchMq.MatchResp_Amend{Amend: amend},
+		MessageId: messageId(n),
+		Seq:       resultSeq(n),
+	}
+}
+
+// 创建修改订单
+func createAmendOrder(id int64, side enum.Side, price, newPrice, newQty, frozenQty string, queueId int64) *engine.AmendOrder {
+	return &engine.AmendOrder{
+		SequenceId: id,
+		Side:       side,
+		Price:      utils.RequireFixedFromString(price),
+		NewPrice:   utils.RequireFixedFromString(newPrice),
+		NewQty:     utils.RequireFixedFromString(newQty),
+		FrozenQty:  utils.RequireFixedFromString(frozenQty),
+		QueueId:    queueId,
+	}
+}
+
+// 测试只减少数量，保持排队的优先级
+func TestMatchAmendReduceQty(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Sell))
+	// 订单1减少数量，解冻0.5基础币
+	me.HandleAmend(createAmendOrder(1, enum.Side_Sell, "100", "100", "0.5", "0", 10))
+	// 买单先和订单1成交
+	me.HandleOrder(createLimitOrder(3, "100", "0.5", enum.Side_Buy))
+
+	assertAsksDepth(t, me, 1)
+	assert.Equal(t, "1", me.GetDepth(5).Asks[0].Qty)
+	resp := results.Results()
+	if !assert.Len(t, resp, 2) {
+		return
+	}
+	assertMatchResp(t, []*matchMq.MatchResp{
+		amendResp(1, &matchMq.AmendResp{
+			Id:             1,
+			CoinId:         1,
+			UnFrozenQty:    "0.5",
+			Price:          "100",
+			Qty:            "0.5",
+			Amount:         "50",
+			UnFilledQty:    "0.5",
+			UnFilledAmount: "50",
+			OrderStatus:    enum.OrderStatus_NewCreated,
+		}),
+	}, resp[:1])
+	maker := resp[1].GetMatchResult().MatchedRecord[0].Maker
+	assert.Equal(t, int64(1), maker.Id)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, maker.OrderStatus)
+}
+
+// 测试增加数量和修改价格，重新排队，修改价格之后可能立即成交
+func TestMatchAmendRequeue(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Sell))
+	// 订单1增加数量，额外冻结了1基础币，排到订单2后面
+	me.HandleAmend(createAmendOrder(1, enum.Side_Sell, "100", "100", "2", "1", 10))
+	// 买单先和订单2成交
+	me.HandleOrder(createLimitOrder(3, "100", "1", enum.Side_Buy))
+	// 买单修改价格之后和订单1成交，额外冻结了11计价币
+	me.HandleOrder(createLimitOrder(4, "90", "1", enum.Side_Buy))
+	me.HandleAmend(createAmendOrder(4, enum.Side_Buy, "90", "101", "1", "11", 11))
+
+	assertAsksDepth(t, me, 1)
+	assert.Equal(t, "1", me.GetDepth(5).Asks[0].Qty)
+	assert.Empty(t, me.GetDepth(5).Bids)
+	resp := results.Results()
+	if !assert.Len(t, resp, 4) {
+		return
+	}
+	assertMatchResp(t, []*matchMq.MatchResp{
+		amendResp(1, &matchMq.AmendResp{
+			Id:             1,
+			CoinId:         1,
+			UnFrozenQty:    "0",
+			Price:          "100",
+			Qty:            "2",
+			Amount:         "200",
+			UnFilledQty:    "2",
+			UnFilledAmount: "200",
+			OrderStatus:    enum.OrderStatus_NewCreated,
+			QueueId:        10,
+		}),
+	}, resp[:1])
+	assert.Equal(t, int64(2), resp[1].GetMatchResult().MatchedRecord[0].Maker.Id)
+	assertMatchResp(t, []*matchMq.MatchResp{
+		amendResp(3, &matchMq.AmendResp{
+			Id:             4,
+			CoinId:         2,
+			UnFrozenQty:    "0",
+			Price:          "101",
+			Qty:            "1",
+			Amount:         "101",
+			UnFilledQty:    "1",
+			UnFilledAmount: "101",
+			OrderStatus:    enum.OrderStatus_NewCreated,
+			QueueId:        11,
+		}),
+	}, resp[2:3])
+	record := resp[3].GetMatchResult().MatchedRecord[0]
+	assert.Equal(t, int64(1), record.Maker.Id)
+	assert.Equal(t, int64(4), record.Taker.Id)
+	assert.Equal(t, "100", record.Price)
+	// taker按照修改之后的价格解冻
+	assert.Equal(t, "101", record.Taker.UnFrozenAmount)
+}
+
+// 测试重启之后按照订单服务保存的排队序号恢复修改过的订单的位置
+func TestMatchAmendReload(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Sell))
+	me.HandleAmend(createAmendOrder(1, enum.Side_Sell, "100", "100", "2", "1", 10))
+	resp := results.Results()
+	if !assert.Len(t, resp, 1) {
+		return
+	}
+	queueId := resp[0].GetAmend().QueueId
+	assert.Equal(t, int64(10), queueId)
+
+	// 订单服务按照订单id的顺序加载未成交的订单
+	reloaded, results := createTestMatchEngine()
+	order1 := createLimitOrder(1, "100", "2", enum.Side_Sell)
+	order1.QueueId = queueId
+	reloaded.ReloadOrder(order1)
+	reloaded.ReloadOrder(createLimitOrder(2, "100", "1", enum.Side_Sell))
+	reloaded.HandleOrder(createLimitOrder(3, "100", "1", enum.Side_Buy))
+	resp = results.Results()
+	if assert.Len(t, resp, 1) {
+		assert.Equal(t, int64(2), resp[0].GetMatchResult().MatchedRecord[0].Maker.Id)
+	}
+}
+
+// 测试修改失败，订单不变，解冻额外冻结的数量
+func TestMatchAmendRejected(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "2", enum.Side_Buy))
+	// 订单不存在
+	me.HandleAmend(createAmendOrder(2, enum.Side_Buy, "100", "101", "1", "1", 10))
+	// 修改之后的数量小于已成交的数量
+	me.HandleOrder(createLimitOrder(3, "100", "1", enum.Side_Sell))
+	me.HandleAmend(createAmendOrder(1, enum.Side_Buy, "100", "100", "1", "0", 11))
+
+	resp := results.Results()
+	if !assert.Len(t, resp, 3) {
+		return
+	}
+	assertMatchResp(t, []*matchMq.MatchResp{
+		amendResp(1, &matchMq.AmendResp{Id: 2, CoinId: 2, UnFrozenQty: "1", Rejected: true}),
+	}, resp[:1])
+	assertMatchResp(t, []*matchMq.MatchResp{
+		amendResp(3, &matchMq.AmendResp{Id: 1, CoinId: 2, UnFrozenQty: "0", Rejected: true}),
+	}, resp[2:])
+	assert.Eventually(t, func() bool {
+		bids := me.GetDepth(5).Bids
+		return len(bids) == 1 && bids[0].Qty == "1"
+	}, time.Second, 10*time.Millisecond)
+}
+
+// 创建冰山单
+func createIcebergOrder(id int64, price string, qty string, displayQty string, side enum.Side) *engine.Order {
+	o := createLimitOrder(id, price, qty, side)
+	o.DisplayQty = utils.RequireFixedFromString(displayQty)
+	return o
+}
+
+// 创建冰山单测试的MatchEngine，和雪花算法一样生成的id比订单id大
+func createIcebergMatchEngine() (*engine.MatchEngine, *engine.MemoryResultSink) {
+	id := int64(100)
+	return createTestMatchEngineWithSymbol(createTestSymbolInfo(), engine.WithIdGenerator(func() int64 {
+		id++
+		return id
+	}))
+}
+
+// 测试冰山单深度只显示一部分，显示的部分成交完之后补充并重新排队
+func TestMatchIcebergOrder(t *testing.T) {
+	me, results := createIcebergMatchEngine()
+	me.HandleOrder(createIcebergOrder(1, "100", "10", "3", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	assert.Eventually(t, func() bool {
+		asks := me.GetDepth(5).Asks
+		return len(asks) == 1 && asks[0].Qty == "5"
+	}, time.Second, 10*time.Millisecond)
+
+	// 和冰山单显示的3成交之后，冰山单补充3排到订单2后面，剩余的1和订单2成交
+	me.HandleOrder(createLimitOrder(3, "100", "4", enum.Side_Buy))
+	resp := results.Results()
+	if !assert.Len(t, resp, 1) {
+		return
+	}
+	records := resp[0].GetMatchResult().MatchedRecord
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, int64(1), records[0].Maker.Id)
+	assert.Equal(t, "3", records[0].Qty)
+	assert.Equal(t, "3", records[0].Maker.FilledQty)
+	assert.Equal(t, "7", records[0].Maker.UnFilledQty)
+	assert.Equal(t, "700", records[0].Maker.UnFilledAmount)
+	assert.Equal(t, enum.OrderStatus_PartFilled, records[0].Maker.OrderStatus)
+	assert.Equal(t, int64(2), records[1].Maker.Id)
+	assert.Equal(t, "1", records[1].Qty)
+	assert.Equal(t, enum.OrderStatus_ALLFilled, records[1].Taker.OrderStatus)
+	assert.Eventually(t, func() bool {
+		asks := me.GetDepth(5).Asks
+		return len(asks) == 1 && asks[0].Qty == "4"
+	}, time.Second, 10*time.Millisecond)
+
+	// 订单2排在冰山单前面
+	me.HandleOrder(createLimitOrder(4, "100", "2", enum.Side_Buy))
+	resp = results.Results()
+	if !assert.Len(t, resp, 2) {
+		return
+	}
+	records = resp[1].GetMatchResult().MatchedRecord
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, int64(2), records[0].Maker.Id)
+	assert.Equal(t, int64(1), records[1].Maker.Id)
+	assert.Equal(t, "6", records[1].Maker.UnFilledQty)
+	assert.Eventually(t, func() bool {
+		asks := me.GetDepth(5).Asks
+		return len(asks) == 1 && asks[0].Qty == "2"
+	}, time.Second, 10*time.Millisecond)
+}
+
+// 测试冰山单撤单解冻包括隐藏部分的全部数量，快照恢复之后隐藏的部分不变
+// 测试冰山单补充之后的排队序号通过撮合结果保存，重启之后恢复冰山单的位置
+func TestMatchIcebergReload(t *testing.T) {
+	me, results := createIcebergMatchEngine()
+	me.HandleOrder(createIcebergOrder(1, "100", "10", "3", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "100", "3", enum.Side_Buy))
+	resp := results.Results()
+	if !assert.Len(t, resp, 1) {
+		return
+	}
+	maker := resp[0].GetMatchResult().MatchedRecord[0].Maker
+	assert.Equal(t, int64(1), maker.Id)
+	// 成交记录id是101，补充之后的排队序号是102
+	assert.Equal(t, int64(102), maker.QueueId)
+
+	// 快照恢复之后冰山单排在订单2后面
+	restored, restoredResults := createIcebergMatchEngine()
+	restored.RestoreSnapshot(me.TakeSnapshot(nil))
+	restored.HandleOrder(createLimitOrder(4, "100", "2", enum.Side_Buy))
+	if resp := restoredResults.Results(); assert.Len(t, resp, 1) {
+		assert.Equal(t, int64(2), resp[0].GetMatchResult().MatchedRecord[0].Maker.Id)
+	}
+
+	// 订单服务按照订单id的顺序加载未成交的订单，冰山单排在订单2后面
+	reloaded, results := createIcebergMatchEngine()
+	iceberg := createIcebergOrder(1, "100", "7", "3", enum.Side_Sell)
+	iceberg.QueueId = maker.QueueId
+	reloaded.ReloadOrder(iceberg)
+	reloaded.ReloadOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	reloaded.HandleOrder(createLimitOrder(4, "100", "2", enum.Side_Buy))
+	resp = results.Results()
+	if assert.Len(t, resp, 1) {
+		assert.Equal(t, int64(2), resp[0].GetMatchResult().MatchedRecord[0].Maker.Id)
+	}
+}
+
+func TestMatchIcebergCancel(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createIcebergOrder(1, "100", "10", "3", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Buy))
+
+	restored, restoredResults := createTestMatchEngine()
+	restored.RestoreSnapshot(me.TakeSnapshot(nil))
+	assert.Eventually(t, func() bool {
+		asks := restored.GetDepth(5).Asks
+		return len(asks) == 1 && asks[0].Qty == "2"
+	}, time.Second, 10*time.Millisecond)
+
+	cancel := &engine.Order{SequenceId: 1, IsCancel: true, Side: enum.Side_Sell, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("100")}
+	restored.HandleOrder(cancel)
+	assert.Len(t, results.Results(), 1)
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(2, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "9"}),
+	}, restoredResults.Results())
+	assertAsksDepth(t, restored, 0)
+}
+
+// 测试撤单只按照订单id查找，相同价格的其他订单排队的顺序不变
+func TestMatchCancelById(t *testing.T) {
+	me, results := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "100", "3", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(4, "101", "4", enum.Side_Sell))
+
+	// 撤单消息中的价格和订单簿中的价格不一致，按照订单簿中的价格更新深度
+	cancel := &engine.Order{SequenceId: 2, IsCancel: true, Side: enum.Side_Sell, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("99")}
+	me.HandleOrder(cancel)
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 2, CoinId: 1, Qty: "2"}),
+	}, results.Results())
+	assert.Eventually(t, func() bool {
+		asks := me.GetDepth(5).Asks
+		return len(asks) == 2 && asks[1].Price == "100" && asks[1].Qty == "4"
+	}, time.Second, 10*time.Millisecond)
+
+	// 已经撤销的订单再次撤销忽略
+	me.HandleOrder(cancel)
+	assert.Len(t, results.Results(), 1)
+
+	me.HandleOrder(createLimitOrder(5, "100", "4", enum.Side_Buy))
+	resp := results.Results()
+	if !assert.Len(t, resp, 2) {
+		return
+	}
+	records := resp[1].GetMatchResult().MatchedRecord
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, int64(1), records[0].Maker.Id)
+	assert.Equal(t, int64(3), records[1].Maker.Id)
+	assertAsksDepth(t, me, 1)
+}
+
+// 集合竞价推送的行情数据
+func auctionData(marketData *engine.MemoryMarketDataSink) []string {
+	data := make([]string, 0, 4)
+	for _, v := range marketData.Data() {
+		if v.Topic == "auction@BTC_USDT" {
+			data = append(data, string(v.Data))
+		}
+	}
+	return data
+}
+
+// 测试开盘之前集合竞价只接受限价单不撮合，开盘时按照成交量最大的价格统一撮合
+func TestMatchCallAuction(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.OpenTimeValue = testTime.Unix() + 60
+	symbolInfo.StoreAuction()
+	now := testTime
+	results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: symbolInfo}, results, marketData,
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return now
+		}),
+	)
+
+	// 价格交叉的订单不撮合，市价单直接撤销
+	me.HandleOrder(createLimitOrder(1, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "101", "3", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "102", "4", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(4, "100", "1", enum.Side_Buy))
+	me.HandleOrder(createMarketOrder(5, "0", "1", enum.Side_Sell))
+	assertAsksDepth(t, me, 2)
+	resp := results.Results()
+	if assert.Len(t, resp, 1) {
+		assert.Equal(t, int64(5), resp[0].GetCancel().GetId())
+	}
+	// 101和102的成交量都是4，未成交的数量都是1，没有参考价格选择较低的价格
+	auctions := auctionData(marketData)
+	if assert.Len(t, auctions, 5) {
+		assert.Contains(t, auctions[4], `"a":true`)
+		assert.Contains(t, auctions[4], `"p":"101"`)
+		assert.Contains(t, auctions[4], `"q":"4"`)
+	}
+	assert.Equal(t, 60*time.Second, me.CheckAuction())
+
+	// 到了开盘时间，买单3作为taker和卖单1、2按照101成交，多冻结的金额解冻
+	now = now.Add(61 * time.Second)
+	assert.Equal(t, time.Duration(0), me.CheckAuction())
+	resp = results.Results()
+	if !assert.Len(t, resp, 2) {
+		return
+	}
+	result := resp[1].GetMatchResult()
+	assert.Equal(t, "4", result.Qty)
+	assert.Equal(t, "404", result.Amount)
+	assert.Equal(t, "101", result.BeginPrice)
+	assert.Equal(t, "101", result.EndPrice)
+	if assert.Len(t, result.MatchedRecord, 2) {
+		assert.Equal(t, int64(1), result.MatchedRecord[0].Maker.Id)
+		assert.Equal(t, enum.OrderStatus_ALLFilled, result.MatchedRecord[0].Maker.OrderStatus)
+		assert.Equal(t, int64(2), result.MatchedRecord[1].Maker.Id)
+		assert.Equal(t, "1", result.MatchedRecord[1].Maker.UnFilledQty)
+		assert.Equal(t, "101", result.MatchedRecord[1].Maker.UnFilledAmount)
+		assert.Equal(t, enum.OrderStatus_ALLFilled, result.MatchedRecord[1].Taker.OrderStatus)
+		assert.Equal(t, "404", result.MatchedRecord[1].Taker.FilledAmount)
+		assert.Equal(t, "408", result.MatchedRecord[1].Taker.UnFrozenAmount)
+	}
+	auctions = auctionData(marketData)
+	if assert.Len(t, auctions, 6) {
+		assert.Contains(t, auctions[5], `"a":false`)
+		assert.Contains(t, auctions[5], `"p":"101"`)
+	}
+	assertAsksDepth(t, me, 1)
+
+	// 开盘之后连续撮合
+	me.HandleOrder(createLimitOrder(6, "101", "1", enum.Side_Buy))
+	resp = results.Results()
+	if assert.Len(t, resp, 3) {
+		assert.Equal(t, int64(2), resp[2].GetMatchResult().MatchedRecord[0].Maker.Id)
+	}
+	assertAsksDepth(t, me, 0)
+}
+
+// 测试熔断期间集合竞价，恢复交易时统一撮合
+func TestMatchHaltAuction(t *testing.T) {
+	symbolInfo := createTestSymbolInfo()
+	symbolInfo.CircuitBreakerValue = "0.1"
+	symbolInfo.CircuitBreakerWindowValue = 60
+	symbolInfo.HaltDurationValue = 300
+	symbolInfo.HaltAuctionValue = true
+	symbolInfo.StorePriceProtection()
+	symbolInfo.StoreAuction()
+	now := testTime
+	results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: symbolInfo}, results, marketData,
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return now
+		}),
+	)
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "1", enum.Side_Buy))
+	now = now.Add(10 * time.Second)
+	me.HandleOrder(createLimitOrder(3, "115", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(4, "115", "1", enum.Side_Buy))
+	results.Reset()
+
+	// 熔断期间订单进入订单簿不撮合
+	me.HandleOrder(createLimitOrder(5, "120", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(6, "118", "1", enum.Side_Sell))
+	assertAsksDepth(t, me, 1)
+	assert.Len(t, results.Results(), 0)
+
+	// 快照恢复之后仍然处于集合竞价
+	restored, restoredResults := createTestMatchEngineWithSymbol(symbolInfo)
+	restored.RestoreSnapshot(me.TakeSnapshot(nil))
+	restored.HandleOrder(createLimitOrder(7, "118", "1", enum.Side_Buy))
+	assert.Len(t, restoredResults.Results(), 0)
+
+	// 恢复交易时按照最接近最新成交价115的118成交
+	now = now.Add(301 * time.Second)
+	me.HandleOrder(createLimitOrder(8, "130", "1", enum.Side_Sell))
+	resp := results.Results()
+	if !assert.Len(t, resp, 1) {
+		return
+	}
+	records := resp[0].GetMatchResult().MatchedRecord
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "118", records[0].Price)
+		assert.Equal(t, int64(5), records[0].Taker.Id)
+		assert.Equal(t, "120", records[0].Taker.UnFrozenAmount)
+		assert.Equal(t, int64(6), records[0].Maker.Id)
+	}
+	assertAsksDepth(t, me, 1)
+
+	halts := make([]string, 0, 2)
+	for _, v := range marketData.Data() {
+		if v.Topic == "halt@BTC_USDT" {
+			halts = append(halts, string(v.Data))
+		}
+	}
+	if assert.Len(t, halts, 2) {
+		assert.Contains(t, halts[1], `"h":false`)
+	}
+	auctions := auctionData(marketData)
+	if assert.NotEmpty(t, auctions) {
+		assert.Contains(t, auctions[len(auctions)-1], `"a":false`)
+		assert.Contains(t, auctions[len(auctions)-1], `"p":"118"`)
+	}
+}
+
+// 测试批量撤单只撤销指定用户和方向的订单，每个订单一个撤单结果
+func TestMatchCancelAll(t *testing.T) {
+	me, results := createTestMatchEngine()
+	orders := []*engine.Order{
+		createLimitOrder(1, "99", "1", enum.Side_Buy),
+		createLimitOrder(2, "100", "2", enum.Side_Buy),
+		createLimitOrder(3, "98", "1", enum.Side_Buy),
+		createLimitOrder(4, "110", "3", enum.Side_Sell),
+		createLimitOrder(5, "120", "1", enum.Side_Buy),
+	}
+	orders[2].Uid = 2
+	// 未触发的条件单
+	orders[4].TriggerPrice = utils.RequireFixedFromString("115")
+	orders[4].TriggerStatus = enum.TriggerStatus_Untriggered
+	for _, v := range orders {
+		if v.Uid == 0 {
+			v.Uid = 1
+		}
+		me.HandleOrder(v)
+	}
+
+	me.HandleCancelAll(&engine.CancelAll{Uid: 1, Side: enum.Side_Sell})
+	assertAsksDepth(t, me, 0)
+	me.HandleCancelAll(&engine.CancelAll{Uid: 1})
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 4, CoinId: 1, Qty: "3", Uid: 1}),
+		cancelResp(2, &matchMq.CancelResp{Id: 2, CoinId: 2, Qty: "200", Uid: 1}),
+		cancelResp(3, &matchMq.CancelResp{Id: 1, CoinId: 2, Qty: "99", Uid: 1}),
+		cancelResp(4, &matchMq.CancelResp{Id: 5, CoinId: 2, Qty: "120", Uid: 1}),
+	}, results.Results())
+	assert.Eventually(t, func() bool {
+		bids := me.GetDepth(5).Bids
+		return len(bids) == 1 && bids[0].Price == "98"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestMatchVersion(t *testing.T) {
+	me, _ := createTestMatchEngine()
+	me.HandleOrder(createLimitOrder(1, "100", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "101", "2", enum.Side_Sell))
+	stopOrder := createLimitOrder(3, "101", "1", enum.Side_Buy)
+	stopOrder.TriggerPrice = utils.RequireFixedFromString("100")
+	stopOrder.TriggerStatus = enum.TriggerStatus_Untriggered
+	me.HandleOrder(stopOrder)
+	assert.Equal(t, int64(3), me.GetBook().Version)
+
+	// 触发的条件单在同一个消息中撮合，版本号不回退
+	me.HandleOrder(createLimitOrder(4, "100", "1", enum.Side_Buy))
+	assert.Equal(t, enum.OrderStatus_ALLFilled, stopOrder.OrderStatus)
+	assert.Equal(t, int64(4), me.GetBook().Version)
+
+	// 撤单和批量撤单版本号加一，下一个订单的id和版本号相同时也不会重复
+	me.HandleOrder(&engine.Order{SequenceId: 2, IsCancel: true, Side: enum.Side_Sell, OrderType: enum.OrderType_LO})
+	assert.Equal(t, int64(5), me.GetBook().Version)
+	me.HandleCancelAll(&engine.CancelAll{Uid: 1})
+	assert.Equal(t, int64(6), me.GetBook().Version)
+	me.HandleOrder(createLimitOrder(6, "99", "1", enum.Side_Buy))
+	assert.Equal(t, int64(7), me.GetBook().Version)
+	me.HandleOrder(createLimitOrder(10, "98", "1", enum.Side_Buy))
+	assert.Equal(t, int64(10), me.GetBook().Version)
+}
+
+func TestMatchExpiry(t *testing.T) {
+	now := testTime
+	results := engine.NewMemoryResultSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo()}, results, engine.NewMemoryMarketDataSink(),
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return now
+		}),
+	)
+	orders := []*engine.Order{
+		createLimitOrder(1, "110", "1", enum.Side_Sell),
+		createLimitOrder(2, "111", "1", enum.Side_Sell),
+		createLimitOrder(3, "99", "2", enum.Side_Buy),
+	}
+	orders[0].ExpireTime = now.Add(10 * time.Second).Unix()
+	orders[2].ExpireTime = now.Add(5 * time.Second).Unix()
+	for _, v := range orders {
+		me.HandleOrder(v)
+	}
+	assert.Equal(t, now.Add(5*time.Second).Unix(), me.NextTimer().Unix())
+
+	// 保存快照，恢复之后的订单簿也要按时过期
+	snapshot := me.TakeSnapshot([]byte("message_id"))
+
+	// 到期之后收到定时消息撤销，撤单消息标记为过期
+	now = now.Add(5 * time.Second)
+	me.HandleTimer()
+	assert.Equal(t, now.Add(5*time.Second).Unix(), me.NextTimer().Unix())
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "198", Expired: true}),
+	}, results.Results())
+
+	// 处理新订单之前先撤销过期的订单，已经过期的新订单直接撤销
+	now = now.Add(5 * time.Second)
+	expiredOrder := createLimitOrder(4, "100", "1", enum.Side_Buy)
+	expiredOrder.ExpireTime = now.Unix()
+	me.HandleOrder(expiredOrder)
+	assertMatchResp(t, []*matchMq.MatchResp{
+		cancelResp(1, &matchMq.CancelResp{Id: 3, CoinId: 2, Qty: "198", Expired: true}),
+		cancelResp(2, &matchMq.CancelResp{Id: 1, CoinId: 1, Qty: "1", Expired: true}),
+		cancelResp(3, &matchMq.CancelResp{Id: 4, CoinId: 2, Qty: "100", Expired: true}),
+	}, results.Results())
+	assert.True(t, me.NextTimer().IsZero())
+	assert.Eventually(t, func() bool {
+		depth := me.GetDepth(5)
+		return len(depth.Asks) == 1 && depth.Asks[0].Price == "111" && len(depth.Bids) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	// 恢复之后重新建立过期时间的索引
+	restored, restoredResults := createTestMatchEngine()
+	restored.RestoreSnapshot(snapshot)
+	assert.Equal(t, testTime.Add(5*time.Second).Unix(), restored.NextTimer().Unix())
+	restored.HandleTimer()
+	assert.Equal(t, 0, len(restoredResults.Results()))
+}
+
+func l3Data(marketData *engine.MemoryMarketDataSink) []string {
+	data := make([]string, 0, 8)
+	for _, v := range marketData.Data() {
+		if v.Topic == "l3@BTC_USDT" {
+			data = append(data, string(v.Data))
+		}
+	}
+	return data
+}
+
+// 测试逐笔委托的新增、修改、删除事件和快照
+func TestMatchL3(t *testing.T) {
+	results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo()}, results, marketData,
+		testIdGenerator(),
+		engine.WithClock(func() time.Time {
+			return testTime
+		}),
+	)
+	me.HandleOrder(createLimitOrder(1, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "100", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "101", "1", enum.Side_Sell))
+	// 吃掉订单1和订单2的一部分
+	me.HandleOrder(createLimitOrder(4, "100", "3", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(5, "99", "1", enum.Side_Buy))
+	me.HandleOrder(&engine.Order{SequenceId: 3, IsCancel: true, Side: enum.Side_Sell, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("101")})
+	assert.Equal(t, []string{
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":1,"v":1,"e":1,"i":1,"si":2,"p":"100","q":"2","qi":1,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":2,"v":2,"e":1,"i":2,"si":2,"p":"100","q":"2","qi":2,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":3,"v":3,"e":1,"i":3,"si":2,"p":"101","q":"1","qi":3,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":4,"v":4,"e":2,"i":2,"si":2,"p":"100","q":"1","qi":2,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":5,"v":4,"e":3,"i":1,"si":2,"p":"100","q":"0","qi":1,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":6,"v":5,"e":1,"i":5,"si":1,"p":"99","q":"1","qi":5,"ts":1700000000}}`,
+		`{"t":"l3@BTC_USDT","p":{"s":"BTC_USDT","sq":7,"v":6,"e":3,"i":3,"si":2,"p":"101","q":"0","qi":3,"ts":1700000000}}`,
+	}, l3Data(marketData))
+
+	// 快照的序号和最后一个事件一致
+	snapshot := me.GetL3Snapshot()
+	assert.Equal(t, int64(7), snapshot.Seq)
+	assert.Equal(t, int64(6), snapshot.Version)
+	if assert.Equal(t, 1, len(snapshot.Asks)) {
+		assert.Equal(t, int64(2), snapshot.Asks[0].Id)
+		assert.Equal(t, "1", snapshot.Asks[0].Qty.String())
+	}
+	if assert.Equal(t, 1, len(snapshot.Bids)) {
+		assert.Equal(t, int64(5), snapshot.Bids[0].Id)
+	}
+
+	// 从快照恢复之后逐笔委托的序号继续递增
+	restored, _ := createTestMatchEngine()
+	restored.RestoreSnapshot(me.TakeSnapshot([]byte("message_id")))
+	restoredSnapshot := restored.GetL3Snapshot()
+	assert.Equal(t, snapshot.Seq, restoredSnapshot.Seq)
+	assert.Equal(t, len(snapshot.Asks), len(restoredSnapshot.Asks))
+	assert.Equal(t, len(snapshot.Bids), len(restoredSnapshot.Bids))
+	restored.HandleOrder(createLimitOrder(6, "98", "1", enum.Side_Buy))
+	restoredSnapshot = restored.GetL3Snapshot()
+	assert.Equal(t, int64(8), restoredSnapshot.Seq)
+	assert.Equal(t, []int64{5, 6}, []int64{restoredSnapshot.Bids[0].Id, restoredSnapshot.Bids[1].Id})
+}
+
+// 测试按照档位合并深度，卖盘向上取整，买盘向下取整
+func TestMatchDepthGroup(t *testing.T) {
+	marketData := engine.NewMemoryMarketDataSink()
+	c := &config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo(), DepthGroups: []string{"10"}}
+	me := engine.NewMatchEngine(c, engine.NewMemoryResultSink(), marketData)
+	me.HandleOrder(createLimitOrder(1, "101", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "105", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "111", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(4, "99", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(5, "95", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(6, "89", "1", enum.Side_Buy))
+	// 撤销之后合并的档位减少数量
+	me.HandleOrder(&engine.Order{SequenceId: 5, IsCancel: true, Side: enum.Side_Buy, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("95")})
+
+	levels := func(positions []*engine.Position) []string {
+		result := make([]string, 0, len(positions))
+		for _, v := range positions {
+			result = append(result, v.Price+":"+v.Qty)
+		}
+		return result
+	}
+	assert.Eventually(t, func() bool {
+		depth, ok := me.GetGroupDepth(5, "10")
+		return ok && assert.ObjectsAreEqual([]string{"120:1", "110:3"}, levels(depth.Asks)) &&
+			assert.ObjectsAreEqual([]string{"90:1", "80:1"}, levels(depth.Bids))
+	}, time.Second, 10*time.Millisecond)
+	_, ok := me.GetGroupDepth(5, "1")
+	assert.False(t, ok)
+
+	// 合并深度推送到单独的topic
+	assert.Eventually(t, func() bool {
+		for _, v := range marketData.Data() {
+			if v.Topic == "depth@BTC_USDT@10" {
+				return true
+			}
+		}
+		return false
+	}, 3*time.Second, 100*time.Millisecond)
+}
+
+// 测试深度推送的校验和、查询深度返回的版本号，以及参考客户端根据推送维护本地深度
+func TestMatchDepthChecksum(t *testing.T) {
+	marketData := engine.NewMemoryMarketDataSink()
+	me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo()}, engine.NewMemoryResultSink(), marketData,
+		testIdGenerator())
+	depthMessages := func() [][]byte {
+		data := make([][]byte, 0, 4)
+		for _, v := range marketData.Data() {
+			if v.Topic == "depth@BTC_USDT" {
+				data = append(data, v.Data)
+			}
+		}
+		return data
+	}
+	waitDepth := func(n int) [][]byte {
+		assert.Eventually(t, func() bool {
+			return len(depthMessages()) == n
+		}, 3*time.Second, 50*time.Millisecond)
+		return depthMessages()
+	}
+	book := socket.NewDepthBook("BTC_USDT", "", func() (*socket.DepthSnapshot, error) {
+		depth := me.GetDepth(commonWs.DepthChecksumLevel)
+		snapshot := &socket.DepthSnapshot{Version: depth.CurrentVersion, Checksum: depth.Checksum}
+		for _, v := range depth.Asks {
+			snapshot.Asks = append(snapshot.Asks, []string{v.Price, v.Qty, v.Amount})
+		}
+		for _, v := range depth.Bids {
+			snapshot.Bids = append(snapshot.Bids, []string{v.Price, v.Qty, v.Amount})
+		}
+		return snapshot, nil
+	})
+	levels := func(positions [][]string) []string {
+		result := make([]string, 0, len(positions))
+		for _, v := range positions {
+			result = append(result, v[0]+":"+v[1])
+		}
+		return result
+	}
+
+	me.HandleOrder(createLimitOrder(1, "101", "1", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(2, "102", "2", enum.Side_Sell))
+	me.HandleOrder(createLimitOrder(3, "99", "1", enum.Side_Buy))
+	me.HandleOrder(createLimitOrder(4, "98", "3", enum.Side_Buy))
+	messages := waitDepth(1)
+	var msg commonWs.Message[commonWs.Depth]
+	assert.NoError(t, json.Unmarshal(messages[0], &msg))
+	// 买盘从高到低，卖盘从低到高交替拼接
+	assert.Equal(t, commonWs.DepthChecksum([][]string{{"99", "1"}, {"98", "3"}}, [][]string{{"101", "1"}, {"102", "2"}}), msg.Payload.Checksum)
+	// 查询深度的版本号和校验和与最后一次推送一致，档位数量受限时卖盘返回价格最低的档位
+	depth := me.GetDepth(1)
+	assert.Equal(t, msg.Payload.CurrentVersion, fmt.Sprint(depth.CurrentVersion))
+	assert.Equal(t, msg.Payload.Checksum, depth.Checksum)
+	assert.Equal(t, "101", depth.Asks[0].Price)
+	assert.NoError(t, book.Apply(messages[0]))
+	assert.True(t, book.Synced())
+
+	// 成交和撤单之后应用增量推送，撤单的版本号小于当前版本，深度的版本号仍然递增
+	me.HandleOrder(createLimitOrder(5, "101", "1", enum.Side_Buy))
+	me.HandleOrder(&engine.Order{SequenceId: 3, IsCancel: true, Side: enum.Side_Buy, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("99")})
+	messages = waitDepth(2)
+	assert.NoError(t, json.Unmarshal(messages[1], &msg))
+	assert.Equal(t, fmt.Sprint(depth.CurrentVersion), msg.Payload.LastVersion)
+	assert.Greater(t, cast.ToInt64(msg.Payload.CurrentVersion), depth.CurrentVersion)
+	assert.NoError(t, book.Apply(messages[1]))
+	assert.True(t, book.Synced())
+	assert.Equal(t, cast.ToInt64(msg.Payload.CurrentVersion), book.Version())
+
+	// 丢失一条推送之后重新获取快照
+	me.HandleOrder(createLimitOrder(6, "100", "1", enum.Side_Buy))
+	waitDepth(3)
+	me.HandleOrder(createLimitOrder(7, "103", "1", enum.Side_Sell))
+	messages = waitDepth(4)
+	assert.NoError(t, book.Apply(messages[3]))
+	assert.True(t, book.Synced())
+	bids, asks := book.Depth(5)
+	assert.Equal(t, []string{"100:1", "98:3"}, levels(bids))
+	assert.Equal(t, []string{"102:2", "103:1"}, levels(asks))
+}
+
+// 测试主备切换，备节点跳过主节点已经发送的撮合结果，序号从主节点最后发送的序号继续
+func TestMatchStandby(t *testing.T) {
+	newEngine := func() (*engine.MatchEngine, *engine.StandbySink, *engine.MemoryResultSink, *engine.MemoryMarketDataSink) {
+		results, marketData := engine.NewMemoryResultSink(), engine.NewMemoryMarketDataSink()
+		sink := engine.NewStandbySink("BTC_USDT", results, marketData)
+		me := engine.NewMatchEngine(&config.Config{Symbol: "BTC_USDT", SymbolInfo: createTestSymbolInfo()}, sink, sink,
+			testIdGenerator(),
+			engine.WithClock(func() time.Time {
+				return testTime
+			}),
+		)
+		return me, sink, results, marketData
+	}
+	// match_source中的消息，每个节点创建新的订单
+	messages := []func() *engine.Order{
+		func() *engine.Order { return createLimitOrder(1, "100", "1", enum.Side_Sell) },
+		func() *engine.Order { return createLimitOrder(2, "101", "1", enum.Side_Sell) },
+		// 成交两个卖单之后撤销剩余的部分，产生两个撮合结果
+		func() *engine.Order {
+			order := createLimitOrder(3, "101", "3", enum.Side_Buy)
+			order.OrderType = enum.OrderType_IOC
+			return order
+		},
+		func() *engine.Order { return createLimitOrder(4, "102", "1", enum.Side_Sell) },
+		func() *engine.Order {
+			return &engine.Order{SequenceId: 4, IsCancel: true, Side: enum.Side_Sell, OrderType: enum.OrderType_LO, Price: utils.RequireFixedFromString("102")}
+		},
+	}
+	handle := func(me *engine.MatchEngine, sink *engine.StandbySink, i int) {
+		sink.Begin(pulsar.NewMessageID(1, int64(i), 0, 0))
+		me.HandleOrder(messages[i]())
+	}
+
+	leader, leaderSink, leaderResults, _ := newEngine()
+	assert.NoError(t, leaderSink.Promote())
+	follower, followerSink, followerResults, followerMarketData := newEngine()
+	for i := 0; i < 3; i++ {
+		handle(leader, leaderSink, i)
+	}
+	for i := 0; i < 2; i++ {
+		handle(follower, followerSink, i)
+	}
+	sent := leaderResults.Results()
+	assert.Equal(t, 2, len(sent))
+	assert.Equal(t, int32(1), sent[1].SourceIndex)
+	// 备节点不发送撮合结果，不推送行情
+	assert.Empty(t, followerResults.Results())
+	assert.Empty(t, followerMarketData.Data())
+
+	// 主节点只发送了第三条消息的第一个撮合结果
+	sourceId, err := pulsar.DeserializeMessageID(sent[0].SourceId)
+	assert.NoError(t, err)
+	followerSink.Confirm(sent[0].Seq, sourceId, sent[0].SourceIndex)
+	assert.NoError(t, followerSink.Promote())
+	assert.True(t, followerSink.Leader())
+	for i := 2; i < len(messages); i++ {
+		handle(follower, followerSink, i)
+	}
+
+	resumed := followerResults.Results()
+	if assert.Equal(t, 2, len(resumed)) {
+		assert.Equal(t, sent[0].Seq+1, resumed[0].Seq)
+		assert.Equal(t, fmt.Sprintf("BTC_USDT_%v", sent[0].Seq+1), resumed[0].MessageId)
+		assert.True(t, proto.Equal(sent[1].GetCancel(), resumed[0].GetCancel()))
+		assert.Equal(t, sent[0].Seq+2, resumed[1].Seq)
+		assert.Equal(t, int64(4), resumed[1].GetCancel().Id)
+	}
+}
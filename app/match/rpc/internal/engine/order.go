@@ -2,7 +2,8 @@ package engine
 
 import (
 	enum "github.com/luxun9527/gex/common/proto/enum"
-	"github.com/shopspring/decimal"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/luxun9527/gex/common/utils"
 )
 
 // Order 订单
@@ -11,15 +12,132 @@ type Order struct {
 	SequenceId     int64
 	CreateTime     int64
 	IsCancel       bool
-	Uid            int64            //用户id
-	Price          decimal.Decimal  //价格
-	Qty            decimal.Decimal  //数量 市价单位零
-	OrderType      enum.OrderType   //订单类型 市价单 限价单
-	Amount         decimal.Decimal  //金额
-	Side           enum.Side        //方向
-	OrderStatus    enum.OrderStatus //订单状态
-	UnfilledQty    decimal.Decimal  //未成交数量
-	FilledQty      decimal.Decimal  //已成交数量
-	UnfilledAmount decimal.Decimal  //未成交金额
-	FilledAmount   decimal.Decimal  //成交金额
+	Uid            int64              //用户id
+	Price          utils.Fixed        //价格
+	Qty            utils.Fixed        //数量 市价单位零
+	OrderType      enum.OrderType     //订单类型 市价单 限价单
+	Amount         utils.Fixed        //金额
+	Side           enum.Side          //方向
+	OrderStatus    enum.OrderStatus   //订单状态
+	UnfilledQty    utils.Fixed        //未成交数量
+	FilledQty      utils.Fixed        //已成交数量
+	UnfilledAmount utils.Fixed        //未成交金额
+	FilledAmount   utils.Fixed        //成交金额
+	PostOnly       bool               //是否只做maker
+	TriggerPrice   utils.Fixed        //触发价格 条件单才有
+	TriggerStatus  enum.TriggerStatus //条件单触发状态
+	STPMode        enum.STPMode       //自成交保护模式 未指定使用交易对的默认配置
+	MakerFeeRate   utils.Fixed        //maker手续费率
+	TakerFeeRate   utils.Fixed        //taker手续费率
+	Fee            utils.Fixed        //累计的手续费 买单为基础币 卖单为计价币
+	QueueId        int64              //修改订单之后重新排队的序号 为零按照订单id排队
+	DisplayQty     utils.Fixed        //冰山单每次显示的数量 为零不是冰山单
+	VisibleQty     utils.Fixed        //冰山单当前显示的剩余数量
+	ExpireTime     int64              //过期时间 单位秒 为零一直有效
+	MaxSlippage    utils.Fixed        //市价单的最大滑点 为零不限制
+	ProtectPrice   utils.Fixed        //市价单的保护价格 买单为最高价 卖单为最低价 为零不限制
+	ByQty          bool               //市价买单按照数量买入 金额为冻结的最大金额
+	//订单在订单簿中的位置和计入档位的数量，不参与序列化
+	level         *priceLevel
+	prev, next    *Order
+	levelQty      utils.Fixed
+	levelUnfilled utils.Fixed
+}
+
+// isLimitPrice 是否是带有限价的订单,限价单、FOK、IOC都按照下单价格撮合和冻结。
+func (o Order) isLimitPrice() bool {
+	return o.OrderType == enum.OrderType_LO || o.OrderType == enum.OrderType_FOK || o.OrderType == enum.OrderType_IOC
+}
+
+// NewOrderFromOperate 下单消息转换为撮合引擎的订单，数值不是合法的定点数返回错误
+func NewOrderFromOperate(operate *matchMq.NewOrderOperate) (*Order, error) {
+	var p utils.FixedParser
+	order := &Order{
+		Uid:            operate.Uid,
+		OrderID:        operate.OrderId,
+		SequenceId:     operate.SequenceId,
+		CreateTime:     0,
+		IsCancel:       false,
+		Price:          p.Parse(operate.Price),
+		Qty:            p.Parse(operate.Qty),
+		OrderType:      operate.OrderType,
+		Amount:         p.Parse(operate.Amount),
+		Side:           operate.Side,
+		OrderStatus:    enum.OrderStatus_NewCreated,
+		UnfilledQty:    p.Parse(operate.Qty),
+		FilledQty:      utils.Fixed{},
+		UnfilledAmount: p.Parse(operate.Amount),
+		FilledAmount:   utils.Fixed{},
+		PostOnly:       operate.PostOnly,
+		STPMode:        operate.StpMode,
+		//没有手续费率的订单不收手续费，空字符串解析为0
+		MakerFeeRate: p.Parse(operate.MakerFeeRate),
+		TakerFeeRate: p.Parse(operate.TakerFeeRate),
+		Fee:          utils.Fixed{},
+		DisplayQty:   p.Parse(operate.DisplayQty),
+		VisibleQty:   utils.Fixed{},
+		ExpireTime:   operate.ExpireTime,
+		//没有滑点保护的市价单空字符串解析为0
+		MaxSlippage:  p.Parse(operate.MaxSlippage),
+		ProtectPrice: p.Parse(operate.ProtectionPrice),
+		ByQty:        operate.ByQty,
+	}
+	//触发价格不为空则为条件单
+	if operate.TriggerPrice != "" {
+		order.TriggerPrice = p.Parse(operate.TriggerPrice)
+		order.TriggerStatus = enum.TriggerStatus_Untriggered
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return order, nil
+}
+
+// NewCancelOrderFromOperate 撤单消息转换为撮合引擎的订单
+func NewCancelOrderFromOperate(operate *matchMq.CancelOperate) *Order {
+	return &Order{
+		OrderID:    "",
+		SequenceId: operate.Id,
+		CreateTime: 0,
+		IsCancel:   true,
+		Side:       operate.Side,
+		Uid:        0,
+		OrderType:  operate.OrderType,
+	}
+}
+
+// scaleOrder 新订单的价格、数量和金额转换为撮合引擎的指数，之后的计算都是相同指数的整数运算。
+// 精度超过交易对的精度，成交金额或者订单簿汇总的数量可能超出定点数的范围时返回错误。
+func (m *MatchEngine) scaleOrder(order *Order) error {
+	var err error
+	rescale := func(v utils.Fixed, exp int32) utils.Fixed {
+		if err != nil {
+			return v
+		}
+		var r utils.Fixed
+		r, err = v.Rescale(exp)
+		return r
+	}
+	amountExp := m.priceExp + m.qtyExp
+	price, triggerPrice, protectPrice := rescale(order.Price, m.priceExp), rescale(order.TriggerPrice, m.priceExp), rescale(order.ProtectPrice, m.priceExp)
+	qty, unfilledQty, displayQty := rescale(order.Qty, m.qtyExp), rescale(order.UnfilledQty, m.qtyExp), rescale(order.DisplayQty, m.qtyExp)
+	amount, unfilledAmount := rescale(order.Amount, amountExp), rescale(order.UnfilledAmount, amountExp)
+	if err != nil {
+		return err
+	}
+	//卖单可能和价格更高的买单成交，按照买一价校验成交金额
+	notionalPrice := price
+	if order.Side == enum.Side_Sell && m.bestBid.GreaterThan(notionalPrice) {
+		notionalPrice = m.bestBid
+	}
+	if _, err := unfilledQty.MulChecked(notionalPrice); err != nil {
+		return err
+	}
+	if _, err := m.orderBook(order.Side).unfilled.AddChecked(unfilledQty); err != nil {
+		return err
+	}
+	order.Price, order.TriggerPrice, order.ProtectPrice = price, triggerPrice, protectPrice
+	order.Qty, order.UnfilledQty, order.DisplayQty = qty, unfilledQty, displayQty
+	order.Amount, order.UnfilledAmount = amount, unfilledAmount
+	return nil
 }
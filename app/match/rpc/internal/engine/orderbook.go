@@ -2,20 +2,40 @@ package engine
 
 import (
 	"fmt"
+
 	rbt "github.com/emirpasic/gods/trees/redblacktree"
 	enum "github.com/luxun9527/gex/common/proto/enum"
-	"github.com/shopspring/decimal"
+	"github.com/luxun9527/gex/common/utils"
 )
 
+// 订单簿按照价格维护档位，红黑树中每个价格只有一个节点，相同价格的订单按照排队的顺序连接成双向链表。
+// 订单id索引订单在链表中的位置，撤单不需要价格，找到订单之后直接从链表中删除。
+// 档位汇总订单的数量，数量变化之后调用modify更新，不需要遍历档位中的订单。
+
 type Key struct {
-	price decimal.Decimal
+	price utils.Fixed
 	id    int64
 }
 
+// priceLevel 价格档位
+type priceLevel struct {
+	price    utils.Fixed
+	head     *Order //排队最前面的订单
+	tail     *Order
+	count    int
+	qty      utils.Fixed //显示的数量，冰山单只有显示的部分，和深度一致
+	unfilled utils.Fixed //未成交数量，冰山单包括隐藏的部分
+}
+
 // OrderBook 订单簿
 type OrderBook struct {
-	orderBook *rbt.Tree  // 红黑树存储订单
-	side      enum.Side  // 买卖方向
+	levels *rbt.Tree        // 红黑树存储价格档位
+	orders map[int64]*Order // 订单id对应订单
+	side   enum.Side        // 买卖方向
+	expiry *rbt.Tree        // 限时单按照过期时间排序
+	l3     *L3Handler       // 记录订单的变化推送逐笔委托
+	//所有订单的未成交数量之和，不小于档位、深度和集合竞价汇总的数量，加入订单之前校验不会超出定点数的范围
+	unfilled utils.Fixed
 }
 
 type DepthPosition struct {
@@ -23,67 +43,235 @@ type DepthPosition struct {
 	Qty   string `json:"qty"`
 }
 
-func NewOrderBook(side enum.Side) *OrderBook {
+func NewOrderBook(side enum.Side, l3 *L3Handler) *OrderBook {
 	order := &OrderBook{
-		side: side,
+		side:   side,
+		l3:     l3,
+		orders: make(map[int64]*Order),
+		expiry: rbt.NewWith(expiryComparator),
 	}
-	orderBook := rbt.NewWith(order.PriceComparator)
-	order.orderBook = orderBook
+	order.levels = rbt.NewWith(order.PriceComparator)
 	return order
 }
+
+// add 订单加入到价格档位的队尾，修改过的订单按照排队的序号插入
 func (ob *OrderBook) add(order *Order) {
-	k := &Key{
-		price: order.Price,
-		id:    order.SequenceId,
+	var level *priceLevel
+	if value, found := ob.levels.Get(order.Price); found {
+		level = value.(*priceLevel)
+	} else {
+		level = &priceLevel{
+			price:    order.Price,
+			qty:      utils.Fixed{},
+			unfilled: utils.Fixed{},
+		}
+		ob.levels.Put(order.Price, level)
+	}
+	//从快照恢复的订单可能带有原来订单簿中的位置
+	order.level, order.prev, order.next = level, nil, nil
+	prev := level.tail
+	for prev != nil && queueLess(order, prev) {
+		prev = prev.prev
 	}
-	//加入到订单簿中
-	ob.orderBook.Put(k, order)
+	if prev == nil {
+		order.next = level.head
+		level.head = order
+	} else {
+		order.next = prev.next
+		order.prev = prev
+		prev.next = order
+	}
+	if order.next == nil {
+		level.tail = order
+	} else {
+		order.next.prev = order
+	}
+	order.levelQty, order.levelUnfilled = order.depthQty(), order.UnfilledQty
+	level.count++
+	level.qty = level.qty.Add(order.levelQty)
+	level.unfilled = level.unfilled.Add(order.levelUnfilled)
+	ob.unfilled = ob.unfilled.Add(order.levelUnfilled)
+	ob.orders[order.SequenceId] = order
 
+	if order.ExpireTime != 0 {
+		ob.expiry.Put(&expiryKey{expireTime: order.ExpireTime, seq: order.SequenceId}, order)
+	}
+	ob.l3.record(l3Add, order)
 }
+
+// remove 根据订单id删除订单，撤单时传入的订单没有过期时间，使用订单簿中的订单
 func (ob *OrderBook) remove(order *Order) {
-	k := &Key{
-		price: order.Price,
-		id:    order.SequenceId,
+	o, found := ob.orders[order.SequenceId]
+	if !found {
+		return
+	}
+	level := o.level
+	if o.prev == nil {
+		level.head = o.next
+	} else {
+		o.prev.next = o.next
+	}
+	if o.next == nil {
+		level.tail = o.prev
+	} else {
+		o.next.prev = o.prev
+	}
+	level.count--
+	level.qty = level.qty.Sub(o.levelQty)
+	level.unfilled = level.unfilled.Sub(o.levelUnfilled)
+	ob.unfilled = ob.unfilled.Sub(o.levelUnfilled)
+	if level.count == 0 {
+		ob.levels.Remove(level.price)
+	}
+	o.level, o.prev, o.next = nil, nil, nil
+	delete(ob.orders, o.SequenceId)
+
+	ob.removeExpiry(o)
+	ob.l3.record(l3Delete, o)
+}
+
+// modify 订单簿中的订单数量变化之后更新档位的汇总数量
+func (ob *OrderBook) modify(order *Order) {
+	if level := order.level; level != nil {
+		qty, unfilled := order.depthQty(), order.UnfilledQty
+		level.qty = level.qty.Sub(order.levelQty).Add(qty)
+		level.unfilled = level.unfilled.Sub(order.levelUnfilled).Add(unfilled)
+		ob.unfilled = ob.unfilled.Sub(order.levelUnfilled).Add(unfilled)
+		order.levelQty, order.levelUnfilled = qty, unfilled
+	}
+	ob.l3.record(l3Modify, order)
+}
+
+// get 根据订单id查找订单
+func (ob *OrderBook) get(sequenceId int64) (*Order, bool) {
+	o, found := ob.orders[sequenceId]
+	return o, found
+}
+
+// size 订单的数量
+func (ob *OrderBook) size() int {
+	return len(ob.orders)
+}
+
+// bestPrice 最优的价格，订单簿为空返回false
+func (ob *OrderBook) bestPrice() (utils.Fixed, bool) {
+	node := ob.levels.Left()
+	if node == nil {
+		return utils.Fixed{}, false
+	}
+	return node.Key.(utils.Fixed), true
+}
+
+// first 排在最前面的订单，订单簿为空返回nil
+func (ob *OrderBook) first() *Order {
+	node := ob.levels.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Value.(*priceLevel).head
+}
+
+// iterator 按照价格优先、时间优先的顺序遍历订单，遍历的时候不能修改订单簿
+func (ob *OrderBook) iterator() *bookIterator {
+	return &bookIterator{levels: ob.levels.Iterator()}
+}
+
+// priceLevels 按照价格排序的档位
+func (ob *OrderBook) priceLevels() []*priceLevel {
+	levels := make([]*priceLevel, 0, ob.levels.Size())
+	for _, v := range ob.levels.Values() {
+		levels = append(levels, v.(*priceLevel))
+	}
+	return levels
+}
+
+// values 按照订单簿的顺序返回所有订单
+func (ob *OrderBook) values() []*Order {
+	orders := make([]*Order, 0, len(ob.orders))
+	iterator := ob.iterator()
+	for iterator.Next() {
+		orders = append(orders, iterator.Order())
+	}
+	return orders
+}
+
+// clear 清空订单簿
+func (ob *OrderBook) clear() {
+	ob.levels.Clear()
+	ob.orders = make(map[int64]*Order)
+	ob.expiry.Clear()
+	ob.unfilled = utils.Fixed{}
+}
+
+// removeExpiry 删除限时单的过期时间索引
+func (ob *OrderBook) removeExpiry(order *Order) {
+	if order.ExpireTime != 0 {
+		ob.expiry.Remove(&expiryKey{expireTime: order.ExpireTime, seq: order.SequenceId})
 	}
-	ob.orderBook.Remove(k)
 }
 
 func (ob *OrderBook) PriceComparator(a, b interface{}) int {
-	aAsserted := a.(*Key)
-	bAsserted := b.(*Key)
-
-	if result := aAsserted.price.Cmp(bAsserted.price); result != 0 {
-		if ob.side == enum.Side_Buy {
-			//卖盘从小到大
-			//买盘的的话加一个负号，买盘从大到小。
-			return -result
-		}
-		return result
+	result := a.(utils.Fixed).Cmp(b.(utils.Fixed))
+	if ob.side == enum.Side_Buy {
+		//卖盘从小到大
+		//买盘的的话加一个负号，买盘从大到小。
+		return -result
+	}
+	return result
+}
+
+// queueId 排队的序号，没有修改过的订单排队的序号就是订单id
+func queueId(order *Order) int64 {
+	if order.QueueId != 0 {
+		return order.QueueId
+	}
+	return order.SequenceId
+}
+
+// queueLess 相同价格的订单按照排队的序号排序，排队的序号相同时按照订单id排序
+func queueLess(a, b *Order) bool {
+	if qa, qb := queueId(a), queueId(b); qa != qb {
+		return qa < qb
 	}
-	switch {
-	case aAsserted.id > bAsserted.id:
-		return 1
-	case aAsserted.id < bAsserted.id:
-		return -1
-	default:
-		return 0
+	return a.SequenceId < b.SequenceId
+}
+
+// bookIterator 先按照价格遍历档位，再按照排队的顺序遍历档位中的订单
+type bookIterator struct {
+	levels rbt.Iterator
+	order  *Order
+}
+
+func (it *bookIterator) Next() bool {
+	if it.order != nil && it.order.next != nil {
+		it.order = it.order.next
+		return true
+	}
+	if !it.levels.Next() {
+		it.order = nil
+		return false
 	}
+	it.order = it.levels.Value().(*priceLevel).head
+	return true
+}
 
+func (it *bookIterator) Order() *Order {
+	return it.order
 }
 
 func (ob *OrderBook) String() string {
 	var str string
-	values := ob.orderBook.Values()
+	values := ob.values()
 	if ob.side == enum.Side_Sell {
 		for i := len(values) - 1; i >= 0; i-- {
-			order := values[i].(*Order)
+			order := values[i]
 			str += fmt.Sprintf("[side=%v]orderID=%v Price=%v qty=%v unfilledQty=%v Amount=%v unfilledAmount=%v\n", enum.Side_Sell, order.OrderID, order.Price, order.Qty, order.UnfilledQty, order.Amount, order.UnfilledAmount)
 
 		}
 
 	} else {
 		for i := 0; i < len(values); i++ {
-			order := values[i].(*Order)
+			order := values[i]
 			str += fmt.Sprintf("[side=%v]orderID=%v Price=%v qty=%v unfilledQty=%v Amount=%v unfilledAmount=%v\n", enum.Side_Buy, order.OrderID, order.Price, order.Qty, order.UnfilledQty, order.Amount, order.UnfilledAmount)
 		}
 	}
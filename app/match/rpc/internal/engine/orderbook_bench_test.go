@@ -0,0 +1,204 @@
+package engine
+
+import (
+	"math/rand"
+	"runtime"
+	"testing"
+
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+)
+
+// 对比按照价格档位的订单簿和原来每个订单一个红黑树节点的订单簿
+// go test -run=^$ -bench=OrderBook -benchmem ./app/match/rpc/internal/engine
+
+const benchLevels = 1000
+
+type benchBook interface {
+	add(order *Order)
+	remove(order *Order)
+	first() *Order
+}
+
+// treeKey 原来的订单簿的key，按照价格、订单id排序
+type treeKey struct {
+	price utils.Fixed
+	id    int64
+}
+
+// treeOrderBook 原来的订单簿，每个订单是红黑树中的一个节点，撤单需要价格
+type treeOrderBook struct {
+	orderBook *rbt.Tree
+	side      enum.Side
+	l3        *L3Handler
+}
+
+func newTreeOrderBook(side enum.Side, l3 *L3Handler) *treeOrderBook {
+	ob := &treeOrderBook{side: side, l3: l3}
+	ob.orderBook = rbt.NewWith(ob.comparator)
+	return ob
+}
+
+func (ob *treeOrderBook) add(order *Order) {
+	ob.orderBook.Put(&treeKey{price: order.Price, id: order.SequenceId}, order)
+	ob.l3.record(l3Add, order)
+}
+
+func (ob *treeOrderBook) remove(order *Order) {
+	k := &treeKey{price: order.Price, id: order.SequenceId}
+	if o, found := ob.orderBook.Get(k); found {
+		ob.l3.record(l3Delete, o.(*Order))
+	}
+	ob.orderBook.Remove(k)
+}
+
+func (ob *treeOrderBook) first() *Order {
+	node := ob.orderBook.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Value.(*Order)
+}
+
+func (ob *treeOrderBook) comparator(a, b interface{}) int {
+	aAsserted := a.(*treeKey)
+	bAsserted := b.(*treeKey)
+	if result := aAsserted.price.Cmp(bAsserted.price); result != 0 {
+		if ob.side == enum.Side_Buy {
+			return -result
+		}
+		return result
+	}
+	switch {
+	case aAsserted.id > bAsserted.id:
+		return 1
+	case aAsserted.id < bAsserted.id:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// benchOrders 生成n个卖单，价格分布在benchLevels个档位中，和撮合引擎一样使用相同指数的定点数
+func benchOrders(n int) []*Order {
+	r := rand.New(rand.NewSource(1))
+	prices := make([]utils.Fixed, benchLevels)
+	for i := range prices {
+		prices[i] = utils.NewFixed(int64(10000+i)*100, -4)
+	}
+	orders := make([]*Order, n)
+	for i := range orders {
+		qty := utils.NewFixed(int64(r.Intn(100)+1)*10000, -4)
+		orders[i] = &Order{
+			SequenceId:  int64(i + 1),
+			Side:        enum.Side_Sell,
+			OrderType:   enum.OrderType_LO,
+			Price:       prices[r.Intn(benchLevels)],
+			Qty:         qty,
+			UnfilledQty: qty,
+		}
+	}
+	return orders
+}
+
+var benchBooks = []struct {
+	name    string
+	newBook func(l3 *L3Handler) benchBook
+}{
+	{"level", func(l3 *L3Handler) benchBook { return NewOrderBook(enum.Side_Sell, l3) }},
+	{"tree", func(l3 *L3Handler) benchBook { return newTreeOrderBook(enum.Side_Sell, l3) }},
+}
+
+func runBookBench(b *testing.B, f func(b *testing.B, book benchBook, l3 *L3Handler)) {
+	for _, v := range benchBooks {
+		newBook := v.newBook
+		b.Run(v.name, func(b *testing.B) {
+			l3 := NewL3Handler(nil, nil)
+			f(b, newBook(l3), l3)
+		})
+	}
+}
+
+// BenchmarkOrderBookAdd 挂单
+func BenchmarkOrderBookAdd(b *testing.B) {
+	runBookBench(b, func(b *testing.B, book benchBook, l3 *L3Handler) {
+		orders := benchOrders(b.N)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for _, o := range orders {
+			book.add(o)
+			l3.pending = l3.pending[:0]
+		}
+	})
+}
+
+// BenchmarkOrderBookCancel 按照随机的顺序撤销订单簿中的订单
+func BenchmarkOrderBookCancel(b *testing.B) {
+	runBookBench(b, func(b *testing.B, book benchBook, l3 *L3Handler) {
+		orders := benchOrders(b.N)
+		for _, o := range orders {
+			book.add(o)
+		}
+		rand.New(rand.NewSource(2)).Shuffle(len(orders), func(i, j int) {
+			orders[i], orders[j] = orders[j], orders[i]
+		})
+		//撤单消息只有订单id和价格
+		cancels := make([]*Order, len(orders))
+		for i, o := range orders {
+			cancels[i] = &Order{SequenceId: o.SequenceId, Price: o.Price, Side: o.Side, IsCancel: true}
+		}
+		l3.pending = l3.pending[:0]
+		//准备的订单产生的垃圾先回收，不计入测试的时间
+		runtime.GC()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for _, o := range cancels {
+			book.remove(o)
+			l3.pending = l3.pending[:0]
+		}
+	})
+}
+
+// BenchmarkOrderBookMatch 吃单，每次取出排在最前面的订单全部成交之后删除
+func BenchmarkOrderBookMatch(b *testing.B) {
+	runBookBench(b, func(b *testing.B, book benchBook, l3 *L3Handler) {
+		for _, o := range benchOrders(b.N) {
+			book.add(o)
+		}
+		l3.pending = l3.pending[:0]
+		//准备的订单产生的垃圾先回收，不计入测试的时间
+		runtime.GC()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			book.remove(book.first())
+			l3.pending = l3.pending[:0]
+		}
+	})
+}
+
+// BenchmarkOrderBookChurn 订单簿保持一定的深度，随机挂单和撤单
+func BenchmarkOrderBookChurn(b *testing.B) {
+	const depth = 100000
+	runBookBench(b, func(b *testing.B, book benchBook, l3 *L3Handler) {
+		orders := benchOrders(depth + b.N)
+		for _, o := range orders[:depth] {
+			book.add(o)
+		}
+		live := append([]*Order(nil), orders[:depth]...)
+		r := rand.New(rand.NewSource(3))
+		l3.pending = l3.pending[:0]
+		//准备的订单产生的垃圾先回收，不计入测试的时间
+		runtime.GC()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			j := r.Intn(depth)
+			book.remove(live[j])
+			live[j] = orders[depth+i]
+			book.add(live[j])
+			l3.pending = l3.pending[:0]
+		}
+	})
+}
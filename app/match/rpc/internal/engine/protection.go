@@ -0,0 +1,151 @@
+package engine
+
+import (
+	"github.com/luxun9527/gex/common/proto/define"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	commonWs "github.com/luxun9527/gex/common/proto/ws"
+	"github.com/luxun9527/gex/common/utils"
+	logger "github.com/luxun9527/zlog"
+	"github.com/shopspring/decimal"
+	"github.com/zeromicro/go-zero/core/logx"
+	"math"
+)
+
+// 价格保护：市价单只在最新成交价上下一定比例的范围内成交，超出范围的部分撤销，防止一笔大单把订单簿吃穿。
+// 熔断：统计窗口内的成交价相对窗口内最早的成交价波动超过阈值时暂停交易，暂停期间只接受撤单，配置了熔断集合竞价时暂停期间进行集合竞价。
+// 人工暂停交易和熔断一样只接受撤单，但是不进行集合竞价，见HandleHalt。
+
+// PricePoint 熔断统计窗口内的成交价
+type PricePoint struct {
+	Price utils.Fixed
+	Time  int64
+}
+
+// fixedPriceProtection 撮合引擎指数下的价格保护配置，比例保留配置的精度，参考价格转换为价格的指数。
+type fixedPriceProtection struct {
+	src            define.PriceProtection //转换的来源，配置修改之后重新转换
+	priceBand      utils.Fixed
+	referencePrice utils.Fixed
+	circuitBreaker utils.Fixed
+}
+
+// priceProtection 获取撮合引擎指数下的价格保护配置，配置没有修改时使用上次转换的结果
+func (m *MatchEngine) priceProtection() *fixedPriceProtection {
+	src := m.c.SymbolInfo.GetPriceProtection()
+	if m.protection != nil && m.protection.src == src {
+		return m.protection
+	}
+	m.protection = &fixedPriceProtection{
+		src:            src,
+		priceBand:      parseRatio("priceBand", src.PriceBand),
+		referencePrice: scaleRuleValue("referencePrice", src.ReferencePrice.RoundFloor(-m.priceExp), m.priceExp),
+		circuitBreaker: parseRatio("circuitBreaker", src.CircuitBreaker),
+	}
+	return m.protection
+}
+
+// parseRatio 比例转换为定点数，保留配置的精度，转换失败按照不开启处理
+func parseRatio(name string, value decimal.Decimal) utils.Fixed {
+	f, err := utils.NewFixedFromDecimal(value)
+	if err != nil {
+		logx.Errorw("invalid price protection", logx.Field("name", name), logx.Field("value", value), logx.Field("err", err))
+		return utils.Fixed{}
+	}
+	return f
+}
+
+// priceBandLimit 市价单可以成交的价格边界，买单为上限，卖单为下限。没有成交价时使用配置的参考价格。
+// 边界按照价格的精度取整到范围之内，参考价格是价格的整数倍，偏移量向下取整之后买单向下取整，卖单向上取整。
+func (m *MatchEngine) priceBandLimit(side enum.Side) (utils.Fixed, bool) {
+	p := m.priceProtection()
+	if !p.priceBand.IsPositive() {
+		return utils.Fixed{}, false
+	}
+	ref := m.lastPrice
+	if !ref.IsPositive() {
+		ref = p.referencePrice
+	}
+	if !ref.IsPositive() {
+		return utils.Fixed{}, false
+	}
+	d := ref.MulRoundDown(p.priceBand, m.priceExp)
+	if side == enum.Side_Sell {
+		return ref.Sub(d), true
+	}
+	//上限超出定点数的范围时所有价格都在范围之内
+	if d.Value() > math.MaxInt64-ref.Value() {
+		return utils.Fixed{}, false
+	}
+	return ref.Add(d), true
+}
+
+// checkCircuitBreaker 记录成交价，窗口内价格波动超过阈值则暂停交易
+func (m *MatchEngine) checkCircuitBreaker(price utils.Fixed, matchTime int64) {
+	p := m.priceProtection()
+	if !p.circuitBreaker.IsPositive() || p.src.Window <= 0 {
+		return
+	}
+	//丢弃窗口之外的成交价
+	i := 0
+	for i < len(m.priceWindow) && matchTime-m.priceWindow[i].Time > int64(p.src.Window) {
+		i++
+	}
+	m.priceWindow = append(m.priceWindow[i:], PricePoint{Price: price, Time: matchTime})
+	base := m.priceWindow[0].Price
+	if !reachCircuitBreaker(price.Sub(base).Abs(), base, p.circuitBreaker) {
+		return
+	}
+	m.haltUntil = matchTime + int64(p.src.HaltDuration)
+	m.priceWindow = nil
+	logx.Sloww("trading halted", logx.Field("price", price), logx.Field("basePrice", base), logx.Field("haltUntil", m.haltUntil))
+	m.pushHalt(true, price)
+	//熔断期间集合竞价，恢复交易时统一撮合
+	if m.c.SymbolInfo.GetAuction().HaltAuction {
+		m.startAuction(m.haltUntil)
+	}
+}
+
+// reachCircuitBreaker 价格波动的比例diff/base是否达到阈值。
+// 阈值是按照自身精度取整的比例，比例按照阈值的精度向下取整之后比较，结果和精确比较相同。
+func reachCircuitBreaker(diff, base, threshold utils.Fixed) bool {
+	//先比较整数部分，超过阈值的波动不需要按照阈值的精度计算，避免比例很大时超出定点数的范围
+	if diff.DivRoundDown(base, 0).GreaterThanOrEqual(threshold) {
+		return true
+	}
+	return diff.DivRoundDown(base, threshold.Exp()).GreaterThanOrEqual(threshold)
+}
+
+// isHalted 是否处于熔断或者人工暂停中，熔断时间结束之后收到第一个订单时恢复交易并推送恢复的消息。
+func (m *MatchEngine) isHalted() bool {
+	if m.manualHalt {
+		return true
+	}
+	if m.haltUntil == 0 {
+		return false
+	}
+	if m.now().UnixNano() < m.haltUntil {
+		return true
+	}
+	m.haltUntil = 0
+	logx.Sloww("trading resumed", logx.Field("lastPrice", m.lastPrice))
+	m.pushHalt(false, m.lastPrice)
+	return false
+}
+
+// pushHalt 推送熔断状态
+func (m *MatchEngine) pushHalt(halted bool, price utils.Fixed) {
+	halt := commonWs.Halt{
+		Symbol:    m.c.SymbolInfo.SymbolName,
+		Halted:    halted,
+		Price:     price.StringFixedBank(m.c.SymbolInfo.QuoteCoinPrec.Load()),
+		Until:     m.haltUntil / 1e9,
+		TimeStamp: m.now().Unix(),
+	}
+	msg := commonWs.Message[commonWs.Halt]{
+		Topic:   commonWs.HaltPrefix.WithParam(m.c.SymbolInfo.SymbolName),
+		Payload: halt,
+	}
+	if err := m.marketDataSink.PushMarketData(msg.Topic, msg.ToBytes()); err != nil {
+		logx.Errorw("push halt websocket data failed", logger.ErrorField(err), logx.Field("data", halt))
+	}
+}
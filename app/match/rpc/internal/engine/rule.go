@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+	"github.com/shopspring/decimal"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 交易规则在下单接口已经校验过一次，规则可能在下单之后修改，所以撮合引擎收到新订单时再校验一次。
+// 撮合引擎在配置修改之后把交易规则转换为撮合引擎指数下的定点数，校验订单只有整数运算。
+
+// fixedTradingRule 撮合引擎指数下的交易规则，为零表示不限制。
+// 最小值向上取整，最大值向下取整，和按照decimal比较的结果相同。
+type fixedTradingRule struct {
+	src         define.TradingRule //转换的来源，配置修改之后重新转换
+	tickSize    utils.Fixed        //价格的指数
+	lotSize     utils.Fixed        //数量的指数
+	minQty      utils.Fixed        //数量的指数
+	maxQty      utils.Fixed        //数量的指数
+	minNotional utils.Fixed        //金额的指数
+	maxNotional utils.Fixed        //金额的指数
+}
+
+// tradingRule 获取撮合引擎指数下的交易规则，配置没有修改时使用上次转换的结果
+func (m *MatchEngine) tradingRule() *fixedTradingRule {
+	//配置每次修改都会重新解析，decimal按照指针比较就可以判断配置是否修改
+	src := m.c.SymbolInfo.GetTradingRule()
+	if m.rule != nil && m.rule.src == src {
+		return m.rule
+	}
+	amountExp := m.priceExp + m.qtyExp
+	m.rule = &fixedTradingRule{
+		src:         src,
+		tickSize:    scaleRuleValue("tickSize", src.TickSize, m.priceExp),
+		lotSize:     scaleRuleValue("lotSize", src.LotSize, m.qtyExp),
+		minQty:      scaleRuleValue("minQty", src.MinQty.RoundCeil(-m.qtyExp), m.qtyExp),
+		maxQty:      scaleRuleValue("maxQty", src.MaxQty.RoundFloor(-m.qtyExp), m.qtyExp),
+		minNotional: scaleRuleValue("minNotional", src.MinNotional.RoundCeil(-amountExp), amountExp),
+		maxNotional: scaleRuleValue("maxNotional", src.MaxNotional.RoundFloor(-amountExp), amountExp),
+	}
+	return m.rule
+}
+
+// scaleRuleValue 配置转换为指定指数的定点数，转换失败按照不限制处理。
+// 最小变动单位的精度不能超过交易对的精度，否则不能按照整数取模。
+func scaleRuleValue(name string, value decimal.Decimal, exp int32) utils.Fixed {
+	if !value.IsPositive() {
+		return utils.Fixed{}
+	}
+	f, err := utils.NewFixedFromDecimal(value)
+	if err == nil {
+		f, err = f.Rescale(exp)
+	}
+	if err != nil {
+		logx.Errorw("invalid trading rule", logx.Field("name", name), logx.Field("value", value), logx.Field("exp", exp), logx.Field("err", err))
+		return utils.Fixed{}
+	}
+	return f
+}
+
+// checkPrice 校验价格是否为最小变动单位的整数倍
+func (r *fixedTradingRule) checkPrice(price utils.Fixed) error {
+	if r.tickSize.IsPositive() && price.Value()%r.tickSize.Value() != 0 {
+		return errs.ErrTickSize
+	}
+	return nil
+}
+
+// checkQty 校验数量的最小变动单位和上下限
+func (r *fixedTradingRule) checkQty(qty utils.Fixed) error {
+	if r.lotSize.IsPositive() && qty.Value()%r.lotSize.Value() != 0 {
+		return errs.ErrLotSize
+	}
+	if r.minQty.IsPositive() && qty.LessThan(r.minQty) {
+		return errs.ErrMinQty
+	}
+	if r.maxQty.IsPositive() && qty.GreaterThan(r.maxQty) {
+		return errs.ErrMaxQty
+	}
+	return nil
+}
+
+// checkNotional 校验下单金额的上下限
+func (r *fixedTradingRule) checkNotional(amount utils.Fixed) error {
+	if r.minNotional.IsPositive() && amount.LessThan(r.minNotional) {
+		return errs.ErrMinNotional
+	}
+	if r.maxNotional.IsPositive() && amount.GreaterThan(r.maxNotional) {
+		return errs.ErrMaxNotional
+	}
+	return nil
+}
+
+// checkLimitOrder 校验带价格的订单，金额超出定点数的范围按照超出最大下单金额处理
+func (r *fixedTradingRule) checkLimitOrder(price, qty utils.Fixed) error {
+	if err := r.checkPrice(price); err != nil {
+		return err
+	}
+	if err := r.checkQty(qty); err != nil {
+		return err
+	}
+	amount, err := price.MulChecked(qty)
+	if err != nil {
+		return errs.ErrMaxNotional
+	}
+	return r.checkNotional(amount)
+}
+
+// checkTradingRule 新订单转换为撮合引擎的定点数，校验是否符合交易对的交易规则
+func (m *MatchEngine) checkTradingRule(order *Order) error {
+	if err := m.scaleOrder(order); err != nil {
+		return err
+	}
+	rule := m.tradingRule()
+	if order.TriggerStatus == enum.TriggerStatus_Untriggered {
+		if err := rule.checkPrice(order.TriggerPrice); err != nil {
+			return err
+		}
+	}
+	switch {
+	//按照金额的市价买单只有金额
+	case order.OrderType == enum.OrderType_MO && order.Side == enum.Side_Buy && !order.isMarketBuyByQty():
+		return rule.checkNotional(order.Amount)
+	//市价卖单和按照数量的市价买单，金额是冻结的最大金额，只校验数量
+	case order.OrderType == enum.OrderType_MO:
+		return rule.checkQty(order.Qty)
+	default:
+		return rule.checkLimitOrder(order.Price, order.Qty)
+	}
+}
+
+// rejectOrder 熔断期间的新订单和不符合交易规则的订单直接撤销，解冻全部冻结的资产
+func (m *MatchEngine) rejectOrder(order *Order) bool {
+	if m.isHalted() {
+		logx.Infow("order rejected by trading halt", logx.Field("sequenceId", order.SequenceId))
+		m.cancelUnfilled(order)
+		return true
+	}
+	if err := m.checkTradingRule(order); err != nil {
+		logx.Infow("order rejected by trading rule", logx.Field("sequenceId", order.SequenceId), logx.Field("err", err))
+		m.cancelUnfilled(order)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	ws "github.com/luxun9527/gpush/proto"
+	logger "github.com/luxun9527/zlog"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// ResultSink 撮合结果的输出，撮合引擎按照撮合的顺序调用，实现需要保证顺序。
+type ResultSink interface {
+	SendMatchResult(resp *matchMq.MatchResp) error
+}
+
+// MarketDataSink 行情数据的输出，深度和成交记录等推送给用户的数据。
+type MarketDataSink interface {
+	PushMarketData(topic string, data []byte) error
+}
+
+// PulsarResultSink 发送撮合结果到pulsar，下游的订单、账户、行情服务消费。
+type PulsarResultSink struct {
+	producer pulsar.Producer
+}
+
+func NewPulsarResultSink(producer pulsar.Producer) *PulsarResultSink {
+	return &PulsarResultSink{producer: producer}
+}
+
+// SendMatchResult 发送失败重试10次，这个操作不异步。
+func (s *PulsarResultSink) SendMatchResult(resp *matchMq.MatchResp) error {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < 10; i++ {
+		if _, err = s.producer.Send(context.Background(), &pulsar.ProducerMessage{
+			Payload: data,
+		}); err != nil {
+			logx.Errorw("send message failed", logger.ErrorField(err), logx.Field("count", i+1))
+			time.Sleep(time.Second)
+			continue
+		}
+		break
+	}
+	return err
+}
+
+// GpushMarketDataSink 通过gpush的proxy推送行情数据
+type GpushMarketDataSink struct {
+	proxyClient ws.ProxyClient
+}
+
+func NewGpushMarketDataSink(proxyClient ws.ProxyClient) *GpushMarketDataSink {
+	return &GpushMarketDataSink{proxyClient: proxyClient}
+}
+
+func (s *GpushMarketDataSink) PushMarketData(topic string, data []byte) error {
+	_, err := s.proxyClient.PushData(context.Background(), &ws.Data{
+		Uid:   "",
+		Topic: topic,
+		Data:  data,
+	})
+	return err
+}
+
+// MemoryResultSink 撮合结果保存在内存中，用于测试和嵌入使用。
+type MemoryResultSink struct {
+	lock    sync.Mutex
+	results []*matchMq.MatchResp
+}
+
+func NewMemoryResultSink() *MemoryResultSink {
+	return &MemoryResultSink{}
+}
+
+func (s *MemoryResultSink) SendMatchResult(resp *matchMq.MatchResp) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.results = append(s.results, resp)
+	return nil
+}
+
+// Results 返回目前为止所有的撮合结果
+func (s *MemoryResultSink) Results() []*matchMq.MatchResp {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	results := make([]*matchMq.MatchResp, len(s.results))
+	copy(results, s.results)
+	return results
+}
+
+// Reset 清空撮合结果
+func (s *MemoryResultSink) Reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.results = nil
+}
+
+// MarketData 一条行情数据
+type MarketData struct {
+	Topic string
+	Data  []byte
+}
+
+// MemoryMarketDataSink 行情数据保存在内存中，用于测试和嵌入使用。
+type MemoryMarketDataSink struct {
+	lock sync.Mutex
+	data []MarketData
+}
+
+func NewMemoryMarketDataSink() *MemoryMarketDataSink {
+	return &MemoryMarketDataSink{}
+}
+
+func (s *MemoryMarketDataSink) PushMarketData(topic string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data = append(s.data, MarketData{Topic: topic, Data: data})
+	return nil
+}
+
+// Data 返回目前为止所有的行情数据
+func (s *MemoryMarketDataSink) Data() []MarketData {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	data := make([]MarketData, len(s.data))
+	copy(data, s.data)
+	return data
+}
+
+// ChannelResultSink 撮合结果写入channel，channel满了会阻塞撮合。
+type ChannelResultSink struct {
+	C chan *matchMq.MatchResp
+}
+
+func NewChannelResultSink(size int) *ChannelResultSink {
+	return &ChannelResultSink{C: make(chan *matchMq.MatchResp, size)}
+}
+
+func (s *ChannelResultSink) SendMatchResult(resp *matchMq.MatchResp) error {
+	s.C <- resp
+	return nil
+}
+
+// ChannelMarketDataSink 行情数据写入channel，channel满了会阻塞推送。
+type ChannelMarketDataSink struct {
+	C chan MarketData
+}
+
+func NewChannelMarketDataSink(size int) *ChannelMarketDataSink {
+	return &ChannelMarketDataSink{C: make(chan MarketData, size)}
+}
+
+func (s *ChannelMarketDataSink) PushMarketData(topic string, data []byte) error {
+	s.C <- MarketData{Topic: topic, Data: data}
+	return nil
+}
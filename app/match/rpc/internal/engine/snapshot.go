@@ -0,0 +1,161 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/luxun9527/gex/common/utils"
+	"os"
+	"path/filepath"
+)
+
+// Snapshot 订单簿快照，包含恢复撮合引擎需要的所有状态。
+// 重启时加载最新的快照，然后从MessageId之后开始重放match_source的消息。
+type Snapshot struct {
+	SymbolName   string
+	CurrentSeqId int64        //当前的版本号
+	ResultSeq    int64        //撮合结果的序号，用于生成撮合结果的消息id
+	LastPrice    utils.Fixed  //最新成交价
+	PriceWindow  []PricePoint //熔断统计窗口内的成交价
+	HaltUntil    int64        //熔断恢复交易的时间
+	ManualHalt   bool         //人工暂停交易
+	AuctionUntil int64        //集合竞价结束的时间
+	L3Seq        int64        //逐笔委托的序号
+	Asks         []Order      //卖盘，按照订单簿的顺序
+	Bids         []Order      //买盘，按照订单簿的顺序
+	Triggers     []Order      //未触发的条件单
+	DepthAsks    []SnapshotPosition
+	DepthBids    []SnapshotPosition
+	MessageId    []byte //最后处理的match_source消息id
+	CreatedAt    int64
+}
+
+// SnapshotPosition 深度档位
+type SnapshotPosition struct {
+	Price utils.Fixed
+	Qty   utils.Fixed
+}
+
+// TakeSnapshot 生成快照，必须和HandleOrder在同一个协程中调用，保证快照和消息id一致。
+func (m *MatchEngine) TakeSnapshot(messageId []byte) *Snapshot {
+	s := &Snapshot{
+		SymbolName:   m.c.SymbolInfo.SymbolName,
+		CurrentSeqId: m.currentSeqId,
+		ResultSeq:    m.resultSeq,
+		LastPrice:    m.lastPrice,
+		PriceWindow:  append([]PricePoint(nil), m.priceWindow...),
+		HaltUntil:    m.haltUntil,
+		ManualHalt:   m.manualHalt,
+		AuctionUntil: m.auctionUntil,
+		L3Seq:        m.l3.seq,
+		Asks:         make([]Order, 0, m.asks.size()),
+		Bids:         make([]Order, 0, m.bids.size()),
+		Triggers:     make([]Order, 0, len(m.triggerOrders)),
+		MessageId:    messageId,
+		CreatedAt:    m.now().Unix(),
+	}
+	for _, v := range m.asks.values() {
+		s.Asks = append(s.Asks, *v)
+	}
+	for _, v := range m.bids.values() {
+		s.Bids = append(s.Bids, *v)
+	}
+	for _, tb := range []*TriggerBook{m.buyTriggers, m.sellTriggers} {
+		for _, v := range tb.triggerBook.Values() {
+			s.Triggers = append(s.Triggers, *v.(*Order))
+		}
+	}
+	//深度是异步更新的，直接读取深度的红黑树可能落后于订单簿，这里使用订单簿档位的汇总数量。
+	s.DepthAsks = aggregateDepth(m.asks)
+	s.DepthBids = aggregateDepth(m.bids)
+	return s
+}
+
+// aggregateDepth 订单簿档位的显示数量就是深度
+func aggregateDepth(book *OrderBook) []SnapshotPosition {
+	levels := book.priceLevels()
+	positions := make([]SnapshotPosition, 0, len(levels))
+	for _, v := range levels {
+		positions = append(positions, SnapshotPosition{Price: v.price, Qty: v.qty})
+	}
+	return positions
+}
+
+// RestoreSnapshot 从快照恢复撮合引擎，在开始消费消息之前调用。
+func (m *MatchEngine) RestoreSnapshot(s *Snapshot) {
+	m.asks.clear()
+	m.bids.clear()
+	m.buyTriggers.triggerBook.Clear()
+	m.sellTriggers.triggerBook.Clear()
+	m.triggerOrders = make(map[int64]*Order, len(s.Triggers))
+	for i := range s.Asks {
+		m.asks.add(&s.Asks[i])
+	}
+	for i := range s.Bids {
+		m.bids.add(&s.Bids[i])
+	}
+	for i := range s.Triggers {
+		m.addTriggerOrder(&s.Triggers[i])
+	}
+	m.updateBestAsk()
+	m.updateBestBid()
+	m.currentSeqId = s.CurrentSeqId
+	m.resultSeq = s.ResultSeq
+	m.lastPrice = s.LastPrice
+	m.priceWindow = s.PriceWindow
+	m.haltUntil = s.HaltUntil
+	m.manualHalt = s.ManualHalt
+	m.auctionUntil = s.AuctionUntil
+	m.l3.restore(s.L3Seq, s.CurrentSeqId, m.asks, m.bids)
+
+	asks := make([]*position, 0, len(s.DepthAsks))
+	for _, v := range s.DepthAsks {
+		asks = append(asks, &position{price: v.Price, qty: v.Qty})
+	}
+	bids := make([]*position, 0, len(s.DepthBids))
+	for _, v := range s.DepthBids {
+		bids = append(bids, &position{price: v.Price, qty: v.Qty})
+	}
+	m.depthHandler.restore(asks, bids, s.CurrentSeqId)
+}
+
+// Encode 快照编码为二进制
+func (s *Snapshot) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SnapshotPath 快照文件路径，每个交易对只保留最新的一个快照
+func SnapshotPath(dir, symbol string) string {
+	return filepath.Join(dir, symbol+".snapshot")
+}
+
+// SaveSnapshot 保存快照，先写临时文件再重命名，避免写到一半宕机导致快照损坏。
+func SaveSnapshot(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadSnapshot 加载快照，快照不存在返回nil
+func LoadSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var s Snapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
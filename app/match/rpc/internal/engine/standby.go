@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"sync/atomic"
+)
+
+// 主备部署：同一个交易对运行两个实例，通过etcd选主，主节点发送撮合结果和推送行情。
+// 备节点用自己的订阅消费相同的match_source，维护相同的订单簿，撮合结果先缓存不发送，行情数据直接丢弃。
+// 撮合结果带有产生这个结果的match_source消息id和在这个消息中的序号，备节点跟随match_result，丢弃主节点已经发送过的缓存。
+// 主节点的租约失效之后备节点成为主节点，补发主节点还没有发送的撮合结果，序号从主节点最后发送的序号继续，
+// 备节点处理match_source落后于主节点时，追上主节点之前产生的撮合结果不再发送。
+// 限时单过期和集合竞价结束由时间触发，切换的时候两个节点触发的位置可能相差一个消息。
+
+type standbyResult struct {
+	sourceId pulsar.MessageID
+	index    int32
+	resp     *matchMq.MatchResp
+}
+
+// StandbySink 主备部署时的撮合结果和行情数据的输出，备节点缓存撮合结果，成为主节点之后和普通的输出一样。
+// 除了推送行情数据，其他方法必须和HandleOrder在同一个协程中调用。
+type StandbySink struct {
+	symbol         string
+	resultSink     ResultSink
+	marketDataSink MarketDataSink
+	leader         atomic.Bool
+	//正在处理的match_source消息
+	sourceId      pulsar.MessageID
+	sourceIdBytes []byte
+	index         int32
+	//备节点还没有被主节点确认发送的撮合结果
+	pending []standbyResult
+	//主节点最后发送的撮合结果
+	leaderSeq      int64
+	leaderSourceId pulsar.MessageID
+	leaderIndex    int32
+	//成为主节点之后发送的序号，主节点没有发送过撮合结果时使用撮合引擎的序号
+	seq int64
+}
+
+func NewStandbySink(symbol string, resultSink ResultSink, marketDataSink MarketDataSink) *StandbySink {
+	return &StandbySink{
+		symbol:         symbol,
+		resultSink:     resultSink,
+		marketDataSink: marketDataSink,
+		pending:        make([]standbyResult, 0, 64),
+	}
+}
+
+// Begin 开始处理一条match_source消息，之后产生的撮合结果都属于这条消息
+func (s *StandbySink) Begin(sourceId pulsar.MessageID) {
+	s.sourceId = sourceId
+	s.sourceIdBytes = sourceId.Serialize()
+	s.index = 0
+}
+
+func (s *StandbySink) SendMatchResult(resp *matchMq.MatchResp) error {
+	resp.SourceId = s.sourceIdBytes
+	resp.SourceIndex = s.index
+	s.index++
+	if s.leader.Load() {
+		return s.send(s.sourceId, resp)
+	}
+	s.pending = append(s.pending, standbyResult{sourceId: s.sourceId, index: resp.SourceIndex, resp: resp})
+	return nil
+}
+
+// send 主节点已经发送过的撮合结果跳过，其他的从主节点最后发送的序号继续
+func (s *StandbySink) send(sourceId pulsar.MessageID, resp *matchMq.MatchResp) error {
+	if s.leaderSeq == 0 {
+		return s.resultSink.SendMatchResult(resp)
+	}
+	if compareSource(sourceId, resp.SourceIndex, s.leaderSourceId, s.leaderIndex) <= 0 {
+		return nil
+	}
+	resp.Seq = s.seq + 1
+	resp.MessageId = resultMessageId(s.symbol, resp.Seq)
+	if err := s.resultSink.SendMatchResult(resp); err != nil {
+		return err
+	}
+	s.seq = resp.Seq
+	return nil
+}
+
+// PushMarketData 备节点不推送行情数据
+func (s *StandbySink) PushMarketData(topic string, data []byte) error {
+	if !s.leader.Load() {
+		return nil
+	}
+	return s.marketDataSink.PushMarketData(topic, data)
+}
+
+// Leader 是否是主节点
+func (s *StandbySink) Leader() bool {
+	return s.leader.Load()
+}
+
+// Confirm 主节点已经发送了这个撮合结果，丢弃这个结果以及之前的缓存
+func (s *StandbySink) Confirm(seq int64, sourceId pulsar.MessageID, index int32) {
+	s.leaderSeq, s.leaderSourceId, s.leaderIndex = seq, sourceId, index
+	n := 0
+	for n < len(s.pending) && compareSource(s.pending[n].sourceId, s.pending[n].index, sourceId, index) <= 0 {
+		n++
+	}
+	if n > 0 {
+		s.pending = append(s.pending[:0], s.pending[n:]...)
+	}
+}
+
+// Promote 成为主节点，补发主节点还没有发送的撮合结果，发送失败返回错误，可以再次调用
+func (s *StandbySink) Promote() error {
+	if s.seq < s.leaderSeq {
+		s.seq = s.leaderSeq
+	}
+	for len(s.pending) > 0 {
+		if err := s.send(s.pending[0].sourceId, s.pending[0].resp); err != nil {
+			return err
+		}
+		s.pending = s.pending[1:]
+	}
+	s.leader.Store(true)
+	return nil
+}
+
+// compareSource 比较两个撮合结果在match_source中的位置
+func compareSource(a pulsar.MessageID, aIndex int32, b pulsar.MessageID, bIndex int32) int {
+	switch {
+	case a == nil || b == nil:
+		//没有消息id的结果在第一条消息之前产生
+		if a == nil && b != nil {
+			return -1
+		}
+		if a != nil && b == nil {
+			return 1
+		}
+	case a.LedgerID() != b.LedgerID():
+		return compareInt64(a.LedgerID(), b.LedgerID())
+	case a.EntryID() != b.EntryID():
+		return compareInt64(a.EntryID(), b.EntryID())
+	case a.BatchIdx() != b.BatchIdx():
+		return compareInt64(int64(a.BatchIdx()), int64(b.BatchIdx()))
+	}
+	return compareInt64(int64(aIndex), int64(bIndex))
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+)
+
+// 自成交保护，taker和maker属于同一个用户时不成交，按照taker的自成交保护模式撤销或者减少订单。
+// 撤销和减少数量都发送撤单消息解冻资产，减少数量的撤单消息带有DecrementQty，订单服务只更新未成交数量，不撤销订单。
+
+// stpCancel 自成交保护产生的撤单消息，在撮合结果之后发送
+type stpCancel struct {
+	resp  *CancelResp
+	order *Order
+}
+
+// stpMode taker的自成交保护模式，订单没有指定时使用交易对的默认配置
+func (m *MatchEngine) stpMode(order *Order) enum.STPMode {
+	if order.STPMode != enum.STPMode_UnknownSTPMode {
+		return order.STPMode
+	}
+	return enum.STPMode(m.c.SymbolInfo.STPMode.Load())
+}
+
+// isSelfTrade taker和maker是同一个用户并且需要自成交保护
+func (m *MatchEngine) isSelfTrade(taker, maker *Order) bool {
+	if taker.Uid != maker.Uid {
+		return false
+	}
+	mode := m.stpMode(taker)
+	return mode != enum.STPMode_UnknownSTPMode && mode != enum.STPMode_AllowSelfTrade
+}
+
+// preventSelfTrade 处理自成交，返回taker是否停止撮合，maker是否需要从订单簿中删除。
+// 停止撮合的taker状态为撤销，剩余的部分由调用方撤销。
+func (m *MatchEngine) preventSelfTrade(taker, maker *Order) (stop, makerRemoved bool) {
+	mode := m.stpMode(taker)
+	//按照金额的市价买单没有数量，减少数量按照撤销新订单处理
+	if mode == enum.STPMode_DecrementAndCancel && taker.Side == enum.Side_Buy && taker.OrderType == enum.OrderType_MO && !taker.isMarketBuyByQty() {
+		mode = enum.STPMode_CancelNewest
+	}
+	switch mode {
+	case enum.STPMode_CancelNewest:
+		stop = true
+	case enum.STPMode_CancelOldest:
+		makerRemoved = true
+	case enum.STPMode_CancelBoth:
+		stop, makerRemoved = true, true
+	case enum.STPMode_DecrementAndCancel:
+		//两个订单都减少较小的数量，数量减为0的订单撤销
+		qty := utils.MinFixed(taker.UnfilledQty, maker.UnfilledQty)
+		if qty.Equal(maker.UnfilledQty) {
+			makerRemoved = true
+		} else {
+			//冰山单先减少隐藏的部分，深度只减少显示的部分减少的数量
+			visible := maker.depthQty()
+			m.decrementOrder(maker, qty)
+			m.orderBook(maker.Side).modify(maker)
+			m.depthHandler.updateDepth(&position{
+				price: maker.Price,
+				qty:   visible.Sub(maker.depthQty()),
+			}, maker.Side, Delete, m.currentSeqId)
+		}
+		if qty.Equal(taker.UnfilledQty) {
+			stop = true
+		} else {
+			m.decrementOrder(taker, qty)
+		}
+	}
+	if makerRemoved {
+		m.depthHandler.updateDepth(&position{
+			price: maker.Price,
+			qty:   maker.depthQty(),
+		}, maker.Side, Delete, m.currentSeqId)
+		maker.OrderStatus = enum.OrderStatus_Canceled
+		m.stpCancels = append(m.stpCancels, stpCancel{resp: m.newCancelResp(maker)})
+	}
+	if stop {
+		taker.OrderStatus = enum.OrderStatus_Canceled
+	}
+	return stop, makerRemoved
+}
+
+// decrementOrder 减少订单的数量和未成交数量，解冻减少的部分，买单解冻计价币，卖单解冻基础币。
+// 订单数量同时减少，保证撮合结果中的成交数量Qty-UnfilledQty是正确的。
+func (m *MatchEngine) decrementOrder(order *Order, qty utils.Fixed) {
+	//市价单价格为0，金额不变，按照数量的市价买单冻结的金额在订单结束时解冻
+	amount := qty.Mul(order.Price)
+	order.Qty = order.Qty.Sub(qty)
+	order.Amount = order.Amount.Sub(amount)
+	order.UnfilledQty = order.UnfilledQty.Sub(qty)
+	order.UnfilledAmount = order.UnfilledAmount.Sub(amount)
+	order.clampVisible()
+	resp := &CancelResp{
+		CancelId:     order.SequenceId,
+		CoinId:       m.c.SymbolInfo.BaseCoinID,
+		Qty:          qty.String(),
+		Uid:          order.Uid,
+		DecrementQty: qty.String(),
+	}
+	if order.Side == enum.Side_Buy {
+		resp.CoinId, resp.Qty = m.c.SymbolInfo.QuoteCoinID, amount.String()
+	}
+	m.stpCancels = append(m.stpCancels, stpCancel{resp: resp, order: order})
+}
+
+// sendSTPCancels 在撮合结果之后发送自成交保护的撤单消息。
+// 减少数量的订单在之后可能继续成交，未成交数量以发送时为准，保证订单服务最后更新的是最新的状态。
+func (m *MatchEngine) sendSTPCancels() {
+	for _, v := range m.stpCancels {
+		if v.order != nil {
+			v.resp.UnfilledQty = v.order.UnfilledQty.String()
+			v.resp.UnfilledAmount = v.order.UnfilledAmount.String()
+			v.resp.OrderQty = v.order.Qty.String()
+			v.resp.OrderAmount = v.order.Amount.String()
+		}
+		m.SendMatchResult(&MatchResult{
+			CancelResp: v.resp,
+			MatchTime:  m.now().UnixNano(),
+		})
+	}
+	m.stpCancels = m.stpCancels[:0]
+}
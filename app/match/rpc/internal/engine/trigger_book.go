@@ -0,0 +1,83 @@
+package engine
+
+import (
+	rbt "github.com/emirpasic/gods/trees/redblacktree"
+	enum "github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+)
+
+// TriggerBook 条件单簿,条件单在触发之前不进入订单簿,按照触发价格排序。
+// 买方向的条件单在最新成交价大于等于触发价时触发,触发价从小到大排序。
+// 卖方向的条件单在最新成交价小于等于触发价时触发,触发价从大到小排序。
+type TriggerBook struct {
+	triggerBook *rbt.Tree // 红黑树存储条件单
+	side        enum.Side // 买卖方向
+}
+
+func NewTriggerBook(side enum.Side) *TriggerBook {
+	tb := &TriggerBook{
+		side: side,
+	}
+	tb.triggerBook = rbt.NewWith(tb.TriggerPriceComparator)
+	return tb
+}
+
+func (tb *TriggerBook) add(order *Order) {
+	k := &Key{
+		price: order.TriggerPrice,
+		id:    order.SequenceId,
+	}
+	tb.triggerBook.Put(k, order)
+}
+
+func (tb *TriggerBook) remove(order *Order) {
+	k := &Key{
+		price: order.TriggerPrice,
+		id:    order.SequenceId,
+	}
+	tb.triggerBook.Remove(k)
+}
+
+// popTriggered 取出最新成交价下已经触发的条件单,按照触发价格的先后顺序返回。
+func (tb *TriggerBook) popTriggered(lastPrice utils.Fixed) []*Order {
+	var orders []*Order
+	for tb.triggerBook.Size() > 0 {
+		node := tb.triggerBook.Left()
+		order := node.Value.(*Order)
+		if !tb.shouldTrigger(order.TriggerPrice, lastPrice) {
+			break
+		}
+		tb.triggerBook.Remove(node.Key)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// shouldTrigger 判断最新成交价是否达到触发价
+func (tb *TriggerBook) shouldTrigger(triggerPrice, lastPrice utils.Fixed) bool {
+	if tb.side == enum.Side_Buy {
+		return lastPrice.GreaterThanOrEqual(triggerPrice)
+	}
+	return lastPrice.LessThanOrEqual(triggerPrice)
+}
+
+func (tb *TriggerBook) TriggerPriceComparator(a, b interface{}) int {
+	aAsserted := a.(*Key)
+	bAsserted := b.(*Key)
+
+	if result := aAsserted.price.Cmp(bAsserted.price); result != 0 {
+		if tb.side == enum.Side_Sell {
+			//卖方向触发价从大到小
+			return -result
+		}
+		return result
+	}
+	switch {
+	case aAsserted.id > bAsserted.id:
+		return 1
+	case aAsserted.id < bAsserted.id:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -3,8 +3,10 @@ package logic
 import (
 	"context"
 
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
 	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
 	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -24,7 +26,22 @@ func NewGetDepthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDepth
 }
 
 func (l *GetDepthLogic) GetDepth(in *pb.GetDepthReq) (*pb.GetDepthResp, error) {
-	depth := l.svcCtx.MatchEngine.GetDepth(in.Level)
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	//合并深度
+	if in.Group != "" {
+		depth, ok := s.MatchEngine.GetGroupDepth(in.Level, in.Group)
+		if !ok {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "depth group not supported")
+		}
+		return toDepthResp(depth), nil
+	}
+	return toDepthResp(s.MatchEngine.GetDepth(in.Level)), nil
+}
+
+func toDepthResp(depth engine.DepthData) *pb.GetDepthResp {
 	ask := make([]*pb.GetDepthResp_Position, 0, len(depth.Asks))
 	bid := make([]*pb.GetDepthResp_Position, 0, len(depth.Bids))
 	for _, v := range depth.Asks {
@@ -44,8 +61,9 @@ func (l *GetDepthLogic) GetDepth(in *pb.GetDepthReq) (*pb.GetDepthResp, error) {
 		bid = append(bid, p)
 	}
 	return &pb.GetDepthResp{
-		Version: depth.CurrentVersion,
-		Asks:    ask,
-		Bids:    bid,
-	}, nil
+		Version:  depth.CurrentVersion,
+		Asks:     ask,
+		Bids:     bid,
+		Checksum: depth.Checksum,
+	}
 }
@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEngineOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEngineOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEngineOrderLogic {
+	return &GetEngineOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetEngineOrder 运维接口，根据主键id获取订单簿中的订单或者未触发的条件单，已经完成的订单不在撮合引擎中
+func (l *GetEngineOrderLogic) GetEngineOrder(in *pb.GetEngineOrderReq) (*pb.GetEngineOrderResp, error) {
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	s.EngineLock.Lock()
+	order, ok := s.MatchEngine.GetOrder(in.Id)
+	s.EngineLock.Unlock()
+	if !ok {
+		return nil, errs.OrderNotFound
+	}
+	return &pb.GetEngineOrderResp{Order: engineOrderToPb(&order)}, nil
+}
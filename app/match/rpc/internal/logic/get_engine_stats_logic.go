@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEngineStatsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEngineStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEngineStatsLogic {
+	return &GetEngineStatsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetEngineStats 运维接口，获取撮合引擎的状态
+func (l *GetEngineStatsLogic) GetEngineStats(in *pb.GetEngineStatsReq) (*pb.GetEngineStatsResp, error) {
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	s.EngineLock.Lock()
+	stats := s.MatchEngine.GetStats()
+	s.EngineLock.Unlock()
+
+	return &pb.GetEngineStatsResp{
+		CurrentSeqId:   stats.CurrentSeqId,
+		ResultSeq:      stats.ResultSeq,
+		AskOrders:      int64(stats.AskOrders),
+		BidOrders:      int64(stats.BidOrders),
+		TriggerOrders:  int64(stats.TriggerOrders),
+		AskLevels:      int64(stats.AskLevels),
+		BidLevels:      int64(stats.BidLevels),
+		BestBid:        stats.BestBid.String(),
+		BestAsk:        stats.BestAsk.String(),
+		LastPrice:      stats.LastPrice.String(),
+		Halted:         stats.ManualHalt,
+		HaltUntil:      stats.HaltUntil,
+		AuctionUntil:   stats.AuctionUntil,
+		TickQueue:      int64(stats.TickQueue),
+		DepthQueue:     int64(stats.DepthQueue),
+		DepthPushQueue: int64(stats.DepthPushQueue),
+		Leader:         s.Standby == nil || s.Standby.Sink.Leader(),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetL3SnapshotLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetL3SnapshotLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetL3SnapshotLogic {
+	return &GetL3SnapshotLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetL3Snapshot 获取订单簿中所有订单的快照，不包含用户id
+func (l *GetL3SnapshotLogic) GetL3Snapshot(in *pb.GetL3SnapshotReq) (*pb.GetL3SnapshotResp, error) {
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	snapshot := s.MatchEngine.GetL3Snapshot()
+	baseCoinPrec, quoteCoinPrec := s.Config.SymbolInfo.BaseCoinPrec.Load(), s.Config.SymbolInfo.QuoteCoinPrec.Load()
+	toPb := func(orders []*engine.L3Order) []*pb.GetL3SnapshotResp_Order {
+		result := make([]*pb.GetL3SnapshotResp_Order, 0, len(orders))
+		for _, v := range orders {
+			result = append(result, &pb.GetL3SnapshotResp_Order{
+				Id:    v.Id,
+				Price: v.Price.StringFixedBank(quoteCoinPrec),
+				Qty:   v.Qty.StringFixedBank(baseCoinPrec),
+				Queue: v.Queue,
+			})
+		}
+		return result
+	}
+	return &pb.GetL3SnapshotResp{
+		Version: snapshot.Version,
+		Seq:     snapshot.Seq,
+		Asks:    toPb(snapshot.Asks),
+		Bids:    toPb(snapshot.Bids),
+	}, nil
+}
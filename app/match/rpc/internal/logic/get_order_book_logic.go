@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOrderBookLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOrderBookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrderBookLogic {
+	return &GetOrderBookLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetOrderBook 运维接口，获取订单簿中所有的订单，复制订单簿期间不处理撮合消息
+func (l *GetOrderBookLogic) GetOrderBook(in *pb.GetOrderBookReq) (*pb.GetOrderBookResp, error) {
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	s.EngineLock.Lock()
+	book := s.MatchEngine.GetBook()
+	s.EngineLock.Unlock()
+
+	toPb := func(orders []engine.Order) []*pb.EngineOrder {
+		result := make([]*pb.EngineOrder, 0, len(orders))
+		for i := range orders {
+			result = append(result, engineOrderToPb(&orders[i]))
+		}
+		return result
+	}
+	return &pb.GetOrderBookResp{
+		Version:  book.Version,
+		Asks:     toPb(book.Asks),
+		Bids:     toPb(book.Bids),
+		Triggers: toPb(book.Triggers),
+	}, nil
+}
+
+// engineOrderToPb 撮合引擎中的订单，数值按照撮合引擎中的精度输出
+func engineOrderToPb(o *engine.Order) *pb.EngineOrder {
+	return &pb.EngineOrder{
+		Id:             o.SequenceId,
+		OrderId:        o.OrderID,
+		Uid:            o.Uid,
+		Side:           o.Side,
+		OrderType:      o.OrderType,
+		OrderStatus:    o.OrderStatus,
+		Price:          o.Price.String(),
+		Qty:            o.Qty.String(),
+		Amount:         o.Amount.String(),
+		FilledQty:      o.FilledQty.String(),
+		FilledAmount:   o.FilledAmount.String(),
+		UnfilledQty:    o.UnfilledQty.String(),
+		UnfilledAmount: o.UnfilledAmount.String(),
+		Fee:            o.Fee.String(),
+		TriggerPrice:   o.TriggerPrice.String(),
+		TriggerStatus:  o.TriggerStatus,
+		DisplayQty:     o.DisplayQty.String(),
+		VisibleQty:     o.VisibleQty.String(),
+		PostOnly:       o.PostOnly,
+		StpMode:        o.STPMode,
+		ExpireTime:     o.ExpireTime,
+		QueueId:        o.QueueId,
+	}
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"github.com/luxun9527/gex/common/errs"
 	"github.com/luxun9527/gex/common/utils"
 	logger "github.com/luxun9527/zlog"
 
@@ -30,9 +31,16 @@ func (l *GetTickLogic) GetTick(in *pb.GetTickReq) (*pb.GetTickResp, error) {
 	if in.Limit == 0 {
 		in.Limit = 40
 	}
-	matchedOrders, err := l.svcCtx.Query.MatchedOrder.
+	s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	symbolInfo := s.Config.SymbolInfo
+	mo := l.svcCtx.Query.MatchedOrder
+	matchedOrders, err := mo.
 		WithContext(l.ctx).
-		Order(l.svcCtx.Query.MatchedOrder.ID.Desc()).
+		Where(mo.SymbolName.Eq(in.Symbol)).
+		Order(mo.ID.Desc()).
 		Limit(int(in.Limit)).Find()
 	if err != nil {
 		logx.Errorw("get match order failed", logger.ErrorField(err))
@@ -46,9 +54,9 @@ func (l *GetTickLogic) GetTick(in *pb.GetTickReq) (*pb.GetTickResp, error) {
 			f = true
 		}
 		tick := &pb.GetTickResp_Tick{
-			Price:        utils.PrecCut(v.Price, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
-			Qty:          utils.PrecCut(v.Qty, l.svcCtx.Config.SymbolInfo.BaseCoinPrec.Load()),
-			Amount:       utils.PrecCut(v.Price, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+			Price:        utils.PrecCut(v.Price, symbolInfo.QuoteCoinPrec.Load()),
+			Qty:          utils.PrecCut(v.Qty, symbolInfo.BaseCoinPrec.Load()),
+			Amount:       utils.PrecCut(v.Price, symbolInfo.QuoteCoinPrec.Load()),
 			Timestamp:    v.MatchTime,
 			Symbol:       v.SymbolName,
 			TakerIsBuyer: f,
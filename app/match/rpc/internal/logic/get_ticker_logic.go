@@ -33,8 +33,6 @@ func NewGetTickerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTick
 
 // 获取ticker
 func (l *GetTickerLogic) GetTicker(in *pb.GetTickerReq) (*pb.GetTickerResp, error) {
-	quoteCoinPrec := l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()
-	baseCoinPrec := l.svcCtx.Config.SymbolInfo.BaseCoinPrec.Load()
 	resp := &pb.GetTickerResp{}
 	if in.Symbol == "" {
 		data, err := l.svcCtx.RedisClient.Hgetall(string(define.Ticker))
@@ -51,6 +49,12 @@ func (l *GetTickerLogic) GetTicker(in *pb.GetTickerReq) (*pb.GetTickerResp, erro
 			if err := json.Unmarshal([]byte(v), &tickerRedisData); err != nil {
 				return nil, errs.Internal
 			}
+			//只返回当前实例运行的交易对
+			s, ok := l.svcCtx.GetSymbolContext(tickerRedisData.Symbol)
+			if !ok {
+				continue
+			}
+			quoteCoinPrec, baseCoinPrec := s.Config.SymbolInfo.QuoteCoinPrec.Load(), s.Config.SymbolInfo.BaseCoinPrec.Load()
 			d := &pb.GetTickerResp_Ticker{
 				LatestPrice: utils.PrecCut(tickerRedisData.Price, quoteCoinPrec),
 				High:        utils.PrecCut(tickerRedisData.High, quoteCoinPrec),
@@ -65,6 +69,11 @@ func (l *GetTickerLogic) GetTicker(in *pb.GetTickerReq) (*pb.GetTickerResp, erro
 		}
 		resp.TickerList = respData
 	} else {
+		s, ok := l.svcCtx.GetSymbolContext(in.Symbol)
+		if !ok {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+		}
+		quoteCoinPrec, baseCoinPrec := s.Config.SymbolInfo.QuoteCoinPrec.Load(), s.Config.SymbolInfo.BaseCoinPrec.Load()
 		respData := make([]*pb.GetTickerResp_Ticker, 0, 10)
 
 		var tickerRedisData model.TickerRedisData
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/app/match/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/match/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	pulsarConfig "github.com/luxun9527/gex/common/pkg/pulsar"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	logger "github.com/luxun9527/zlog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type HaltTradingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewHaltTradingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HaltTradingLogic {
+	return &HaltTradingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// HaltTrading 运维接口，暂停或者恢复交易。
+// 消息发送到match_source，和订单按照相同的顺序处理，之前发送的订单撮合之后才暂停，主备节点和重放的结果一致。
+// 返回时撮合引擎可能还没有处理，通过GetEngineStats确认。
+func (l *HaltTradingLogic) HaltTrading(in *pb.HaltTradingReq) (*pb.HaltTradingResp, error) {
+	if _, ok := l.svcCtx.GetSymbolContext(in.Symbol); !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	topic := pulsarConfig.Topic{
+		Tenant:    pulsarConfig.PublicTenant,
+		Namespace: pulsarConfig.GexNamespace,
+		Topic:     pulsarConfig.MatchSourceTopic + "_" + in.Symbol,
+	}
+	//运维操作很少，每次创建生产者，不为每个交易对保留一个match_source的生产者
+	producer, err := l.svcCtx.PulsarClient.CreateProducer(pulsar.ProducerOptions{
+		Topic:           topic.BuildTopic(),
+		SendTimeout:     10 * time.Second,
+		DisableBatching: true,
+	})
+	if err != nil {
+		l.Errorw("create match source producer failed", logger.ErrorField(err), logx.Field("symbol", in.Symbol))
+		return nil, errs.PulsarErr
+	}
+	defer producer.Close()
+
+	haltReq := &matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_Halt{
+			Halt: &matchMq.HaltOperate{Halted: in.Halted},
+		},
+	}
+	data, _ := proto.Marshal(haltReq)
+	if _, err := producer.Send(l.ctx, &pulsar.ProducerMessage{
+		Payload: data,
+	}); err != nil {
+		l.Errorw("send halt message failed", logger.ErrorField(err), logx.Field("symbol", in.Symbol))
+		return nil, errs.PulsarErr
+	}
+	l.Sloww("halt trading requested", logx.Field("symbol", in.Symbol), logx.Field("halted", in.Halted))
+	return &pb.HaltTradingResp{}, nil
+}
@@ -39,3 +39,33 @@ func (s *MatchServiceServer) GetTicker(ctx context.Context, in *pb.GetTickerReq)
 	l := logic.NewGetTickerLogic(ctx, s.svcCtx)
 	return l.GetTicker(in)
 }
+
+// 获取逐笔委托的快照
+func (s *MatchServiceServer) GetL3Snapshot(ctx context.Context, in *pb.GetL3SnapshotReq) (*pb.GetL3SnapshotResp, error) {
+	l := logic.NewGetL3SnapshotLogic(ctx, s.svcCtx)
+	return l.GetL3Snapshot(in)
+}
+
+// 运维接口 获取订单簿中所有的订单
+func (s *MatchServiceServer) GetOrderBook(ctx context.Context, in *pb.GetOrderBookReq) (*pb.GetOrderBookResp, error) {
+	l := logic.NewGetOrderBookLogic(ctx, s.svcCtx)
+	return l.GetOrderBook(in)
+}
+
+// 运维接口 获取撮合引擎中的订单
+func (s *MatchServiceServer) GetEngineOrder(ctx context.Context, in *pb.GetEngineOrderReq) (*pb.GetEngineOrderResp, error) {
+	l := logic.NewGetEngineOrderLogic(ctx, s.svcCtx)
+	return l.GetEngineOrder(in)
+}
+
+// 运维接口 获取撮合引擎的状态
+func (s *MatchServiceServer) GetEngineStats(ctx context.Context, in *pb.GetEngineStatsReq) (*pb.GetEngineStatsResp, error) {
+	l := logic.NewGetEngineStatsLogic(ctx, s.svcCtx)
+	return l.GetEngineStats(in)
+}
+
+// 运维接口 暂停或者恢复交易 暂停期间只接受撤单
+func (s *MatchServiceServer) HaltTrading(ctx context.Context, in *pb.HaltTradingReq) (*pb.HaltTradingResp, error) {
+	l := logic.NewHaltTradingLogic(ctx, s.svcCtx)
+	return l.HaltTrading(in)
+}
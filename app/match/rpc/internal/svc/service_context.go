@@ -4,33 +4,29 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/luxun9527/gex/app/match/rpc/internal/config"
 	"github.com/luxun9527/gex/app/match/rpc/internal/dao/query"
-	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
 	"github.com/luxun9527/gex/app/order/rpc/orderservice"
 	"github.com/luxun9527/gex/common/pkg/etcd"
-	pulsarConfig "github.com/luxun9527/gex/common/pkg/pulsar"
-	"github.com/luxun9527/gex/common/proto/define"
 	ws "github.com/luxun9527/gpush/proto"
 	logger "github.com/luxun9527/zlog"
 	"github.com/spf13/cast"
-	"github.com/yitter/idgenerator-go/idgen"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
 	"go.etcd.io/etcd/client/v3/naming/resolver"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/attributes"
 	"strings"
-	"time"
+	"sync"
 )
 
 type ServiceContext struct {
-	MatchConsumer      pulsar.Consumer
-	Config             *config.Config
-	MatchEngine        *engine.MatchEngine
-	OrderClient        orderservice.OrderService
-	Query              *query.Query
-	RedisClient        *redis.Redis
-	InitOrderPrimaryID int64
+	Config       *config.Config
+	PulsarClient pulsar.Client
+	WsClient     ws.ProxyClient
+	OrderClient  orderservice.OrderService
+	Query        *query.Query
+	RedisClient  *redis.Redis
+	//运行中的交易对 交易对名称 -> *SymbolContext
+	symbols sync.Map
 }
 
 func NewServiceContext(c *config.Config) *ServiceContext {
@@ -38,48 +34,14 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 	logx.SetWriter(logger.NewZapWriter(logger.GetZapLogger()))
 	logx.DisableStat()
 
-	var symbolInfo define.SymbolInfo
-	define.InitSymbolConfig(define.EtcdSymbolPrefix+c.Symbol, c.SymbolEtcdConfig, &symbolInfo)
-	logx.Infof("init symbol config symbolInfo %+v", &symbolInfo)
-	c.SymbolInfo = &symbolInfo
-
-	//注册到etcd
+	//每个交易对单独注册到etcd，key和元数据在交易对启动的时候设置
 	d := strings.Split(c.RpcServerConf.ListenOn, ":")
-	c.EtcdRegisterConf.Key += "/" + c.Symbol
 	c.EtcdRegisterConf.Port = cast.ToInt32(d[1])
-	c.EtcdRegisterConf.MetaData = attributes.New("symbol", c.Symbol)
-	etcd.Register(c.EtcdRegisterConf)
 
 	client, err := c.PulsarConfig.BuildClient()
 	if err != nil {
 		logx.Severef("init pulsar client failed err %v", err)
 	}
-	topic := pulsarConfig.Topic{
-		Tenant:    pulsarConfig.PublicTenant,  // public
-		Namespace: pulsarConfig.GexNamespace,  // trade
-		Topic:     pulsarConfig.MatchResultTopic + "_" + c.Symbol, // match_result_IKUN_USDT
-	}
-	producer, err := client.CreateProducer(pulsar.ProducerOptions{
-		Topic:           topic.BuildTopic(),
-		SendTimeout:     10 * time.Second,
-		DisableBatching: true, // 禁用批处理
-	})
-	if err != nil {
-		logx.Severef("init pulsar producer failed %v", logger.ErrorField(err))
-	}
-	topic = pulsarConfig.Topic{
-		Tenant:    pulsarConfig.PublicTenant,
-		Namespace: pulsarConfig.GexNamespace,
-		Topic:     pulsarConfig.MatchSourceTopic + "_" + c.Symbol,
-	}
-	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
-		Topic:            topic.BuildTopic(),
-		SubscriptionName: pulsarConfig.MatchSourceSub,
-		Type:             pulsar.Shared,
-	})
-	if err != nil {
-		logx.Severef("init pulsar consumer failed err = %v", err)
-	}
 	//自定义负载均衡策略
 	var clientOpts []zrpc.ClientOption
 	serviceConfig := grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"symbol_lb"}`)
@@ -95,16 +57,36 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 
 	clientOpts = append(clientOpts, zrpc.WithDialOption(r), zrpc.WithDialOption(serviceConfig))
 
-	//使用交易对的Id作为workid
-	var options = idgen.NewIdGeneratorOptions(uint16(c.SymbolInfo.SymbolID) % 64)
-	idgen.SetIdGenerator(options)
 	sc := &ServiceContext{
-		MatchConsumer: consumer,
-		Config:        c,
-		OrderClient:   orderservice.NewOrderService(zrpc.MustNewClient(c.OrderRpcConf, clientOpts...)),
-		MatchEngine:   engine.NewMatchEngine(c, producer, ws.NewProxyClient(zrpc.MustNewClient(c.WsConf).Conn())),
-		Query:         query.Use(c.GormConf.MustNewGormClient()),
-		RedisClient:   redis.MustNewRedis(c.RedisConf),
+		Config:       c,
+		PulsarClient: client,
+		WsClient:     ws.NewProxyClient(zrpc.MustNewClient(c.WsConf).Conn()),
+		OrderClient:  orderservice.NewOrderService(zrpc.MustNewClient(c.OrderRpcConf, clientOpts...)),
+		Query:        query.Use(c.GormConf.MustNewGormClient()),
+		RedisClient:  redis.MustNewRedis(c.RedisConf),
 	}
 	return sc
 }
+
+// GetSymbolContext 获取运行中的交易对
+func (sc *ServiceContext) GetSymbolContext(symbol string) (*SymbolContext, bool) {
+	s, ok := sc.symbols.Load(symbol)
+	if !ok {
+		return nil, false
+	}
+	return s.(*SymbolContext), true
+}
+
+// StoreSymbolContext 交易对启动完成之后保存，之后才能查询到这个交易对
+func (sc *ServiceContext) StoreSymbolContext(s *SymbolContext) {
+	sc.symbols.Store(s.Config.Symbol, s)
+}
+
+// DeleteSymbolContext 交易对下线
+func (sc *ServiceContext) DeleteSymbolContext(symbol string) (*SymbolContext, bool) {
+	s, ok := sc.symbols.LoadAndDelete(symbol)
+	if !ok {
+		return nil, false
+	}
+	return s.(*SymbolContext), true
+}
@@ -0,0 +1,184 @@
+package svc
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/app/match/rpc/internal/config"
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	pulsarConfig "github.com/luxun9527/gex/common/pkg/pulsar"
+	"github.com/luxun9527/gex/common/proto/define"
+	"sync"
+	"time"
+)
+
+// SymbolContext 一个交易对的撮合引擎和撮合消息的生产者、消费者
+type SymbolContext struct {
+	//交易对的配置，Symbol和SymbolInfo是这个交易对的
+	Config        *config.Config
+	MatchEngine   *engine.MatchEngine
+	MatchConsumer pulsar.Consumer
+	MatchProducer pulsar.Producer
+	//发送定时消息到match_source，只有主节点发送
+	SourceProducer pulsar.Producer
+	//加载订单时最大的订单id，小于这个id的新订单已经加载过
+	InitOrderPrimaryID int64
+	//从快照恢复时快照记录的最后一条消息的id
+	SnapshotMessageID pulsar.MessageID
+	//撮合引擎不是并发安全的，处理消息的协程修改订单簿时加锁，运维接口查询订单簿时加锁
+	EngineLock sync.Mutex
+	//主备部署时的选主状态，没有开启主备为nil
+	Standby *Standby
+	//交易对下线时取消，停止消费消息和注册
+	Ctx    context.Context
+	cancel context.CancelFunc
+	//关闭完成之后关闭，同一个交易对重启之前等待，主备部署时match_source的订阅是独占的
+	closed chan struct{}
+	//撮合引擎的时间，处理消息之前推进到消息的发送时间，主备节点和重放使用相同的时间撮合
+	clock time.Time
+}
+
+// Standby 主备部署时交易对的选主状态
+type Standby struct {
+	Sink *engine.StandbySink
+	//从最后一条消息开始跟随match_result，记录主节点已经发送的撮合结果
+	ResultReader pulsar.Reader
+	//选主成功之后创建从当前最后一条消息开始的reader，启动之后主节点没有发送过撮合结果时也能得到主节点最后的序号
+	NewLatestReader func() (pulsar.Reader, error)
+	//选主成功之后取消，备节点接收消息时使用，没有新的消息也能及时切换为主节点
+	FollowCtx context.Context
+	elect     context.CancelFunc
+}
+
+// Elect 选主成功，消费消息的协程切换为主节点
+func (s *Standby) Elect() {
+	s.elect()
+}
+
+// NewSymbolContext 创建交易对的撮合引擎，订阅这个交易对的match_source，撮合结果发送到match_result
+func (sc *ServiceContext) NewSymbolContext(symbolInfo *define.SymbolInfo) (*SymbolContext, error) {
+	c := *sc.Config
+	c.Symbol = symbolInfo.SymbolName
+	c.SymbolInfo = symbolInfo
+
+	topic := pulsarConfig.Topic{
+		Tenant:    pulsarConfig.PublicTenant,                                   // public
+		Namespace: pulsarConfig.GexNamespace,                                   // trade
+		Topic:     pulsarConfig.MatchResultTopic + "_" + symbolInfo.SymbolName, // match_result_IKUN_USDT
+	}
+	producer, err := sc.PulsarClient.CreateProducer(pulsar.ProducerOptions{
+		Topic:           topic.BuildTopic(),
+		SendTimeout:     10 * time.Second,
+		DisableBatching: true, // 禁用批处理
+	})
+	if err != nil {
+		return nil, err
+	}
+	resultTopic := topic.BuildTopic()
+	topic = pulsarConfig.Topic{
+		Tenant:    pulsarConfig.PublicTenant,
+		Namespace: pulsarConfig.GexNamespace,
+		Topic:     pulsarConfig.MatchSourceTopic + "_" + symbolInfo.SymbolName,
+	}
+	consumerOptions := pulsar.ConsumerOptions{
+		Topic:            topic.BuildTopic(),
+		SubscriptionName: pulsarConfig.MatchSourceSub,
+		Type:             pulsar.Shared,
+	}
+	//主备的每个实例都要消费所有的消息，使用各自的订阅
+	if c.StandbyConf.Enable {
+		consumerOptions.SubscriptionName = pulsarConfig.MatchSourceSub + "_" + c.StandbyConf.Name
+		consumerOptions.Type = pulsar.Exclusive
+	}
+	sourceProducer, err := sc.PulsarClient.CreateProducer(pulsar.ProducerOptions{
+		Topic:           consumerOptions.Topic,
+		SendTimeout:     10 * time.Second,
+		DisableBatching: true,
+	})
+	if err != nil {
+		producer.Close()
+		return nil, err
+	}
+	consumer, err := sc.PulsarClient.Subscribe(consumerOptions)
+	if err != nil {
+		sourceProducer.Close()
+		producer.Close()
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &SymbolContext{
+		Config:         &c,
+		MatchConsumer:  consumer,
+		MatchProducer:  producer,
+		SourceProducer: sourceProducer,
+		Ctx:            ctx,
+		cancel:         cancel,
+		closed:         make(chan struct{}),
+		//收到第一个消息之前使用启动的时间，用于加载订单时撤销已经过期的订单
+		clock: time.Now(),
+	}
+	resultSink, marketDataSink := engine.ResultSink(engine.NewPulsarResultSink(producer)), engine.MarketDataSink(engine.NewGpushMarketDataSink(sc.WsClient))
+	if c.StandbyConf.Enable {
+		readerOptions := pulsar.ReaderOptions{
+			Topic:                   resultTopic,
+			StartMessageID:          pulsar.LatestMessageID(),
+			StartMessageIDInclusive: true,
+		}
+		reader, err := sc.PulsarClient.CreateReader(readerOptions)
+		if err != nil {
+			cancel()
+			consumer.Close()
+			sourceProducer.Close()
+			producer.Close()
+			return nil, err
+		}
+		sink := engine.NewStandbySink(symbolInfo.SymbolName, resultSink, marketDataSink)
+		followCtx, elect := context.WithCancel(ctx)
+		s.Standby = &Standby{
+			Sink:         sink,
+			ResultReader: reader,
+			NewLatestReader: func() (pulsar.Reader, error) {
+				return sc.PulsarClient.CreateReader(readerOptions)
+			},
+			FollowCtx: followCtx,
+			elect:     elect,
+		}
+		resultSink, marketDataSink = sink, sink
+	}
+	s.MatchEngine = engine.NewMatchEngine(&c, resultSink, marketDataSink, engine.WithClock(s.Clock))
+	return s, nil
+}
+
+// Clock 撮合引擎的时间
+func (s *SymbolContext) Clock() time.Time {
+	return s.clock
+}
+
+// AdvanceClock 推进撮合引擎的时间，消息的发送时间可能比之前的消息早，时间只增不减。
+// 和撮合引擎在同一个协程中调用。
+func (s *SymbolContext) AdvanceClock(t time.Time) {
+	if t.After(s.clock) {
+		s.clock = t
+	}
+}
+
+// Stop 交易对下线，停止消费消息，正在处理的消息处理完之后关闭
+func (s *SymbolContext) Stop() {
+	s.cancel()
+}
+
+// Close 关闭撮合引擎和消息的生产者、消费者，在消费消息的协程退出时调用
+func (s *SymbolContext) Close() {
+	s.MatchConsumer.Close()
+	s.MatchEngine.Close()
+	s.MatchProducer.Close()
+	s.SourceProducer.Close()
+	if s.Standby != nil {
+		s.Standby.ResultReader.Close()
+	}
+	close(s.closed)
+}
+
+// Closed 关闭完成之后返回
+func (s *SymbolContext) Closed() <-chan struct{} {
+	return s.closed
+}
@@ -13,15 +13,27 @@ import (
 )
 
 type (
-	GetDepthReq           = pb.GetDepthReq
-	GetDepthResp          = pb.GetDepthResp
-	GetDepthResp_Position = pb.GetDepthResp_Position
-	GetTickReq            = pb.GetTickReq
-	GetTickResp           = pb.GetTickResp
-	GetTickResp_Tick      = pb.GetTickResp_Tick
-	GetTickerReq          = pb.GetTickerReq
-	GetTickerResp         = pb.GetTickerResp
-	GetTickerResp_Ticker  = pb.GetTickerResp_Ticker
+	EngineOrder             = pb.EngineOrder
+	GetDepthReq             = pb.GetDepthReq
+	GetDepthResp            = pb.GetDepthResp
+	GetDepthResp_Position   = pb.GetDepthResp_Position
+	GetEngineOrderReq       = pb.GetEngineOrderReq
+	GetEngineOrderResp      = pb.GetEngineOrderResp
+	GetEngineStatsReq       = pb.GetEngineStatsReq
+	GetEngineStatsResp      = pb.GetEngineStatsResp
+	GetL3SnapshotReq        = pb.GetL3SnapshotReq
+	GetL3SnapshotResp       = pb.GetL3SnapshotResp
+	GetL3SnapshotResp_Order = pb.GetL3SnapshotResp_Order
+	GetOrderBookReq         = pb.GetOrderBookReq
+	GetOrderBookResp        = pb.GetOrderBookResp
+	GetTickReq              = pb.GetTickReq
+	GetTickResp             = pb.GetTickResp
+	GetTickResp_Tick        = pb.GetTickResp_Tick
+	GetTickerReq            = pb.GetTickerReq
+	GetTickerResp           = pb.GetTickerResp
+	GetTickerResp_Ticker    = pb.GetTickerResp_Ticker
+	HaltTradingReq          = pb.HaltTradingReq
+	HaltTradingResp         = pb.HaltTradingResp
 
 	MatchService interface {
 		// 获取深度
@@ -30,6 +42,16 @@ type (
 		GetTick(ctx context.Context, in *GetTickReq, opts ...grpc.CallOption) (*GetTickResp, error)
 		// 获取ticker
 		GetTicker(ctx context.Context, in *GetTickerReq, opts ...grpc.CallOption) (*GetTickerResp, error)
+		// 获取逐笔委托的快照
+		GetL3Snapshot(ctx context.Context, in *GetL3SnapshotReq, opts ...grpc.CallOption) (*GetL3SnapshotResp, error)
+		// 运维接口 获取订单簿中所有的订单
+		GetOrderBook(ctx context.Context, in *GetOrderBookReq, opts ...grpc.CallOption) (*GetOrderBookResp, error)
+		// 运维接口 获取撮合引擎中的订单
+		GetEngineOrder(ctx context.Context, in *GetEngineOrderReq, opts ...grpc.CallOption) (*GetEngineOrderResp, error)
+		// 运维接口 获取撮合引擎的状态
+		GetEngineStats(ctx context.Context, in *GetEngineStatsReq, opts ...grpc.CallOption) (*GetEngineStatsResp, error)
+		// 运维接口 暂停或者恢复交易 暂停期间只接受撤单
+		HaltTrading(ctx context.Context, in *HaltTradingReq, opts ...grpc.CallOption) (*HaltTradingResp, error)
 	}
 
 	defaultMatchService struct {
@@ -60,3 +82,33 @@ func (m *defaultMatchService) GetTicker(ctx context.Context, in *GetTickerReq, o
 	client := pb.NewMatchServiceClient(m.cli.Conn())
 	return client.GetTicker(ctx, in, opts...)
 }
+
+// 获取逐笔委托的快照
+func (m *defaultMatchService) GetL3Snapshot(ctx context.Context, in *GetL3SnapshotReq, opts ...grpc.CallOption) (*GetL3SnapshotResp, error) {
+	client := pb.NewMatchServiceClient(m.cli.Conn())
+	return client.GetL3Snapshot(ctx, in, opts...)
+}
+
+// 运维接口 获取订单簿中所有的订单
+func (m *defaultMatchService) GetOrderBook(ctx context.Context, in *GetOrderBookReq, opts ...grpc.CallOption) (*GetOrderBookResp, error) {
+	client := pb.NewMatchServiceClient(m.cli.Conn())
+	return client.GetOrderBook(ctx, in, opts...)
+}
+
+// 运维接口 获取撮合引擎中的订单
+func (m *defaultMatchService) GetEngineOrder(ctx context.Context, in *GetEngineOrderReq, opts ...grpc.CallOption) (*GetEngineOrderResp, error) {
+	client := pb.NewMatchServiceClient(m.cli.Conn())
+	return client.GetEngineOrder(ctx, in, opts...)
+}
+
+// 运维接口 获取撮合引擎的状态
+func (m *defaultMatchService) GetEngineStats(ctx context.Context, in *GetEngineStatsReq, opts ...grpc.CallOption) (*GetEngineStatsResp, error) {
+	client := pb.NewMatchServiceClient(m.cli.Conn())
+	return client.GetEngineStats(ctx, in, opts...)
+}
+
+// 运维接口 暂停或者恢复交易 暂停期间只接受撤单
+func (m *defaultMatchService) HaltTrading(ctx context.Context, in *HaltTradingReq, opts ...grpc.CallOption) (*HaltTradingResp, error) {
+	client := pb.NewMatchServiceClient(m.cli.Conn())
+	return client.HaltTrading(ctx, in, opts...)
+}
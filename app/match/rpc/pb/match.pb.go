@@ -7,6 +7,7 @@
 package pb
 
 import (
+	enum "github.com/luxun9527/gex/common/proto/enum"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -29,6 +30,8 @@ type GetDepthReq struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	// 档位
 	Level int32 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	// 合并深度的档位 例如0.1 为空则不合并
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
 }
 
 func (x *GetDepthReq) Reset() {
@@ -77,6 +80,13 @@ func (x *GetDepthReq) GetLevel() int32 {
 	return 0
 }
 
+func (x *GetDepthReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
 type GetDepthResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,6 +98,8 @@ type GetDepthResp struct {
 	Asks []*GetDepthResp_Position `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
 	// 买盘
 	Bids []*GetDepthResp_Position `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	// 前25档的CRC32校验和，和推送的深度使用同一个算法
+	Checksum uint32 `protobuf:"varint,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (x *GetDepthResp) Reset() {
@@ -143,6 +155,13 @@ func (x *GetDepthResp) GetBids() []*GetDepthResp_Position {
 	return nil
 }
 
+func (x *GetDepthResp) GetChecksum() uint32 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
 type GetTickReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -208,71 +227,996 @@ type GetTickResp struct {
 	TickList []*GetTickResp_Tick `protobuf:"bytes,1,rep,name=tick_list,json=tickList,proto3" json:"tick_list,omitempty"`
 }
 
-func (x *GetTickResp) Reset() {
-	*x = GetTickResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTickResp) Reset() {
+	*x = GetTickResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickResp) ProtoMessage() {}
+
+func (x *GetTickResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickResp.ProtoReflect.Descriptor instead.
+func (*GetTickResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTickResp) GetTickList() []*GetTickResp_Tick {
+	if x != nil {
+		return x.TickList
+	}
+	return nil
+}
+
+type GetTickerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetTickerReq) Reset() {
+	*x = GetTickerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerReq) ProtoMessage() {}
+
+func (x *GetTickerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerReq.ProtoReflect.Descriptor instead.
+func (*GetTickerReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTickerReq) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetTickerResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TickerList []*GetTickerResp_Ticker `protobuf:"bytes,1,rep,name=ticker_list,json=tickerList,proto3" json:"ticker_list,omitempty"`
+}
+
+func (x *GetTickerResp) Reset() {
+	*x = GetTickerResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickerResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerResp) ProtoMessage() {}
+
+func (x *GetTickerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerResp.ProtoReflect.Descriptor instead.
+func (*GetTickerResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTickerResp) GetTickerList() []*GetTickerResp_Ticker {
+	if x != nil {
+		return x.TickerList
+	}
+	return nil
+}
+
+type GetL3SnapshotReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetL3SnapshotReq) Reset() {
+	*x = GetL3SnapshotReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetL3SnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetL3SnapshotReq) ProtoMessage() {}
+
+func (x *GetL3SnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetL3SnapshotReq.ProtoReflect.Descriptor instead.
+func (*GetL3SnapshotReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetL3SnapshotReq) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetL3SnapshotResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单簿版本
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// 逐笔委托的序号,只需要处理序号大于快照的逐笔委托
+	Seq int64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// 卖盘 按照价格和排队的序号排序
+	Asks []*GetL3SnapshotResp_Order `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	// 买盘 按照价格和排队的序号排序
+	Bids []*GetL3SnapshotResp_Order `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids,omitempty"`
+}
+
+func (x *GetL3SnapshotResp) Reset() {
+	*x = GetL3SnapshotResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetL3SnapshotResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetL3SnapshotResp) ProtoMessage() {}
+
+func (x *GetL3SnapshotResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetL3SnapshotResp.ProtoReflect.Descriptor instead.
+func (*GetL3SnapshotResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetL3SnapshotResp) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetL3SnapshotResp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetL3SnapshotResp) GetAsks() []*GetL3SnapshotResp_Order {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *GetL3SnapshotResp) GetBids() []*GetL3SnapshotResp_Order {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+// 撮合引擎中的订单
+type EngineOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 主键id
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 订单号
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 用户id
+	Uid int64 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 方向
+	Side enum.Side `protobuf:"varint,4,opt,name=side,proto3,enum=commonEnum.Side" json:"side,omitempty"`
+	// 订单类型
+	OrderType enum.OrderType `protobuf:"varint,5,opt,name=order_type,json=orderType,proto3,enum=commonEnum.OrderType" json:"order_type,omitempty"`
+	// 订单状态
+	OrderStatus enum.OrderStatus `protobuf:"varint,6,opt,name=order_status,json=orderStatus,proto3,enum=commonEnum.OrderStatus" json:"order_status,omitempty"`
+	// 价格
+	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// 数量 市价买单为零
+	Qty string `protobuf:"bytes,8,opt,name=qty,proto3" json:"qty,omitempty"`
+	// 金额
+	Amount string `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 已成交数量
+	FilledQty string `protobuf:"bytes,10,opt,name=filled_qty,json=filledQty,proto3" json:"filled_qty,omitempty"`
+	// 已成交金额
+	FilledAmount string `protobuf:"bytes,11,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	// 未成交数量
+	UnfilledQty string `protobuf:"bytes,12,opt,name=unfilled_qty,json=unfilledQty,proto3" json:"unfilled_qty,omitempty"`
+	// 未成交金额
+	UnfilledAmount string `protobuf:"bytes,13,opt,name=unfilled_amount,json=unfilledAmount,proto3" json:"unfilled_amount,omitempty"`
+	// 累计的手续费 买单为基础币 卖单为计价币
+	Fee string `protobuf:"bytes,14,opt,name=fee,proto3" json:"fee,omitempty"`
+	// 触发价格 条件单才有
+	TriggerPrice string `protobuf:"bytes,15,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// 条件单触发状态
+	TriggerStatus enum.TriggerStatus `protobuf:"varint,16,opt,name=trigger_status,json=triggerStatus,proto3,enum=commonEnum.TriggerStatus" json:"trigger_status,omitempty"`
+	// 冰山单每次显示的数量 为零不是冰山单
+	DisplayQty string `protobuf:"bytes,17,opt,name=display_qty,json=displayQty,proto3" json:"display_qty,omitempty"`
+	// 冰山单当前显示的剩余数量
+	VisibleQty string `protobuf:"bytes,18,opt,name=visible_qty,json=visibleQty,proto3" json:"visible_qty,omitempty"`
+	// 是否只做maker
+	PostOnly bool `protobuf:"varint,19,opt,name=post_only,json=postOnly,proto3" json:"post_only,omitempty"`
+	// 自成交保护模式
+	StpMode enum.STPMode `protobuf:"varint,20,opt,name=stp_mode,json=stpMode,proto3,enum=commonEnum.STPMode" json:"stp_mode,omitempty"`
+	// 过期时间 单位秒 为零一直有效
+	ExpireTime int64 `protobuf:"varint,21,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// 修改订单之后重新排队的序号 为零按照订单id排队
+	QueueId int64 `protobuf:"varint,22,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+}
+
+func (x *EngineOrder) Reset() {
+	*x = EngineOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineOrder) ProtoMessage() {}
+
+func (x *EngineOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineOrder.ProtoReflect.Descriptor instead.
+func (*EngineOrder) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EngineOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EngineOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *EngineOrder) GetSide() enum.Side {
+	if x != nil {
+		return x.Side
+	}
+	return enum.Side(0)
+}
+
+func (x *EngineOrder) GetOrderType() enum.OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return enum.OrderType(0)
+}
+
+func (x *EngineOrder) GetOrderStatus() enum.OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return enum.OrderStatus(0)
+}
+
+func (x *EngineOrder) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetQty() string {
+	if x != nil {
+		return x.Qty
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetFilledQty() string {
+	if x != nil {
+		return x.FilledQty
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetFilledAmount() string {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetUnfilledQty() string {
+	if x != nil {
+		return x.UnfilledQty
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetUnfilledAmount() string {
+	if x != nil {
+		return x.UnfilledAmount
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetTriggerStatus() enum.TriggerStatus {
+	if x != nil {
+		return x.TriggerStatus
+	}
+	return enum.TriggerStatus(0)
+}
+
+func (x *EngineOrder) GetDisplayQty() string {
+	if x != nil {
+		return x.DisplayQty
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetVisibleQty() string {
+	if x != nil {
+		return x.VisibleQty
+	}
+	return ""
+}
+
+func (x *EngineOrder) GetPostOnly() bool {
+	if x != nil {
+		return x.PostOnly
+	}
+	return false
+}
+
+func (x *EngineOrder) GetStpMode() enum.STPMode {
+	if x != nil {
+		return x.StpMode
+	}
+	return enum.STPMode(0)
+}
+
+func (x *EngineOrder) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *EngineOrder) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+type GetOrderBookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetOrderBookReq) Reset() {
+	*x = GetOrderBookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderBookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookReq) ProtoMessage() {}
+
+func (x *GetOrderBookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookReq.ProtoReflect.Descriptor instead.
+func (*GetOrderBookReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrderBookReq) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetOrderBookResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单簿版本
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// 卖盘 按照价格和排队的顺序排序
+	Asks []*EngineOrder `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	// 买盘 按照价格和排队的顺序排序
+	Bids []*EngineOrder `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	// 未触发的条件单
+	Triggers []*EngineOrder `protobuf:"bytes,4,rep,name=triggers,proto3" json:"triggers,omitempty"`
+}
+
+func (x *GetOrderBookResp) Reset() {
+	*x = GetOrderBookResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderBookResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookResp) ProtoMessage() {}
+
+func (x *GetOrderBookResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookResp.ProtoReflect.Descriptor instead.
+func (*GetOrderBookResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOrderBookResp) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetOrderBookResp) GetAsks() []*EngineOrder {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *GetOrderBookResp) GetBids() []*EngineOrder {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *GetOrderBookResp) GetTriggers() []*EngineOrder {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type GetEngineOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// 主键id
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEngineOrderReq) Reset() {
+	*x = GetEngineOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEngineOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineOrderReq) ProtoMessage() {}
+
+func (x *GetEngineOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineOrderReq.ProtoReflect.Descriptor instead.
+func (*GetEngineOrderReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEngineOrderReq) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetEngineOrderReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEngineOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *EngineOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *GetEngineOrderResp) Reset() {
+	*x = GetEngineOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEngineOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineOrderResp) ProtoMessage() {}
+
+func (x *GetEngineOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineOrderResp.ProtoReflect.Descriptor instead.
+func (*GetEngineOrderResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEngineOrderResp) GetOrder() *EngineOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetEngineStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetEngineStatsReq) Reset() {
+	*x = GetEngineStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEngineStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineStatsReq) ProtoMessage() {}
+
+func (x *GetEngineStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineStatsReq.ProtoReflect.Descriptor instead.
+func (*GetEngineStatsReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEngineStatsReq) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetEngineStatsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单簿版本
+	CurrentSeqId int64 `protobuf:"varint,1,opt,name=current_seq_id,json=currentSeqId,proto3" json:"current_seq_id,omitempty"`
+	// 撮合结果的序号
+	ResultSeq int64 `protobuf:"varint,2,opt,name=result_seq,json=resultSeq,proto3" json:"result_seq,omitempty"`
+	// 卖盘订单数量
+	AskOrders int64 `protobuf:"varint,3,opt,name=ask_orders,json=askOrders,proto3" json:"ask_orders,omitempty"`
+	// 买盘订单数量
+	BidOrders int64 `protobuf:"varint,4,opt,name=bid_orders,json=bidOrders,proto3" json:"bid_orders,omitempty"`
+	// 未触发的条件单数量
+	TriggerOrders int64 `protobuf:"varint,5,opt,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders,omitempty"`
+	// 卖盘档位数量
+	AskLevels int64 `protobuf:"varint,6,opt,name=ask_levels,json=askLevels,proto3" json:"ask_levels,omitempty"`
+	// 买盘档位数量
+	BidLevels int64 `protobuf:"varint,7,opt,name=bid_levels,json=bidLevels,proto3" json:"bid_levels,omitempty"`
+	// 买一价
+	BestBid string `protobuf:"bytes,8,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	// 卖一价
+	BestAsk string `protobuf:"bytes,9,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	// 最新成交价
+	LastPrice string `protobuf:"bytes,10,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// 是否人工暂停交易
+	Halted bool `protobuf:"varint,11,opt,name=halted,proto3" json:"halted,omitempty"`
+	// 熔断恢复交易的时间 单位纳秒 为零表示没有熔断
+	HaltUntil int64 `protobuf:"varint,12,opt,name=halt_until,json=haltUntil,proto3" json:"halt_until,omitempty"`
+	// 集合竞价结束的时间 单位纳秒 为零表示不在集合竞价
+	AuctionUntil int64 `protobuf:"varint,13,opt,name=auction_until,json=auctionUntil,proto3" json:"auction_until,omitempty"`
+	// 等待推送的成交
+	TickQueue int64 `protobuf:"varint,14,opt,name=tick_queue,json=tickQueue,proto3" json:"tick_queue,omitempty"`
+	// 等待更新的深度
+	DepthQueue int64 `protobuf:"varint,15,opt,name=depth_queue,json=depthQueue,proto3" json:"depth_queue,omitempty"`
+	// 等待推送的深度
+	DepthPushQueue int64 `protobuf:"varint,16,opt,name=depth_push_queue,json=depthPushQueue,proto3" json:"depth_push_queue,omitempty"`
+	// 主备部署时是否是主节点 没有开启主备为true
+	Leader bool `protobuf:"varint,17,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (x *GetEngineStatsResp) Reset() {
+	*x = GetEngineStatsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEngineStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineStatsResp) ProtoMessage() {}
+
+func (x *GetEngineStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineStatsResp.ProtoReflect.Descriptor instead.
+func (*GetEngineStatsResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetEngineStatsResp) GetCurrentSeqId() int64 {
+	if x != nil {
+		return x.CurrentSeqId
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetResultSeq() int64 {
+	if x != nil {
+		return x.ResultSeq
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetAskOrders() int64 {
+	if x != nil {
+		return x.AskOrders
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetBidOrders() int64 {
+	if x != nil {
+		return x.BidOrders
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetTriggerOrders() int64 {
+	if x != nil {
+		return x.TriggerOrders
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetAskLevels() int64 {
+	if x != nil {
+		return x.AskLevels
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetBidLevels() int64 {
+	if x != nil {
+		return x.BidLevels
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetBestBid() string {
+	if x != nil {
+		return x.BestBid
+	}
+	return ""
+}
+
+func (x *GetEngineStatsResp) GetBestAsk() string {
+	if x != nil {
+		return x.BestAsk
+	}
+	return ""
+}
+
+func (x *GetEngineStatsResp) GetLastPrice() string {
+	if x != nil {
+		return x.LastPrice
+	}
+	return ""
+}
+
+func (x *GetEngineStatsResp) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *GetEngineStatsResp) GetHaltUntil() int64 {
+	if x != nil {
+		return x.HaltUntil
+	}
+	return 0
+}
+
+func (x *GetEngineStatsResp) GetAuctionUntil() int64 {
+	if x != nil {
+		return x.AuctionUntil
 	}
+	return 0
 }
 
-func (x *GetTickResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetEngineStatsResp) GetTickQueue() int64 {
+	if x != nil {
+		return x.TickQueue
+	}
+	return 0
 }
 
-func (*GetTickResp) ProtoMessage() {}
-
-func (x *GetTickResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetEngineStatsResp) GetDepthQueue() int64 {
+	if x != nil {
+		return x.DepthQueue
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTickResp.ProtoReflect.Descriptor instead.
-func (*GetTickResp) Descriptor() ([]byte, []int) {
-	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{3}
+func (x *GetEngineStatsResp) GetDepthPushQueue() int64 {
+	if x != nil {
+		return x.DepthPushQueue
+	}
+	return 0
 }
 
-func (x *GetTickResp) GetTickList() []*GetTickResp_Tick {
+func (x *GetEngineStatsResp) GetLeader() bool {
 	if x != nil {
-		return x.TickList
+		return x.Leader
 	}
-	return nil
+	return false
 }
 
-type GetTickerReq struct {
+type HaltTradingReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 交易对
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// true暂停交易 false恢复交易
+	Halted bool `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
-func (x *GetTickerReq) Reset() {
-	*x = GetTickerReq{}
+func (x *HaltTradingReq) Reset() {
+	*x = HaltTradingReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[4]
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTickerReq) String() string {
+func (x *HaltTradingReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerReq) ProtoMessage() {}
+func (*HaltTradingReq) ProtoMessage() {}
 
-func (x *GetTickerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[4]
+func (x *HaltTradingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,43 +1227,48 @@ func (x *GetTickerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerReq.ProtoReflect.Descriptor instead.
-func (*GetTickerReq) Descriptor() ([]byte, []int) {
-	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use HaltTradingReq.ProtoReflect.Descriptor instead.
+func (*HaltTradingReq) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetTickerReq) GetSymbol() string {
+func (x *HaltTradingReq) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-type GetTickerResp struct {
+func (x *HaltTradingReq) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+type HaltTradingResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TickerList []*GetTickerResp_Ticker `protobuf:"bytes,1,rep,name=ticker_list,json=tickerList,proto3" json:"ticker_list,omitempty"`
 }
 
-func (x *GetTickerResp) Reset() {
-	*x = GetTickerResp{}
+func (x *HaltTradingResp) Reset() {
+	*x = HaltTradingResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[5]
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTickerResp) String() string {
+func (x *HaltTradingResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerResp) ProtoMessage() {}
+func (*HaltTradingResp) ProtoMessage() {}
 
-func (x *GetTickerResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[5]
+func (x *HaltTradingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,16 +1279,9 @@ func (x *GetTickerResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerResp.ProtoReflect.Descriptor instead.
-func (*GetTickerResp) Descriptor() ([]byte, []int) {
-	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetTickerResp) GetTickerList() []*GetTickerResp_Ticker {
-	if x != nil {
-		return x.TickerList
-	}
-	return nil
+// Deprecated: Use HaltTradingResp.ProtoReflect.Descriptor instead.
+func (*HaltTradingResp) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{16}
 }
 
 type GetDepthResp_Position struct {
@@ -358,7 +1300,7 @@ type GetDepthResp_Position struct {
 func (x *GetDepthResp_Position) Reset() {
 	*x = GetDepthResp_Position{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[6]
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -371,7 +1313,7 @@ func (x *GetDepthResp_Position) String() string {
 func (*GetDepthResp_Position) ProtoMessage() {}
 
 func (x *GetDepthResp_Position) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[6]
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +1372,7 @@ type GetTickResp_Tick struct {
 func (x *GetTickResp_Tick) Reset() {
 	*x = GetTickResp_Tick{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[7]
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +1385,7 @@ func (x *GetTickResp_Tick) String() string {
 func (*GetTickResp_Tick) ProtoMessage() {}
 
 func (x *GetTickResp_Tick) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[7]
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +1469,7 @@ type GetTickerResp_Ticker struct {
 func (x *GetTickerResp_Ticker) Reset() {
 	*x = GetTickerResp_Ticker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_match_rpc_pb_match_proto_msgTypes[8]
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +1482,7 @@ func (x *GetTickerResp_Ticker) String() string {
 func (*GetTickerResp_Ticker) ProtoMessage() {}
 
 func (x *GetTickerResp_Ticker) ProtoReflect() protoreflect.Message {
-	mi := &file_app_match_rpc_pb_match_proto_msgTypes[8]
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,81 +1554,313 @@ func (x *GetTickerResp_Ticker) GetSymbol() string {
 	return ""
 }
 
+type GetL3SnapshotResp_Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单id
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 价格
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// 数量 冰山单只有显示的数量
+	Qty string `protobuf:"bytes,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	// 排队的序号 相同价格的订单按照排队的序号排序
+	Queue int64 `protobuf:"varint,4,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (x *GetL3SnapshotResp_Order) Reset() {
+	*x = GetL3SnapshotResp_Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_match_rpc_pb_match_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetL3SnapshotResp_Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetL3SnapshotResp_Order) ProtoMessage() {}
+
+func (x *GetL3SnapshotResp_Order) ProtoReflect() protoreflect.Message {
+	mi := &file_app_match_rpc_pb_match_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetL3SnapshotResp_Order.ProtoReflect.Descriptor instead.
+func (*GetL3SnapshotResp_Order) Descriptor() ([]byte, []int) {
+	return file_app_match_rpc_pb_match_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetL3SnapshotResp_Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetL3SnapshotResp_Order) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *GetL3SnapshotResp_Order) GetQty() string {
+	if x != nil {
+		return x.Qty
+	}
+	return ""
+}
+
+func (x *GetL3SnapshotResp_Order) GetQueue() int64 {
+	if x != nil {
+		return x.Queue
+	}
+	return 0
+}
+
 var File_app_match_rpc_pb_match_proto protoreflect.FileDescriptor
 
 var file_app_match_rpc_pb_match_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x61, 0x70, 0x70, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x72, 0x70, 0x63, 0x2f,
 	0x70, 0x62, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
-	0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x30, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x69, 0x64,
-	0x73, 0x1a, 0x4a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x71, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x69, 0x63,
-	0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x1a,
-	0xa2, 0x01, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x24,
-	0x0a, 0x0e, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x75, 0x79, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x73, 0x42,
-	0x75, 0x79, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x0f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x1a, 0x4a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe8, 0x01, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09,
+	0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x1a, 0xa2, 0x01, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x49, 0x73, 0x42, 0x75, 0x79, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22,
+	0xad, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x1a,
+	0xdd, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6c, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x32, 0x34, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x32, 0x34, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22,
+	0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xfe, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x32, 0x0a,
+	0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x32, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x04, 0x62, 0x69, 0x64, 0x73, 0x1a, 0x55, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0xf6, 0x05, 0x0a,
+	0x0b, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
+	0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x66,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x51, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x70,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x54, 0x50, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x73, 0x74, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x22, 0xac, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12,
+	0x2e, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22,
+	0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65,
 	0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xad, 0x02, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c,
-	0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x52, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xdd, 0x01, 0x0a,
-	0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
-	0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10,
-	0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x77,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x32, 0x34, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x32, 0x34, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x32, 0xad, 0x01, 0x0a,
-	0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x2b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xaf, 0x04, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x6b, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x69, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73,
+	0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
+	0x69, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x62, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74,
+	0x42, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6c, 0x74, 0x5f, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x61, 0x6c, 0x74, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63,
+	0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x74, 0x68, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0e, 0x48,
+	0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x22, 0x11, 0x0a,
+	0x0f, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x32, 0xfe, 0x03, 0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x12, 0x2e,
 	0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x11, 0x2e,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x12, 0x13, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x42, 0x06, 0x5a, 0x04,
-	0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x42, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18,
+	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -701,34 +1875,72 @@ func file_app_match_rpc_pb_match_proto_rawDescGZIP() []byte {
 	return file_app_match_rpc_pb_match_proto_rawDescData
 }
 
-var file_app_match_rpc_pb_match_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_app_match_rpc_pb_match_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_app_match_rpc_pb_match_proto_goTypes = []interface{}{
-	(*GetDepthReq)(nil),           // 0: match.GetDepthReq
-	(*GetDepthResp)(nil),          // 1: match.GetDepthResp
-	(*GetTickReq)(nil),            // 2: match.GetTickReq
-	(*GetTickResp)(nil),           // 3: match.GetTickResp
-	(*GetTickerReq)(nil),          // 4: match.GetTickerReq
-	(*GetTickerResp)(nil),         // 5: match.GetTickerResp
-	(*GetDepthResp_Position)(nil), // 6: match.GetDepthResp.Position
-	(*GetTickResp_Tick)(nil),      // 7: match.GetTickResp.Tick
-	(*GetTickerResp_Ticker)(nil),  // 8: match.GetTickerResp.Ticker
+	(*GetDepthReq)(nil),             // 0: match.GetDepthReq
+	(*GetDepthResp)(nil),            // 1: match.GetDepthResp
+	(*GetTickReq)(nil),              // 2: match.GetTickReq
+	(*GetTickResp)(nil),             // 3: match.GetTickResp
+	(*GetTickerReq)(nil),            // 4: match.GetTickerReq
+	(*GetTickerResp)(nil),           // 5: match.GetTickerResp
+	(*GetL3SnapshotReq)(nil),        // 6: match.GetL3SnapshotReq
+	(*GetL3SnapshotResp)(nil),       // 7: match.GetL3SnapshotResp
+	(*EngineOrder)(nil),             // 8: match.EngineOrder
+	(*GetOrderBookReq)(nil),         // 9: match.GetOrderBookReq
+	(*GetOrderBookResp)(nil),        // 10: match.GetOrderBookResp
+	(*GetEngineOrderReq)(nil),       // 11: match.GetEngineOrderReq
+	(*GetEngineOrderResp)(nil),      // 12: match.GetEngineOrderResp
+	(*GetEngineStatsReq)(nil),       // 13: match.GetEngineStatsReq
+	(*GetEngineStatsResp)(nil),      // 14: match.GetEngineStatsResp
+	(*HaltTradingReq)(nil),          // 15: match.HaltTradingReq
+	(*HaltTradingResp)(nil),         // 16: match.HaltTradingResp
+	(*GetDepthResp_Position)(nil),   // 17: match.GetDepthResp.Position
+	(*GetTickResp_Tick)(nil),        // 18: match.GetTickResp.Tick
+	(*GetTickerResp_Ticker)(nil),    // 19: match.GetTickerResp.Ticker
+	(*GetL3SnapshotResp_Order)(nil), // 20: match.GetL3SnapshotResp.Order
+	(enum.Side)(0),                  // 21: commonEnum.Side
+	(enum.OrderType)(0),             // 22: commonEnum.OrderType
+	(enum.OrderStatus)(0),           // 23: commonEnum.OrderStatus
+	(enum.TriggerStatus)(0),         // 24: commonEnum.TriggerStatus
+	(enum.STPMode)(0),               // 25: commonEnum.STPMode
 }
 var file_app_match_rpc_pb_match_proto_depIdxs = []int32{
-	6, // 0: match.GetDepthResp.asks:type_name -> match.GetDepthResp.Position
-	6, // 1: match.GetDepthResp.bids:type_name -> match.GetDepthResp.Position
-	7, // 2: match.GetTickResp.tick_list:type_name -> match.GetTickResp.Tick
-	8, // 3: match.GetTickerResp.ticker_list:type_name -> match.GetTickerResp.Ticker
-	0, // 4: match.MatchService.GetDepth:input_type -> match.GetDepthReq
-	2, // 5: match.MatchService.GetTick:input_type -> match.GetTickReq
-	4, // 6: match.MatchService.GetTicker:input_type -> match.GetTickerReq
-	1, // 7: match.MatchService.GetDepth:output_type -> match.GetDepthResp
-	3, // 8: match.MatchService.GetTick:output_type -> match.GetTickResp
-	5, // 9: match.MatchService.GetTicker:output_type -> match.GetTickerResp
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	17, // 0: match.GetDepthResp.asks:type_name -> match.GetDepthResp.Position
+	17, // 1: match.GetDepthResp.bids:type_name -> match.GetDepthResp.Position
+	18, // 2: match.GetTickResp.tick_list:type_name -> match.GetTickResp.Tick
+	19, // 3: match.GetTickerResp.ticker_list:type_name -> match.GetTickerResp.Ticker
+	20, // 4: match.GetL3SnapshotResp.asks:type_name -> match.GetL3SnapshotResp.Order
+	20, // 5: match.GetL3SnapshotResp.bids:type_name -> match.GetL3SnapshotResp.Order
+	21, // 6: match.EngineOrder.side:type_name -> commonEnum.Side
+	22, // 7: match.EngineOrder.order_type:type_name -> commonEnum.OrderType
+	23, // 8: match.EngineOrder.order_status:type_name -> commonEnum.OrderStatus
+	24, // 9: match.EngineOrder.trigger_status:type_name -> commonEnum.TriggerStatus
+	25, // 10: match.EngineOrder.stp_mode:type_name -> commonEnum.STPMode
+	8,  // 11: match.GetOrderBookResp.asks:type_name -> match.EngineOrder
+	8,  // 12: match.GetOrderBookResp.bids:type_name -> match.EngineOrder
+	8,  // 13: match.GetOrderBookResp.triggers:type_name -> match.EngineOrder
+	8,  // 14: match.GetEngineOrderResp.order:type_name -> match.EngineOrder
+	0,  // 15: match.MatchService.GetDepth:input_type -> match.GetDepthReq
+	2,  // 16: match.MatchService.GetTick:input_type -> match.GetTickReq
+	4,  // 17: match.MatchService.GetTicker:input_type -> match.GetTickerReq
+	6,  // 18: match.MatchService.GetL3Snapshot:input_type -> match.GetL3SnapshotReq
+	9,  // 19: match.MatchService.GetOrderBook:input_type -> match.GetOrderBookReq
+	11, // 20: match.MatchService.GetEngineOrder:input_type -> match.GetEngineOrderReq
+	13, // 21: match.MatchService.GetEngineStats:input_type -> match.GetEngineStatsReq
+	15, // 22: match.MatchService.HaltTrading:input_type -> match.HaltTradingReq
+	1,  // 23: match.MatchService.GetDepth:output_type -> match.GetDepthResp
+	3,  // 24: match.MatchService.GetTick:output_type -> match.GetTickResp
+	5,  // 25: match.MatchService.GetTicker:output_type -> match.GetTickerResp
+	7,  // 26: match.MatchService.GetL3Snapshot:output_type -> match.GetL3SnapshotResp
+	10, // 27: match.MatchService.GetOrderBook:output_type -> match.GetOrderBookResp
+	12, // 28: match.MatchService.GetEngineOrder:output_type -> match.GetEngineOrderResp
+	14, // 29: match.MatchService.GetEngineStats:output_type -> match.GetEngineStatsResp
+	16, // 30: match.MatchService.HaltTrading:output_type -> match.HaltTradingResp
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_app_match_rpc_pb_match_proto_init() }
@@ -810,7 +2022,7 @@ func file_app_match_rpc_pb_match_proto_init() {
 			}
 		}
 		file_app_match_rpc_pb_match_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDepthResp_Position); i {
+			switch v := v.(*GetL3SnapshotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -822,7 +2034,7 @@ func file_app_match_rpc_pb_match_proto_init() {
 			}
 		}
 		file_app_match_rpc_pb_match_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTickResp_Tick); i {
+			switch v := v.(*GetL3SnapshotResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -834,6 +2046,138 @@ func file_app_match_rpc_pb_match_proto_init() {
 			}
 		}
 		file_app_match_rpc_pb_match_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEngineOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEngineOrderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEngineStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEngineStatsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltTradingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltTradingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepthResp_Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickResp_Tick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_match_rpc_pb_match_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTickerResp_Ticker); i {
 			case 0:
 				return &v.state
@@ -845,6 +2189,18 @@ func file_app_match_rpc_pb_match_proto_init() {
 				return nil
 			}
 		}
+		file_app_match_rpc_pb_match_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetL3SnapshotResp_Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -852,7 +2208,7 @@ func file_app_match_rpc_pb_match_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_match_rpc_pb_match_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MatchService_GetDepth_FullMethodName  = "/match.MatchService/GetDepth"
-	MatchService_GetTick_FullMethodName   = "/match.MatchService/GetTick"
-	MatchService_GetTicker_FullMethodName = "/match.MatchService/GetTicker"
+	MatchService_GetDepth_FullMethodName       = "/match.MatchService/GetDepth"
+	MatchService_GetTick_FullMethodName        = "/match.MatchService/GetTick"
+	MatchService_GetTicker_FullMethodName      = "/match.MatchService/GetTicker"
+	MatchService_GetL3Snapshot_FullMethodName  = "/match.MatchService/GetL3Snapshot"
+	MatchService_GetOrderBook_FullMethodName   = "/match.MatchService/GetOrderBook"
+	MatchService_GetEngineOrder_FullMethodName = "/match.MatchService/GetEngineOrder"
+	MatchService_GetEngineStats_FullMethodName = "/match.MatchService/GetEngineStats"
+	MatchService_HaltTrading_FullMethodName    = "/match.MatchService/HaltTrading"
 )
 
 // MatchServiceClient is the client API for MatchService service.
@@ -34,6 +39,16 @@ type MatchServiceClient interface {
 	GetTick(ctx context.Context, in *GetTickReq, opts ...grpc.CallOption) (*GetTickResp, error)
 	// 获取ticker
 	GetTicker(ctx context.Context, in *GetTickerReq, opts ...grpc.CallOption) (*GetTickerResp, error)
+	// 获取逐笔委托的快照
+	GetL3Snapshot(ctx context.Context, in *GetL3SnapshotReq, opts ...grpc.CallOption) (*GetL3SnapshotResp, error)
+	// 运维接口 获取订单簿中所有的订单
+	GetOrderBook(ctx context.Context, in *GetOrderBookReq, opts ...grpc.CallOption) (*GetOrderBookResp, error)
+	// 运维接口 获取撮合引擎中的订单
+	GetEngineOrder(ctx context.Context, in *GetEngineOrderReq, opts ...grpc.CallOption) (*GetEngineOrderResp, error)
+	// 运维接口 获取撮合引擎的状态
+	GetEngineStats(ctx context.Context, in *GetEngineStatsReq, opts ...grpc.CallOption) (*GetEngineStatsResp, error)
+	// 运维接口 暂停或者恢复交易 暂停期间只接受撤单
+	HaltTrading(ctx context.Context, in *HaltTradingReq, opts ...grpc.CallOption) (*HaltTradingResp, error)
 }
 
 type matchServiceClient struct {
@@ -71,6 +86,51 @@ func (c *matchServiceClient) GetTicker(ctx context.Context, in *GetTickerReq, op
 	return out, nil
 }
 
+func (c *matchServiceClient) GetL3Snapshot(ctx context.Context, in *GetL3SnapshotReq, opts ...grpc.CallOption) (*GetL3SnapshotResp, error) {
+	out := new(GetL3SnapshotResp)
+	err := c.cc.Invoke(ctx, MatchService_GetL3Snapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetOrderBook(ctx context.Context, in *GetOrderBookReq, opts ...grpc.CallOption) (*GetOrderBookResp, error) {
+	out := new(GetOrderBookResp)
+	err := c.cc.Invoke(ctx, MatchService_GetOrderBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetEngineOrder(ctx context.Context, in *GetEngineOrderReq, opts ...grpc.CallOption) (*GetEngineOrderResp, error) {
+	out := new(GetEngineOrderResp)
+	err := c.cc.Invoke(ctx, MatchService_GetEngineOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetEngineStats(ctx context.Context, in *GetEngineStatsReq, opts ...grpc.CallOption) (*GetEngineStatsResp, error) {
+	out := new(GetEngineStatsResp)
+	err := c.cc.Invoke(ctx, MatchService_GetEngineStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) HaltTrading(ctx context.Context, in *HaltTradingReq, opts ...grpc.CallOption) (*HaltTradingResp, error) {
+	out := new(HaltTradingResp)
+	err := c.cc.Invoke(ctx, MatchService_HaltTrading_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchServiceServer is the server API for MatchService service.
 // All implementations must embed UnimplementedMatchServiceServer
 // for forward compatibility
@@ -81,6 +141,16 @@ type MatchServiceServer interface {
 	GetTick(context.Context, *GetTickReq) (*GetTickResp, error)
 	// 获取ticker
 	GetTicker(context.Context, *GetTickerReq) (*GetTickerResp, error)
+	// 获取逐笔委托的快照
+	GetL3Snapshot(context.Context, *GetL3SnapshotReq) (*GetL3SnapshotResp, error)
+	// 运维接口 获取订单簿中所有的订单
+	GetOrderBook(context.Context, *GetOrderBookReq) (*GetOrderBookResp, error)
+	// 运维接口 获取撮合引擎中的订单
+	GetEngineOrder(context.Context, *GetEngineOrderReq) (*GetEngineOrderResp, error)
+	// 运维接口 获取撮合引擎的状态
+	GetEngineStats(context.Context, *GetEngineStatsReq) (*GetEngineStatsResp, error)
+	// 运维接口 暂停或者恢复交易 暂停期间只接受撤单
+	HaltTrading(context.Context, *HaltTradingReq) (*HaltTradingResp, error)
 	mustEmbedUnimplementedMatchServiceServer()
 }
 
@@ -97,6 +167,21 @@ func (UnimplementedMatchServiceServer) GetTick(context.Context, *GetTickReq) (*G
 func (UnimplementedMatchServiceServer) GetTicker(context.Context, *GetTickerReq) (*GetTickerResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
 }
+func (UnimplementedMatchServiceServer) GetL3Snapshot(context.Context, *GetL3SnapshotReq) (*GetL3SnapshotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetL3Snapshot not implemented")
+}
+func (UnimplementedMatchServiceServer) GetOrderBook(context.Context, *GetOrderBookReq) (*GetOrderBookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedMatchServiceServer) GetEngineOrder(context.Context, *GetEngineOrderReq) (*GetEngineOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngineOrder not implemented")
+}
+func (UnimplementedMatchServiceServer) GetEngineStats(context.Context, *GetEngineStatsReq) (*GetEngineStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngineStats not implemented")
+}
+func (UnimplementedMatchServiceServer) HaltTrading(context.Context, *HaltTradingReq) (*HaltTradingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltTrading not implemented")
+}
 func (UnimplementedMatchServiceServer) mustEmbedUnimplementedMatchServiceServer() {}
 
 // UnsafeMatchServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +249,96 @@ func _MatchService_GetTicker_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchService_GetL3Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetL3SnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetL3Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetL3Snapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetL3Snapshot(ctx, req.(*GetL3SnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetOrderBook(ctx, req.(*GetOrderBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetEngineOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEngineOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetEngineOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetEngineOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetEngineOrder(ctx, req.(*GetEngineOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetEngineStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEngineStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetEngineStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetEngineStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetEngineStats(ctx, req.(*GetEngineStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_HaltTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltTradingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).HaltTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_HaltTrading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).HaltTrading(ctx, req.(*HaltTradingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchService_ServiceDesc is the grpc.ServiceDesc for MatchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +358,26 @@ var MatchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTicker",
 			Handler:    _MatchService_GetTicker_Handler,
 		},
+		{
+			MethodName: "GetL3Snapshot",
+			Handler:    _MatchService_GetL3Snapshot_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _MatchService_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "GetEngineOrder",
+			Handler:    _MatchService_GetEngineOrder_Handler,
+		},
+		{
+			MethodName: "GetEngineStats",
+			Handler:    _MatchService_GetEngineStats_Handler,
+		},
+		{
+			MethodName: "HaltTrading",
+			Handler:    _MatchService_HaltTrading_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "app/match/rpc/pb/match.proto",
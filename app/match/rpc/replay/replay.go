@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/luxun9527/gex/app/match/rpc/internal/config"
+	"github.com/luxun9527/gex/app/match/rpc/internal/engine"
+	pulsarConfig "github.com/luxun9527/gex/common/pkg/pulsar"
+	"github.com/luxun9527/gex/common/proto/define"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// 撮合重放工具，读取一段match_source的消息，用一个新的撮合引擎重新撮合，输出撮合结果。
+// 撮合id从id-start开始递增，撮合时间使用消息的时间，相同的输入每次重放的输出完全一致，用于审计和复现有争议的成交。
+// 从文件重放
+// go run ./app/match/rpc/replay -symbol-file symbol.yaml -in match_source.bin -out match_result.json
+// 从pulsar重放
+// go run ./app/match/rpc/replay -symbol-file symbol.yaml -pulsar pulsar:6650 -start 100:0:-1:0 -count 1000 -out-format binary -out match_result.bin
+var (
+	symbolFile = flag.String("symbol-file", "", "交易对配置文件,格式和etcd中Symbol/下的配置一致")
+	in         = flag.String("in", "", "输入文件,不为空则从文件读取消息")
+	inFormat   = flag.String("in-format", formatBinary, "输入文件格式 binary json")
+	hosts      = flag.String("pulsar", "", "pulsar地址,多个用逗号分隔,不为空则从pulsar读取消息")
+	topic      = flag.String("topic", "", "pulsar主题,默认为match_source_交易对")
+	start      = flag.String("start", "earliest", "pulsar开始的消息id ledgerId:entryId:batchIdx:partitionIdx 包含这条消息")
+	skip       = flag.Int64("skip", 0, "跳过前面的消息数量")
+	count      = flag.Int64("count", 0, "重放的消息数量,0表示全部")
+	out        = flag.String("out", "", "输出文件,默认输出到标准输出")
+	outFormat  = flag.String("out-format", formatJson, "输出格式 binary json,binary和撮合引擎发送到pulsar的消息内容一致")
+	idStart    = flag.Int64("id-start", 1, "撮合id的起始值")
+	timeStart  = flag.Int64("time-start", 0, "从文件重放时的起始时间 毫秒时间戳")
+)
+
+func main() {
+	flag.Parse()
+	//日志输出到标准错误，标准输出只输出撮合结果
+	logx.SetWriter(logx.NewWriter(os.Stderr))
+	logx.SetLevel(logx.ErrorLevel)
+
+	symbolInfo, err := loadSymbolInfo(*symbolFile)
+	if err != nil {
+		log.Fatalf("load symbol config failed err = %v", err)
+	}
+
+	src, err := newSource(symbolInfo.SymbolName)
+	if err != nil {
+		log.Fatalf("init source failed err = %v", err)
+	}
+	defer src.close()
+
+	w := os.Stdout
+	if *out != "" {
+		if w, err = os.Create(*out); err != nil {
+			log.Fatalf("create output file failed err = %v", err)
+		}
+		defer w.Close()
+	}
+	rw, err := newResultWriter(w, *outFormat)
+	if err != nil {
+		log.Fatalf("init output failed err = %v", err)
+	}
+
+	//注入id和时间，保证每次重放的结果一致
+	id, now := *idStart-1, time.UnixMilli(*timeStart)
+	c := &config.Config{Symbol: symbolInfo.SymbolName, SymbolInfo: symbolInfo}
+	me := engine.NewMatchEngine(c, rw, discardMarketDataSink{},
+		engine.WithIdGenerator(func() int64 {
+			id++
+			return id
+		}),
+		engine.WithClock(func() time.Time {
+			return now
+		}),
+	)
+
+	var handled int64
+	for i := int64(0); *count == 0 || handled < *count; i++ {
+		req, t, err := src.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("read message failed index = %v err = %v", i, err)
+		}
+		if i < *skip {
+			continue
+		}
+		//和撮合服务一致，时间只增不减，定时消息把时间推进到到期的时间
+		if t.After(now) {
+			now = t
+		}
+		if timer := req.GetTimer(); timer != nil && timer.Time > now.UnixNano() {
+			now = time.Unix(0, timer.Time)
+		}
+		handleMatchReq(me, req)
+		handled++
+	}
+	if err := rw.flush(); err != nil {
+		log.Fatalf("write output failed err = %v", err)
+	}
+	log.Printf("replay finished messages = %v results = %v", handled, rw.count)
+}
+
+// handleMatchReq 和撮合服务消费match_source的处理保持一致，数值不合法的消息跳过
+func handleMatchReq(me *engine.MatchEngine, req *matchMq.MatchReq) {
+	switch operate := req.Operate.(type) {
+	case *matchMq.MatchReq_NewOrder:
+		order, err := engine.NewOrderFromOperate(operate.NewOrder)
+		if err != nil {
+			log.Printf("skip invalid new order %v err = %v", operate.NewOrder.SequenceId, err)
+			return
+		}
+		me.HandleOrder(order)
+	case *matchMq.MatchReq_Cancel:
+		me.HandleOrder(engine.NewCancelOrderFromOperate(operate.Cancel))
+	case *matchMq.MatchReq_Amend:
+		amend, err := engine.NewAmendOrderFromOperate(operate.Amend)
+		if err != nil {
+			log.Printf("skip invalid amend order %v err = %v", operate.Amend.Id, err)
+			return
+		}
+		me.HandleAmend(amend)
+	case *matchMq.MatchReq_CancelAll:
+		me.HandleCancelAll(engine.NewCancelAllFromOperate(operate.CancelAll))
+	case *matchMq.MatchReq_Halt:
+		me.HandleHalt(engine.NewHaltFromOperate(operate.Halt))
+	case *matchMq.MatchReq_Timer:
+		me.HandleTimer()
+	}
+}
+
+func newSource(symbol string) (source, error) {
+	switch {
+	case *in != "":
+		return newFileSource(*in, *inFormat, *timeStart)
+	case *hosts != "":
+		client, err := pulsarConfig.PulsarConfig{Hosts: strings.Split(*hosts, ",")}.BuildClient()
+		if err != nil {
+			return nil, err
+		}
+		t := *topic
+		if t == "" {
+			t = pulsarConfig.Topic{
+				Tenant:    pulsarConfig.PublicTenant,
+				Namespace: pulsarConfig.GexNamespace,
+				Topic:     pulsarConfig.MatchSourceTopic + "_" + symbol,
+			}.BuildTopic()
+		}
+		return newPulsarSource(client, t, *start)
+	default:
+		return nil, fmt.Errorf("one of -in or -pulsar is required")
+	}
+}
+
+// loadSymbolInfo 加载交易对配置，和define.InitSymbolConfig一样设置精度
+func loadSymbolInfo(path string) (*define.SymbolInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var symbolInfo define.SymbolInfo
+	if err := yaml.Unmarshal(data, &symbolInfo); err != nil {
+		return nil, err
+	}
+	if symbolInfo.BaseCoinPrecValue <= 0 || symbolInfo.QuoteCoinPrecValue <= 0 {
+		return nil, fmt.Errorf("invalid prec baseCoinPrec = %v quoteCoinPrec = %v", symbolInfo.BaseCoinPrecValue, symbolInfo.QuoteCoinPrecValue)
+	}
+	symbolInfo.StoreValues()
+	return &symbolInfo, nil
+}
+
+// resultWriter 实现engine.ResultSink，按照撮合引擎发送的顺序输出撮合结果
+type resultWriter struct {
+	w      *bufio.Writer
+	format string
+	count  int64
+}
+
+func newResultWriter(w io.Writer, format string) (*resultWriter, error) {
+	if format != formatBinary && format != formatJson {
+		return nil, fmt.Errorf("unknown output format %v", format)
+	}
+	return &resultWriter{w: bufio.NewWriter(w), format: format}, nil
+}
+
+func (rw *resultWriter) SendMatchResult(resp *matchMq.MatchResp) error {
+	rw.count++
+	if rw.format == formatBinary {
+		_, err := protodelim.MarshalTo(rw.w, resp)
+		return err
+	}
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if _, err := rw.w.Write(data); err != nil {
+		return err
+	}
+	return rw.w.WriteByte('\n')
+}
+
+func (rw *resultWriter) flush() error {
+	return rw.w.Flush()
+}
@@ -0,0 +1,8 @@
+package main
+
+// discardMarketDataSink 重放的时候行情数据不推送给用户。
+type discardMarketDataSink struct{}
+
+func (discardMarketDataSink) PushMarketData(_ string, _ []byte) error {
+	return nil
+}
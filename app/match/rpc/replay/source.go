@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"github.com/spf13/cast"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	formatBinary = "binary" //长度前缀的protobuf二进制,和pulsar中的消息内容一致
+	formatJson   = "json"   //每行一个protojson
+)
+
+// source 重放的消息来源
+type source interface {
+	// next 返回下一条消息和消息的时间，没有消息的时候返回io.EOF
+	next() (*matchMq.MatchReq, time.Time, error)
+	close()
+}
+
+// fileSource 从文件读取消息，消息时间从startTime开始每条消息加1毫秒
+type fileSource struct {
+	f         *os.File
+	r         *bufio.Reader
+	format    string
+	index     int64
+	startTime int64
+}
+
+func newFileSource(path, format string, startTime int64) (*fileSource, error) {
+	if format != formatBinary && format != formatJson {
+		return nil, fmt.Errorf("unknown input format %v", format)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSource{
+		f:         f,
+		r:         bufio.NewReader(f),
+		format:    format,
+		startTime: startTime,
+	}, nil
+}
+
+func (s *fileSource) next() (*matchMq.MatchReq, time.Time, error) {
+	var req matchMq.MatchReq
+	if s.format == formatBinary {
+		if err := protodelim.UnmarshalFrom(s.r, &req); err != nil {
+			return nil, time.Time{}, err
+		}
+	} else {
+		line, err := s.r.ReadString('\n')
+		if strings.TrimSpace(line) == "" {
+			if err == nil {
+				return s.next()
+			}
+			return nil, time.Time{}, err
+		}
+		if err := protojson.Unmarshal([]byte(line), &req); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	t := time.UnixMilli(s.startTime + s.index)
+	s.index++
+	return &req, t, nil
+}
+
+func (s *fileSource) close() {
+	s.f.Close()
+}
+
+// pulsarSource 从pulsar的match_source主题读取消息，使用reader不影响撮合服务的订阅。
+// 消息时间使用消息的发布时间。
+type pulsarSource struct {
+	client pulsar.Client
+	reader pulsar.Reader
+}
+
+func newPulsarSource(client pulsar.Client, topic, start string) (*pulsarSource, error) {
+	startID, err := parseMessageID(start)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := client.CreateReader(pulsar.ReaderOptions{
+		Topic:                   topic,
+		StartMessageID:          startID,
+		StartMessageIDInclusive: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pulsarSource{client: client, reader: reader}, nil
+}
+
+func (s *pulsarSource) next() (*matchMq.MatchReq, time.Time, error) {
+	if !s.reader.HasNext() {
+		return nil, time.Time{}, io.EOF
+	}
+	msg, err := s.reader.Next(context.Background())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var req matchMq.MatchReq
+	if err := proto.Unmarshal(msg.Payload(), &req); err != nil {
+		return nil, time.Time{}, fmt.Errorf("unmarshal message %v failed %w", msg.ID(), err)
+	}
+	return &req, msg.PublishTime(), nil
+}
+
+func (s *pulsarSource) close() {
+	s.reader.Close()
+	s.client.Close()
+}
+
+// parseMessageID 解析消息id，格式为 ledgerId:entryId:batchIdx:partitionIdx，earliest表示从头开始。
+func parseMessageID(id string) (pulsar.MessageID, error) {
+	if id == "" || id == "earliest" {
+		return pulsar.EarliestMessageID(), nil
+	}
+	d := strings.Split(id, ":")
+	if len(d) != 4 {
+		return nil, fmt.Errorf("invalid message id %v", id)
+	}
+	return pulsar.NewMessageID(cast.ToInt64(d[0]), cast.ToInt64(d[1]), cast.ToInt32(d[2]), cast.ToInt32(d[3])), nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"github.com/luxun9527/gex/common/pkg/etcd"
 	logger "github.com/luxun9527/zlog"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -15,4 +16,5 @@ type Config struct {
 	AccountRpcConf   zrpc.RpcClientConf
 	LanguageEtcdConf etcd.EtcdConfig
 	SymbolEtcdConfig etcd.EtcdConfig
+	RedisConf        redis.RedisConf
 }
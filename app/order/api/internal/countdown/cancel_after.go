@@ -0,0 +1,106 @@
+package countdown
+
+import (
+	"context"
+	orderpb "github.com/luxun9527/gex/app/order/rpc/pb"
+	"github.com/luxun9527/gex/common/proto/define"
+	logger "github.com/luxun9527/zlog"
+	"github.com/spf13/cast"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"time"
+
+	"github.com/luxun9527/gex/app/order/api/internal/svc"
+)
+
+// 撤单倒计时：用户设置倒计时之后需要在倒计时结束之前再次调用刷新，否则通过批量撤单撤销用户的订单。
+// 倒计时按照交易对设置，只撤销这个交易对的订单；不指定交易对则撤销用户在所有交易对的订单，和交易对的倒计时互相独立。
+// 倒计时保存在redis的有序集合中，分数为撤单的时间，订单api重启之后不会丢失。
+// 每个订单api实例都会检查到期的倒计时，从有序集合中删除成功的实例负责撤单，保证只撤一次。
+
+const checkInterval = time.Second
+
+// 分数没有变化才删除，检查之后用户刷新了倒计时则不撤单
+var claimScript = redis.NewScript(`
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if score and tonumber(score) <= tonumber(ARGV[2]) then
+	return redis.call('ZREM', KEYS[1], ARGV[1])
+end
+return 0`)
+
+// 撤单失败放回去的时候用户可能已经刷新或者取消了倒计时，只在不存在的时候添加，不覆盖新的撤单时间
+var restoreScript = redis.NewScript(`
+return redis.call('ZADD', KEYS[1], 'NX', ARGV[1], ARGV[2])`)
+
+// Member 有序集合的成员 用户id@交易对，交易对为空表示所有交易对
+func Member(uid int64, symbol string) string {
+	return cast.ToString(uid) + "@" + symbol
+}
+
+// Start 定时检查到期的倒计时
+func Start(sc *svc.ServiceContext) {
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			check(sc, time.Now().Unix())
+		}
+	}()
+}
+
+// check 撤销到期的倒计时对应的订单，now为当前时间 单位秒
+func check(sc *svc.ServiceContext, now int64) {
+	ctx := context.Background()
+	key := define.OrderCancelAfter.WithParams()
+	expired, err := sc.RedisClient.ZrangebyscoreWithScoresCtx(ctx, key, 0, now)
+	if err != nil {
+		logx.Errorw("get expired cancel after failed", logger.ErrorField(err))
+		return
+	}
+	for _, v := range expired {
+		claimed, err := sc.RedisClient.ScriptRunCtx(ctx, claimScript, []string{key}, v.Key, now)
+		if err != nil {
+			logx.Errorw("claim cancel after failed", logger.ErrorField(err), logx.Field("member", v.Key))
+			continue
+		}
+		if cast.ToInt64(claimed) == 0 {
+			continue
+		}
+		uid, symbol, ok := strings.Cut(v.Key, "@")
+		if !ok {
+			logx.Errorw("invalid cancel after member", logx.Field("member", v.Key))
+			continue
+		}
+		logx.Infow("cancel after expired", logx.Field("uid", uid), logx.Field("symbol", symbol))
+		if err := cancelAll(ctx, sc, cast.ToInt64(uid), symbol); err != nil {
+			//撤单失败放回去，下一次检查的时候重试，已经撤销的交易对重试的时候没有订单可撤
+			logx.Errorw("cancel all orders failed", logger.ErrorField(err), logx.Field("member", v.Key))
+			if _, err := sc.RedisClient.ScriptRunCtx(ctx, restoreScript, []string{key}, v.Score, v.Key); err != nil {
+				logx.Errorw("restore cancel after failed", logger.ErrorField(err), logx.Field("member", v.Key))
+			}
+		}
+	}
+}
+
+// cancelAll 撤销用户在交易对的所有订单，交易对为空则撤销所有交易对，返回最后一个失败的错误
+func cancelAll(ctx context.Context, sc *svc.ServiceContext, uid int64, symbol string) error {
+	symbols := []string{symbol}
+	if symbol == "" {
+		symbols = symbols[:0]
+		sc.Symbols.Range(func(key, value any) bool {
+			symbols = append(symbols, key.(string))
+			return true
+		})
+	}
+	var lastErr error
+	for _, s := range symbols {
+		rpcCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("symbol", s))
+		if _, err := sc.OrderClient.CancelAllOrders(rpcCtx, &orderpb.CancelAllOrdersReq{Uid: uid}); err != nil {
+			logx.Errorw("cancel all orders failed", logger.ErrorField(err), logx.Field("uid", uid), logx.Field("symbol", s))
+			lastErr = err
+		}
+	}
+	return lastErr
+}
@@ -0,0 +1,129 @@
+package countdown
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/luxun9527/gex/app/order/api/internal/svc"
+	orderpb "github.com/luxun9527/gex/app/order/rpc/pb"
+	"github.com/luxun9527/gex/common/proto/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// 记录批量撤单的请求，onCancel在撤单的时候调用，返回错误则撤单失败
+type testOrderClient struct {
+	orderpb.OrderServiceClient
+	canceled []string
+	onCancel func(symbol string) error
+}
+
+func (c *testOrderClient) CancelAllOrders(ctx context.Context, in *orderpb.CancelAllOrdersReq, opts ...grpc.CallOption) (*orderpb.OrderEmpty, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	symbol := md.Get("symbol")[0]
+	c.canceled = append(c.canceled, Member(in.Uid, symbol))
+	if c.onCancel != nil {
+		if err := c.onCancel(symbol); err != nil {
+			return nil, err
+		}
+	}
+	return &orderpb.OrderEmpty{}, nil
+}
+
+func createTestServiceContext(t *testing.T) (*svc.ServiceContext, *testOrderClient) {
+	client := &testOrderClient{}
+	symbols := &sync.Map{}
+	symbols.Store("BTC_USDT", &define.SymbolInfo{SymbolName: "BTC_USDT"})
+	return &svc.ServiceContext{
+		OrderClient: client,
+		RedisClient: redistest.CreateRedis(t),
+		Symbols:     symbols,
+	}, client
+}
+
+func TestCheckCancelAfter(t *testing.T) {
+	sc, client := createTestServiceContext(t)
+	key := define.OrderCancelAfter.WithParams()
+	_, err := sc.RedisClient.Zadd(key, 100, Member(1, "BTC_USDT"))
+	assert.Nil(t, err)
+	_, err = sc.RedisClient.Zadd(key, 200, Member(2, "BTC_USDT"))
+	assert.Nil(t, err)
+
+	// 只撤销到期的倒计时，撤单成功之后删除
+	check(sc, 150)
+	assert.Equal(t, []string{Member(1, "BTC_USDT")}, client.canceled)
+	_, err = sc.RedisClient.Zscore(key, Member(1, "BTC_USDT"))
+	assert.Equal(t, redis.Nil, err)
+	score, err := sc.RedisClient.Zscore(key, Member(2, "BTC_USDT"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), score)
+
+	// 已经撤销的倒计时不会重复撤单
+	check(sc, 150)
+	assert.Len(t, client.canceled, 1)
+}
+
+func TestCheckCancelAfterRestore(t *testing.T) {
+	sc, client := createTestServiceContext(t)
+	key := define.OrderCancelAfter.WithParams()
+	member := Member(1, "BTC_USDT")
+	_, err := sc.RedisClient.Zadd(key, 100, member)
+	assert.Nil(t, err)
+
+	// 撤单失败放回原来的撤单时间，下一次检查的时候重试
+	client.onCancel = func(string) error {
+		return errors.New("order rpc unavailable")
+	}
+	check(sc, 150)
+	score, err := sc.RedisClient.Zscore(key, member)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), score)
+
+	// 撤单期间用户刷新了倒计时，撤单失败之后不覆盖新的撤单时间
+	client.onCancel = func(string) error {
+		_, err := sc.RedisClient.Zadd(key, 300, member)
+		assert.Nil(t, err)
+		return errors.New("order rpc unavailable")
+	}
+	check(sc, 150)
+	score, err = sc.RedisClient.Zscore(key, member)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(300), score)
+	assert.Len(t, client.canceled, 2)
+}
+
+func TestClaimCancelAfter(t *testing.T) {
+	sc, _ := createTestServiceContext(t)
+	key := define.OrderCancelAfter.WithParams()
+	member := Member(1, "BTC_USDT")
+	_, err := sc.RedisClient.Zadd(key, 300, member)
+	assert.Nil(t, err)
+
+	// 查询到期之后用户刷新了倒计时，撤单时间还没到不能删除
+	claimed, err := sc.RedisClient.ScriptRun(claimScript, []string{key}, member, 150)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), claimed)
+	claimed, err = sc.RedisClient.ScriptRun(claimScript, []string{key}, member, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), claimed)
+	claimed, err = sc.RedisClient.ScriptRun(claimScript, []string{key}, member, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), claimed)
+}
+
+func TestCheckCancelAfterAllSymbols(t *testing.T) {
+	sc, client := createTestServiceContext(t)
+	sc.Symbols.Store("ETH_USDT", &define.SymbolInfo{SymbolName: "ETH_USDT"})
+	key := define.OrderCancelAfter.WithParams()
+	_, err := sc.RedisClient.Zadd(key, 100, Member(1, ""))
+	assert.Nil(t, err)
+
+	// 不指定交易对的倒计时撤销所有交易对的订单
+	check(sc, 150)
+	assert.ElementsMatch(t, []string{Member(1, "BTC_USDT"), Member(1, "ETH_USDT")}, client.canceled)
+}
@@ -24,6 +24,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/cancel_order",
 					Handler: CancelOrderHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/cancel_all_orders",
+					Handler: CancelAllOrdersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/cancel_after",
+					Handler: CancelAfterHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/amend_order",
+					Handler: AmendOrderHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodPost,
 					Path:    "/get_order_list",
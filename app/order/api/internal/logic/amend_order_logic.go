@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	orderpb "github.com/luxun9527/gex/app/order/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/metadata"
+	"strings"
+
+	"github.com/luxun9527/gex/app/order/api/internal/svc"
+	"github.com/luxun9527/gex/app/order/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AmendOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAmendOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AmendOrderLogic {
+	return &AmendOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AmendOrder 修改限价单的价格和数量，资产是否足够在订单服务冻结时校验
+func (l *AmendOrderLogic) AmendOrder(req *types.AmendOrderReq) (resp *types.Empty, err error) {
+	s, ok := l.svcCtx.Symbols.Load(req.SymbolName)
+	if !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	symbolInfo, ok := s.(*define.SymbolInfo)
+	if !ok {
+		return nil, errs.Internal
+	}
+	qty, err := decimal.NewFromString(req.Qty)
+	if err != nil || !qty.IsPositive() {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "qty must is a number")
+	}
+	price, err := decimal.NewFromString(req.Price)
+	if err != nil || !price.IsPositive() {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "price must is a number")
+	}
+	//价格和数量精度
+	if p := strings.Split(req.Price, "."); len(p) == 2 && int(symbolInfo.QuoteCoinPrec.Load()) < len(p[1]) {
+		return nil, errs.ErrPrec
+	}
+	if q := strings.Split(req.Qty, "."); len(q) == 2 && int(symbolInfo.BaseCoinPrec.Load()) < len(q[1]) {
+		return nil, errs.ErrPrec
+	}
+	//交易规则
+	if err := symbolInfo.GetTradingRule().CheckLimitOrder(price, qty); err != nil {
+		return nil, err
+	}
+
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.SymbolName))
+	uid := l.ctx.Value("uid")
+	_, err = l.svcCtx.OrderClient.AmendOrder(ctx, &orderpb.AmendOrderReq{
+		Id:    cast.ToInt64(req.ID),
+		Uid:   cast.ToInt64(uid),
+		Price: req.Price,
+		Qty:   req.Qty,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
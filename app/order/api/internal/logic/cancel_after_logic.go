@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"github.com/luxun9527/gex/app/order/api/internal/countdown"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/define"
+	logger "github.com/luxun9527/zlog"
+	"github.com/spf13/cast"
+	"time"
+
+	"github.com/luxun9527/gex/app/order/api/internal/svc"
+	"github.com/luxun9527/gex/app/order/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 倒计时最长一个小时
+const maxCancelAfterTimeout = 3600
+
+type CancelAfterLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelAfterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAfterLogic {
+	return &CancelAfterLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CancelAfter 设置或者刷新撤单倒计时，timeout为0取消倒计时，不指定交易对则倒计时结束撤销所有交易对的订单
+func (l *CancelAfterLogic) CancelAfter(req *types.CancelAfterReq) (resp *types.CancelAfterResp, err error) {
+	if _, ok := l.svcCtx.Symbols.Load(req.SymbolName); req.SymbolName != "" && !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	if req.Timeout < 0 || req.Timeout > maxCancelAfterTimeout {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "timeout must between 0 and 3600")
+	}
+	key := define.OrderCancelAfter.WithParams()
+	member := countdown.Member(cast.ToInt64(l.ctx.Value("uid")), req.SymbolName)
+	if req.Timeout == 0 {
+		if _, err := l.svcCtx.RedisClient.ZremCtx(l.ctx, key, member); err != nil {
+			logx.Errorw("remove cancel after failed", logger.ErrorField(err))
+			return nil, errs.RedisErr
+		}
+		return &types.CancelAfterResp{}, nil
+	}
+	deadline := time.Now().Unix() + req.Timeout
+	if _, err := l.svcCtx.RedisClient.ZaddCtx(l.ctx, key, deadline, member); err != nil {
+		logx.Errorw("set cancel after failed", logger.ErrorField(err))
+		return nil, errs.RedisErr
+	}
+	return &types.CancelAfterResp{Deadline: deadline}, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+	orderpb "github.com/luxun9527/gex/app/order/rpc/pb"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/enum"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/luxun9527/gex/app/order/api/internal/svc"
+	"github.com/luxun9527/gex/app/order/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAllOrdersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelAllOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAllOrdersLogic {
+	return &CancelAllOrdersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CancelAllOrders 撤销用户在交易对的所有订单，可以只撤销一个方向
+func (l *CancelAllOrdersLogic) CancelAllOrders(req *types.CancelAllOrdersReq) (resp *types.Empty, err error) {
+	if _, ok := l.svcCtx.Symbols.Load(req.SymbolName); !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "symbol not existed")
+	}
+	side := enum.Side(req.Side)
+	if side != enum.Side_UnknownSide && side != enum.Side_Buy && side != enum.Side_Sell {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "side is invalid")
+	}
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", req.SymbolName))
+	uid := l.ctx.Value("uid")
+	_, err = l.svcCtx.OrderClient.CancelAllOrders(ctx, &orderpb.CancelAllOrdersReq{
+		Uid:  cast.ToInt64(uid),
+		Side: side,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cast"
 	"google.golang.org/grpc/metadata"
 	"strings"
+	"time"
 
 	"github.com/luxun9527/gex/app/order/api/internal/svc"
 	"github.com/luxun9527/gex/app/order/api/internal/types"
@@ -63,12 +64,95 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 		return nil, errs.Internal
 	}
 	uid := l.ctx.Value("uid")
+	rule := symbolInfo.GetTradingRule()
 	//参数校验
 	if _, ok := enum.Side_name[req.Side]; !ok {
 		return nil, errs.WarpMessage(errs.ParamValidateFailed, "side must is 1 or 2")
 	}
-	if _, ok := enum.OrderType_name[req.OrderType]; !ok {
-		return nil, errs.WarpMessage(errs.ParamValidateFailed, "order type must is 1 or 2")
+	if _, ok := enum.OrderType_name[req.OrderType]; !ok || enum.OrderType(req.OrderType) == enum.OrderType_UnknownOrderType {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "order type must is 1 2 3 or 4")
+	}
+	if req.PostOnly && enum.OrderType(req.OrderType) != enum.OrderType_LO {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "post only must be limit order")
+	}
+	if _, ok := enum.STPMode_name[req.StpMode]; !ok {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "stp mode must between 0 and 5")
+	}
+	//条件单只支持止损限价和止损市价
+	if req.TriggerPrice != "" {
+		if enum.OrderType(req.OrderType) != enum.OrderType_LO && enum.OrderType(req.OrderType) != enum.OrderType_MO {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "trigger order must be limit or market order")
+		}
+		if req.PostOnly {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "trigger order can not be post only")
+		}
+		triggerPrice, err := decimal.NewFromString(req.TriggerPrice)
+		if err != nil || !triggerPrice.IsPositive() {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "trigger price must is a number")
+		}
+		//触发价格精度
+		tp := strings.Split(req.TriggerPrice, ".")
+		if len(tp) == 2 && int(symbolInfo.QuoteCoinPrec.Load()) < len(tp[1]) {
+			return nil, errs.ErrPrec
+		}
+		if err := rule.CheckPrice(triggerPrice); err != nil {
+			return nil, err
+		}
+	}
+	//冰山单只显示一部分数量
+	if req.DisplayQty != "" {
+		if enum.OrderType(req.OrderType) != enum.OrderType_LO {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "iceberg order must be limit order")
+		}
+		displayQty, err := decimal.NewFromString(req.DisplayQty)
+		if err != nil || !displayQty.IsPositive() {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "display qty must is a number")
+		}
+		qty, err := decimal.NewFromString(req.Qty)
+		if err != nil || displayQty.GreaterThanOrEqual(qty) {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "display qty must less than qty")
+		}
+		dq := strings.Split(req.DisplayQty, ".")
+		if len(dq) == 2 && int(symbolInfo.BaseCoinPrec.Load()) < len(dq[1]) {
+			return nil, errs.ErrPrec
+		}
+		if err := rule.CheckQty(displayQty); err != nil {
+			return nil, err
+		}
+	}
+	//限时单到期之后由撮合引擎撤销
+	if req.ExpireTime != 0 {
+		if enum.OrderType(req.OrderType) != enum.OrderType_LO || req.TriggerPrice != "" {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "expire time only support limit order")
+		}
+		if req.ExpireTime <= time.Now().Unix() {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "expire time must be in the future")
+		}
+	}
+	//市价单的滑点保护，超出最大滑点或者保护价格的部分撤销
+	if req.MaxSlippage != "" || req.ProtectPrice != "" {
+		if enum.OrderType(req.OrderType) != enum.OrderType_MO {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "slippage protection only support market order")
+		}
+		if req.MaxSlippage != "" {
+			slippage, err := decimal.NewFromString(req.MaxSlippage)
+			if err != nil || !slippage.IsPositive() || slippage.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+				return nil, errs.WarpMessage(errs.ParamValidateFailed, "max slippage must between 0 and 1")
+			}
+		}
+		if req.ProtectPrice != "" {
+			protectPrice, err := decimal.NewFromString(req.ProtectPrice)
+			if err != nil || !protectPrice.IsPositive() {
+				return nil, errs.WarpMessage(errs.ParamValidateFailed, "protection price must is a number")
+			}
+			pp := strings.Split(req.ProtectPrice, ".")
+			if len(pp) == 2 && int(symbolInfo.QuoteCoinPrec.Load()) < len(pp[1]) {
+				return nil, errs.ErrPrec
+			}
+			if err := rule.CheckPrice(protectPrice); err != nil {
+				return nil, err
+			}
+		}
 	}
 	zero, basePrec, quotePrec := decimal.NewFromInt32(0), 0, 0
 	switch {
@@ -87,6 +171,10 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 		if int(symbolInfo.BaseCoinPrec.Load()) < basePrec {
 			return nil, errs.ErrPrec
 		}
+		//交易规则
+		if err := rule.CheckQty(qty); err != nil {
+			return nil, err
+		}
 
 		//验证用户金额
 		if err := l.validateUserBalance(cast.ToInt64(uid), symbolInfo.BaseCoinID, req.Qty); err != nil {
@@ -107,6 +195,37 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 			return nil, errs.NotBids
 		}
 
+	//按照数量的市价买单，冻结的金额由订单服务按照深度计算
+	case enum.OrderType(req.OrderType) == enum.OrderType_MO && enum.Side(req.Side) == enum.Side_Buy && utils.NewFromStringMaxPrec(req.Qty).IsPositive():
+		qty, err := decimal.NewFromString(req.Qty)
+		if err != nil {
+			return nil, errs.WarpMessage(errs.ParamValidateFailed, "qty must is a number")
+		}
+		q := strings.Split(req.Qty, ".")
+		if len(q) == 2 {
+			basePrec = len(q[1])
+		}
+		//数量精度
+		if int(symbolInfo.BaseCoinPrec.Load()) < basePrec {
+			return nil, errs.ErrPrec
+		}
+		//交易规则
+		if err := rule.CheckQty(qty); err != nil {
+			return nil, err
+		}
+		req.Amount = ""
+		depthList, err := l.svcCtx.MatchClient.GetDepth(ctx, &matchpb.GetDepthReq{
+			Symbol: req.SymbolName,
+			Level:  1,
+		})
+		if err != nil {
+			logx.Errorw("CreateOrder call GetDepth failed", logger.ErrorField(err))
+			return nil, errs.Internal
+		}
+		if len(depthList.Asks) == 0 {
+			return nil, errs.NotAsks
+		}
+
 	case enum.OrderType(req.OrderType) == enum.OrderType_MO && enum.Side(req.Side) == enum.Side_Buy:
 		amount, err := decimal.NewFromString(req.Amount)
 		if err != nil || amount.Equal(zero) {
@@ -121,6 +240,10 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 		if int(symbolInfo.QuoteCoinPrec.Load()) < quotePrec {
 			return nil, errs.ErrPrec
 		}
+		//交易规则
+		if err := rule.CheckNotional(amount); err != nil {
+			return nil, err
+		}
 
 		//验证用户金额
 		if err := l.validateUserBalance(cast.ToInt64(uid), symbolInfo.BaseCoinID, req.Qty); err != nil {
@@ -143,8 +266,10 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 		if len(depthList.Asks) == 0 {
 			return nil, errs.NotAsks
 		}
-	//限价单参数校验
-	case enum.OrderType(req.OrderType) == enum.OrderType_LO:
+	//限价单参数校验,FOK和IOC也是带价格的订单,校验规则和限价单一样
+	case enum.OrderType(req.OrderType) == enum.OrderType_LO,
+		enum.OrderType(req.OrderType) == enum.OrderType_FOK,
+		enum.OrderType(req.OrderType) == enum.OrderType_IOC:
 		qty, err := decimal.NewFromString(req.Qty)
 		if err != nil || qty.Equal(zero) {
 			return nil, errs.WarpMessage(errs.ParamValidateFailed, "qty must is a number")
@@ -172,6 +297,10 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 		if int(symbolInfo.BaseCoinPrec.Load()) < basePrec {
 			return nil, errs.ErrPrec
 		}
+		//交易规则
+		if err := rule.CheckLimitOrder(p, qty); err != nil {
+			return nil, err
+		}
 
 		if enum.Side(req.Side) == enum.Side_Buy {
 			if err := l.validateUserBalance(cast.ToInt64(uid), symbolInfo.QuoteCoinID, req.Amount); err != nil {
@@ -190,15 +319,23 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.E
 
 	//用户资产校验
 	_, err = l.svcCtx.OrderClient.Order(ctx, &orderpb.CreateOrderReq{
-		UserId:     cast.ToInt64(uid),
-		SymbolId:   symbolInfo.SymbolID,
-		SymbolName: req.SymbolName,
-		Qty:        req.Qty,
-		Price:      req.Price,
-		Amount:     req.Amount,
-		Side:       enum.Side(req.Side),
-		OrderType:  enum.OrderType(req.OrderType),
-		OrderId:    "",
+		UserId:          cast.ToInt64(uid),
+		SymbolId:        symbolInfo.SymbolID,
+		SymbolName:      req.SymbolName,
+		Qty:             req.Qty,
+		Price:           req.Price,
+		Amount:          req.Amount,
+		Side:            enum.Side(req.Side),
+		OrderType:       enum.OrderType(req.OrderType),
+		OrderId:         "",
+		PostOnly:        req.PostOnly,
+		TriggerPrice:    req.TriggerPrice,
+		StpMode:         enum.STPMode(req.StpMode),
+		VipLevel:        cast.ToInt32(l.ctx.Value("vipLevel")),
+		DisplayQty:      req.DisplayQty,
+		ExpireTime:      req.ExpireTime,
+		MaxSlippage:     req.MaxSlippage,
+		ProtectionPrice: req.ProtectPrice,
 	})
 	if err != nil {
 		logx.Errorw("call create order failed", logger.ErrorField(err))
@@ -62,6 +62,8 @@ func (l *GetOrderListLogic) GetOrderList(req *types.GetOrderListReq) (resp *type
 			FilledAmount:   v.FilledAmount,
 			FilledAvgPrice: v.FilledAvgPrice,
 			CreatedAt:      v.CreatedAt,
+			TriggerPrice:   v.TriggerPrice,
+			TriggerStatus:  int32(v.TriggerStatus),
 		}
 		orderInfoList = append(orderInfoList, orderInfo)
 	}
@@ -34,6 +34,7 @@ func (m *AuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 		reqCtx := r.Context()
 		ctx := context.WithValue(reqCtx, "uid", userInfo.Uid)
 		ctx = context.WithValue(ctx, "username", userInfo.Username)
+		ctx = context.WithValue(ctx, "vipLevel", userInfo.VipLevel)
 		newReq := r.WithContext(ctx)
 		next(w, newReq)
 	}
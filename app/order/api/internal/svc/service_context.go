@@ -14,6 +14,7 @@ import (
 	"github.com/luxun9527/gex/common/proto/define"
 	logger "github.com/luxun9527/zlog"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -31,6 +32,7 @@ type ServiceContext struct {
 	Auth             rest.Middleware
 	AccountRpcClient accountservice.AccountService
 	Symbols          *sync.Map
+	RedisClient      *redis.Redis
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -49,6 +51,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 			}
 			s.QuoteCoinPrec.Store(s.QuoteCoinPrecValue)
 			s.BaseCoinPrec.Store(s.BaseCoinPrecValue)
+			s.StoreTradingRule()
 			symbolConfig.Store(s.SymbolName, &s)
 			logx.Infof("symbol config loaded symbolConfig %+v", &symbolConfig)
 
@@ -65,6 +68,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 				logx.Slowf("symbol config changed symbolConfig %+v", &s)
 				s.QuoteCoinPrec.Store(s.QuoteCoinPrecValue)
 				s.BaseCoinPrec.Store(s.BaseCoinPrecValue)
+				s.StoreTradingRule()
 				symbolConfig.Store(s.SymbolName, &s)
 				logx.Slowf("symbol config changed after added symbolConfig %+v", &symbolConfig)
 
@@ -103,5 +107,6 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		OrderClient:      orderservice.NewOrderService(zrpc.MustNewClient(c.OrderRpcConf, clientOpts...)),
 		AccountRpcClient: accountRpcClient,
 		Symbols:          &symbolConfig,
+		RedisClient:      redis.MustNewRedis(c.RedisConf),
 	}
 }
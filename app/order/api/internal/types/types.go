@@ -5,12 +5,19 @@ type Empty struct {
 }
 
 type CreateOrderReq struct {
-	SymbolName string `json:"symbol_name" validate:"required"`       //交易对名称
-	Price      string `json:"price" validate:"required,numeric"`     //价格
-	Qty        string `json:"qty" validate:"required,numeric"`       //数量
-	Amount     string `json:"amount"`                                //金额
-	Side       int32  `json:"side" validate:"required,number"`       //方向
-	OrderType  int32  `json:"order_type" validate:"required,number"` //订单类型
+	SymbolName   string `json:"symbol_name" validate:"required"`       //交易对名称
+	Price        string `json:"price" validate:"required,numeric"`     //价格
+	Qty          string `json:"qty" validate:"required,numeric"`       //数量,市价买单不为0则按照数量买入
+	Amount       string `json:"amount"`                                //金额,按照金额的市价买单才需要
+	Side         int32  `json:"side" validate:"required,number"`       //方向
+	OrderType    int32  `json:"order_type" validate:"required,number"` //订单类型 1市价单 2限价单 3FOK 4IOC
+	PostOnly     bool   `json:"post_only,optional"`                    //是否只做maker,只对限价单有效
+	TriggerPrice string `json:"trigger_price,optional"`                //触发价格,不为空则为止损限价单或止损市价单
+	StpMode      int32  `json:"stp_mode,optional"`                     //自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量
+	DisplayQty   string `json:"display_qty,optional"`                  //冰山单每次显示的数量,只对限价单有效
+	ExpireTime   int64  `json:"expire_time,optional"`                  //过期时间 单位秒,只对限价单有效,为空则一直有效
+	MaxSlippage  string `json:"max_slippage,optional"`                 //最大滑点,例如0.05,只对市价单有效,超出的部分撤销
+	ProtectPrice string `json:"protection_price,optional"`             //保护价格,只对市价单有效,买单为最高价,卖单为最低价
 }
 
 type CancelOrderReq struct {
@@ -18,6 +25,27 @@ type CancelOrderReq struct {
 	SymbolName string `json:"symbol_name"` //交易对名称
 }
 
+type CancelAllOrdersReq struct {
+	SymbolName string `json:"symbol_name" validate:"required"` //交易对名称
+	Side       int32  `json:"side,optional"`                   //方向 0撤销两个方向 1买 2卖
+}
+
+type CancelAfterReq struct {
+	SymbolName string `json:"symbol_name,optional"` //交易对名称,为空表示所有交易对
+	Timeout    int64  `json:"timeout,optional"`     //倒计时 单位秒,为0取消倒计时
+}
+
+type CancelAfterResp struct {
+	Deadline int64 `json:"deadline"` //撤单的时间 单位秒,为0表示没有倒计时
+}
+
+type AmendOrderReq struct {
+	ID         string `json:"id" validate:"required"`            //订单id
+	SymbolName string `json:"symbol_name" validate:"required"`   //交易对名称
+	Price      string `json:"price" validate:"required,numeric"` //修改之后的价格
+	Qty        string `json:"qty" validate:"required,numeric"`   //修改之后的数量,包括已经成交的数量
+}
+
 type GetOrderListReq struct {
 	Status     []int32 `json:"status_list"` //状态
 	SymbolName string  `json:"symbol_name"` //状态
@@ -40,6 +68,8 @@ type OrderInfo struct {
 	FilledAmount   string `json:"filled_amount"`    //成交金额
 	FilledAvgPrice string `json:"filled_avg_price"` //成交均价
 	CreatedAt      int64  `json:"created_at"`       //创建时间
+	TriggerPrice   string `json:"trigger_price"`    //触发价格
+	TriggerStatus  int32  `json:"trigger_status"`   //条件单触发状态 1待触发 2已触发
 }
 
 type GetOrderListResp struct {
@@ -8,6 +8,7 @@ import (
 	"github.com/zeromicro/go-zero/rest/httpx"
 
 	"github.com/luxun9527/gex/app/order/api/internal/config"
+	"github.com/luxun9527/gex/app/order/api/internal/countdown"
 	"github.com/luxun9527/gex/app/order/api/internal/handler"
 	"github.com/luxun9527/gex/app/order/api/internal/svc"
 
@@ -29,6 +30,7 @@ func main() {
 
 	ctx := svc.NewServiceContext(c)
 	handler.RegisterHandlers(server, ctx)
+	countdown.Start(ctx)
 	logx.SetLevel(logx.DebugLevel)
 	logx.SetWriter(logger.NewZapWriter(logger.GetZapLogger()))
 	logx.Infof("Starting server at %s:%d...\n", c.Host, c.Port)
@@ -13,6 +13,8 @@ import (
 type Config struct {
 	zrpc.RpcServerConf
 	AccountRpcConf zrpc.RpcClientConf
+	//按照数量的市价买单查询深度
+	MatchRpcConf zrpc.RpcClientConf
 	//dtm使用
 	OrderRpcConf     zrpc.RpcClientConf
 	DtmConf          zrpc.RpcClientConf
@@ -66,6 +66,16 @@ func InitConsumer(sc *svc.ServiceContext) {
 				if err := matchResultHandler.CancelOrder(r, storeConsumedMessageId); err != nil {
 					logx.Severef("[consumer] handle cancel order message failed err=%v data=%v", err, r)
 				}
+			case *matchMq.MatchResp_Trigger:
+				logx.Debugw("receive match trigger data ", logx.Field("data", r))
+				if err := matchResultHandler.TriggerOrder(r, storeConsumedMessageId); err != nil {
+					logx.Severef("[consumer] handle trigger order message failed err=%v data=%v", err, r)
+				}
+			case *matchMq.MatchResp_Amend:
+				logx.Debugw("receive match amend data ", logx.Field("data", r))
+				if err := matchResultHandler.AmendOrder(r, storeConsumedMessageId); err != nil {
+					logx.Severef("[consumer] handle amend order message failed err=%v data=%v", err, r)
+				}
 			}
 			if err := sc.MatchConsumer.Ack(message); err != nil {
 				logx.Errorw("ack message failed", logger.ErrorField(err))
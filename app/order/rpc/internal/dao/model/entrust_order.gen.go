@@ -8,25 +8,37 @@ const TableNameEntrustOrder = "entrust_order"
 
 // EntrustOrder mapped from table <entrust_order>
 type EntrustOrder struct {
-	ID             int64  `gorm:"column:id;primaryKey;comment:序号 主键 雪花算法生成，递增" json:"id"`
-	OrderID        string `gorm:"column:order_id;not null;comment:订单号" json:"order_id"`
-	UserID         int64  `gorm:"column:user_id;not null;comment:用户id" json:"user_id"`
-	SymbolID       int32  `gorm:"column:symbol_id;not null;comment:交易对ID" json:"symbol_id"`
-	SymbolName     string `gorm:"column:symbol_name;not null;comment:交易对名称" json:"symbol_name"`
-	Qty            string `gorm:"column:qty;not null;comment:下单数量" json:"qty"`
-	Price          string `gorm:"column:price;not null;comment:价格" json:"price"`
-	Side           int32  `gorm:"column:side;not null;comment:方向1买 2卖" json:"side"`
-	Amount         string `gorm:"column:amount;not null;comment:金额" json:"amount"`
-	Status         int32  `gorm:"column:status;not null;comment:状态1新订单2部分成交 3全部成交，4撤销，5无效订单" json:"status"`
-	OrderType      int32  `gorm:"column:order_type;not null;comment:订单类型1市价单2限价单" json:"order_type"`
-	FilledQty      string `gorm:"column:filled_qty;not null;comment:成交数量" json:"filled_qty"`
-	UnFilledQty    string `gorm:"column:un_filled_qty;not null;comment:未成交数量" json:"un_filled_qty"`
-	FilledAvgPrice string `gorm:"column:filled_avg_price;not null;comment:成交均价" json:"filled_avg_price"`
-	FilledAmount   string `gorm:"column:filled_amount;not null;comment:成交金额" json:"filled_amount"`
-	UnFilledAmount string `gorm:"column:un_filled_amount;not null;comment:未成交金额" json:"un_filled_amount"`
-	CreatedAt      int64  `gorm:"column:created_at;not null;comment:创建时间" json:"created_at"`
-	UpdatedAt      int64  `gorm:"column:updated_at;not null;comment:修改时间" json:"updated_at"`
-	DeletedAt      int64  `gorm:"column:deleted_at;not null;comment:删除时间" json:"deleted_at"`
+	ID              int64  `gorm:"column:id;primaryKey;comment:序号 主键 雪花算法生成，递增" json:"id"`
+	OrderID         string `gorm:"column:order_id;not null;comment:订单号" json:"order_id"`
+	UserID          int64  `gorm:"column:user_id;not null;comment:用户id" json:"user_id"`
+	SymbolID        int32  `gorm:"column:symbol_id;not null;comment:交易对ID" json:"symbol_id"`
+	SymbolName      string `gorm:"column:symbol_name;not null;comment:交易对名称" json:"symbol_name"`
+	Qty             string `gorm:"column:qty;not null;comment:下单数量" json:"qty"`
+	Price           string `gorm:"column:price;not null;comment:价格" json:"price"`
+	Side            int32  `gorm:"column:side;not null;comment:方向1买 2卖" json:"side"`
+	Amount          string `gorm:"column:amount;not null;comment:金额" json:"amount"`
+	Status          int32  `gorm:"column:status;not null;comment:状态1新订单2部分成交 3全部成交，4撤销，5无效订单" json:"status"`
+	OrderType       int32  `gorm:"column:order_type;not null;comment:订单类型1市价单2限价单" json:"order_type"`
+	FilledQty       string `gorm:"column:filled_qty;not null;comment:成交数量" json:"filled_qty"`
+	UnFilledQty     string `gorm:"column:un_filled_qty;not null;comment:未成交数量" json:"un_filled_qty"`
+	FilledAvgPrice  string `gorm:"column:filled_avg_price;not null;comment:成交均价" json:"filled_avg_price"`
+	FilledAmount    string `gorm:"column:filled_amount;not null;comment:成交金额" json:"filled_amount"`
+	UnFilledAmount  string `gorm:"column:un_filled_amount;not null;comment:未成交金额" json:"un_filled_amount"`
+	CreatedAt       int64  `gorm:"column:created_at;not null;comment:创建时间" json:"created_at"`
+	UpdatedAt       int64  `gorm:"column:updated_at;not null;comment:修改时间" json:"updated_at"`
+	DeletedAt       int64  `gorm:"column:deleted_at;not null;comment:删除时间" json:"deleted_at"`
+	PostOnly        int32  `gorm:"column:post_only;not null;comment:是否只做maker 0否 1是" json:"post_only"`
+	TriggerPrice    string `gorm:"column:trigger_price;not null;comment:触发价格 0表示不是条件单" json:"trigger_price"`
+	TriggerStatus   int32  `gorm:"column:trigger_status;not null;comment:条件单触发状态 0不是条件单 1待触发 2已触发" json:"trigger_status"`
+	StpMode         int32  `gorm:"column:stp_mode;not null;comment:自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量" json:"stp_mode"`
+	MakerFeeRate    string `gorm:"column:maker_fee_rate;not null;comment:maker手续费率" json:"maker_fee_rate"`
+	TakerFeeRate    string `gorm:"column:taker_fee_rate;not null;comment:taker手续费率" json:"taker_fee_rate"`
+	Fee             string `gorm:"column:fee;not null;comment:累计手续费 买单为基础币 卖单为计价币" json:"fee"`
+	DisplayQty      string `gorm:"column:display_qty;not null;comment:冰山单每次显示的数量 0表示不是冰山单" json:"display_qty"`
+	ExpireTime      int64  `gorm:"column:expire_time;not null;comment:过期时间 单位秒 0表示一直有效" json:"expire_time"`
+	MaxSlippage     string `gorm:"column:max_slippage;not null;comment:市价单的最大滑点 0表示不限制" json:"max_slippage"`
+	ProtectionPrice string `gorm:"column:protection_price;not null;comment:市价单的保护价格 0表示不限制" json:"protection_price"`
+	QueueID         int64  `gorm:"column:queue_id;not null;comment:修改订单之后重新排队的序号 0表示按照订单id排队" json:"queue_id"`
 }
 
 // TableName EntrustOrder's table name
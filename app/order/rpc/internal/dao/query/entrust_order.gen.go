@@ -46,6 +46,18 @@ func newEntrustOrder(db *gorm.DB, opts ...gen.DOOption) entrustOrder {
 	_entrustOrder.CreatedAt = field.NewInt64(tableName, "created_at")
 	_entrustOrder.UpdatedAt = field.NewInt64(tableName, "updated_at")
 	_entrustOrder.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_entrustOrder.PostOnly = field.NewInt32(tableName, "post_only")
+	_entrustOrder.TriggerPrice = field.NewString(tableName, "trigger_price")
+	_entrustOrder.TriggerStatus = field.NewInt32(tableName, "trigger_status")
+	_entrustOrder.StpMode = field.NewInt32(tableName, "stp_mode")
+	_entrustOrder.MakerFeeRate = field.NewString(tableName, "maker_fee_rate")
+	_entrustOrder.TakerFeeRate = field.NewString(tableName, "taker_fee_rate")
+	_entrustOrder.Fee = field.NewString(tableName, "fee")
+	_entrustOrder.DisplayQty = field.NewString(tableName, "display_qty")
+	_entrustOrder.ExpireTime = field.NewInt64(tableName, "expire_time")
+	_entrustOrder.MaxSlippage = field.NewString(tableName, "max_slippage")
+	_entrustOrder.ProtectionPrice = field.NewString(tableName, "protection_price")
+	_entrustOrder.QueueID = field.NewInt64(tableName, "queue_id")
 
 	_entrustOrder.fillFieldMap()
 
@@ -55,26 +67,38 @@ func newEntrustOrder(db *gorm.DB, opts ...gen.DOOption) entrustOrder {
 type entrustOrder struct {
 	entrustOrderDo entrustOrderDo
 
-	ALL            field.Asterisk
-	ID             field.Int64  // 序号 主键 雪花算法生成，递增
-	OrderID        field.String // 订单号
-	UserID         field.Int64  // 用户id
-	SymbolID       field.Int32  // 交易对ID
-	SymbolName     field.String // 交易对名称
-	Qty            field.String // 下单数量
-	Price          field.String // 价格
-	Side           field.Int32  // 方向1买 2卖
-	Amount         field.String // 金额
-	Status         field.Int32  // 状态1新订单2部分成交 3全部成交，4撤销，5无效订单
-	OrderType      field.Int32  // 订单类型1市价单2限价单
-	FilledQty      field.String // 成交数量
-	UnFilledQty    field.String // 未成交数量
-	FilledAvgPrice field.String // 成交均价
-	FilledAmount   field.String // 成交金额
-	UnFilledAmount field.String // 未成交金额
-	CreatedAt      field.Int64  // 创建时间
-	UpdatedAt      field.Int64  // 修改时间
-	DeletedAt      field.Int64  // 删除时间
+	ALL             field.Asterisk
+	ID              field.Int64  // 序号 主键 雪花算法生成，递增
+	OrderID         field.String // 订单号
+	UserID          field.Int64  // 用户id
+	SymbolID        field.Int32  // 交易对ID
+	SymbolName      field.String // 交易对名称
+	Qty             field.String // 下单数量
+	Price           field.String // 价格
+	Side            field.Int32  // 方向1买 2卖
+	Amount          field.String // 金额
+	Status          field.Int32  // 状态1新订单2部分成交 3全部成交，4撤销，5无效订单
+	OrderType       field.Int32  // 订单类型1市价单2限价单
+	FilledQty       field.String // 成交数量
+	UnFilledQty     field.String // 未成交数量
+	FilledAvgPrice  field.String // 成交均价
+	FilledAmount    field.String // 成交金额
+	UnFilledAmount  field.String // 未成交金额
+	CreatedAt       field.Int64  // 创建时间
+	UpdatedAt       field.Int64  // 修改时间
+	DeletedAt       field.Int64  // 删除时间
+	PostOnly        field.Int32  // 是否只做maker 0否 1是
+	TriggerPrice    field.String // 触发价格 0表示不是条件单
+	TriggerStatus   field.Int32  // 条件单触发状态 0不是条件单 1待触发 2已触发
+	StpMode         field.Int32  // 自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量
+	MakerFeeRate    field.String // maker手续费率
+	TakerFeeRate    field.String // taker手续费率
+	Fee             field.String // 累计手续费 买单为基础币 卖单为计价币
+	DisplayQty      field.String // 冰山单每次显示的数量 0表示不是冰山单
+	ExpireTime      field.Int64  // 过期时间 单位秒 0表示一直有效
+	MaxSlippage     field.String // 市价单的最大滑点 0表示不限制
+	ProtectionPrice field.String // 市价单的保护价格 0表示不限制
+	QueueID         field.Int64  // 修改订单之后重新排队的序号 0表示按照订单id排队
 
 	fieldMap map[string]field.Expr
 }
@@ -110,6 +134,18 @@ func (e *entrustOrder) updateTableName(table string) *entrustOrder {
 	e.CreatedAt = field.NewInt64(table, "created_at")
 	e.UpdatedAt = field.NewInt64(table, "updated_at")
 	e.DeletedAt = field.NewInt64(table, "deleted_at")
+	e.PostOnly = field.NewInt32(table, "post_only")
+	e.TriggerPrice = field.NewString(table, "trigger_price")
+	e.TriggerStatus = field.NewInt32(table, "trigger_status")
+	e.StpMode = field.NewInt32(table, "stp_mode")
+	e.MakerFeeRate = field.NewString(table, "maker_fee_rate")
+	e.TakerFeeRate = field.NewString(table, "taker_fee_rate")
+	e.Fee = field.NewString(table, "fee")
+	e.DisplayQty = field.NewString(table, "display_qty")
+	e.ExpireTime = field.NewInt64(table, "expire_time")
+	e.MaxSlippage = field.NewString(table, "max_slippage")
+	e.ProtectionPrice = field.NewString(table, "protection_price")
+	e.QueueID = field.NewInt64(table, "queue_id")
 
 	e.fillFieldMap()
 
@@ -134,7 +170,7 @@ func (e *entrustOrder) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (e *entrustOrder) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 19)
+	e.fieldMap = make(map[string]field.Expr, 31)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["order_id"] = e.OrderID
 	e.fieldMap["user_id"] = e.UserID
@@ -154,6 +190,18 @@ func (e *entrustOrder) fillFieldMap() {
 	e.fieldMap["created_at"] = e.CreatedAt
 	e.fieldMap["updated_at"] = e.UpdatedAt
 	e.fieldMap["deleted_at"] = e.DeletedAt
+	e.fieldMap["post_only"] = e.PostOnly
+	e.fieldMap["trigger_price"] = e.TriggerPrice
+	e.fieldMap["trigger_status"] = e.TriggerStatus
+	e.fieldMap["stp_mode"] = e.StpMode
+	e.fieldMap["maker_fee_rate"] = e.MakerFeeRate
+	e.fieldMap["taker_fee_rate"] = e.TakerFeeRate
+	e.fieldMap["fee"] = e.Fee
+	e.fieldMap["display_qty"] = e.DisplayQty
+	e.fieldMap["expire_time"] = e.ExpireTime
+	e.fieldMap["max_slippage"] = e.MaxSlippage
+	e.fieldMap["protection_price"] = e.ProtectionPrice
+	e.fieldMap["queue_id"] = e.QueueID
 }
 
 func (e entrustOrder) clone(db *gorm.DB) entrustOrder {
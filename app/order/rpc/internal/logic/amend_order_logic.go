@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"github.com/dtm-labs/client/dtmgrpc"
+	accountpb "github.com/luxun9527/gex/app/account/rpc/pb"
+	"github.com/luxun9527/gex/app/order/rpc/internal/dao/model"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/enum"
+	"github.com/luxun9527/gex/common/utils"
+	commonUtils "github.com/luxun9527/gex/common/utils"
+	logger "github.com/luxun9527/zlog"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"github.com/luxun9527/gex/app/order/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/order/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AmendOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAmendOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AmendOrderLogic {
+	return &AmendOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AmendOrder 修改订单的价格和数量。
+// 需要额外冻结资产时通过分布式事务先冻结再发送修改的消息，否则直接发送，撮合之后解冻多冻结的部分。
+func (l *AmendOrderLogic) AmendOrder(in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, in.Uid))
+
+	order, err := entrustOrder.WithContext(l.ctx).
+		Select(entrustOrder.UserID, entrustOrder.Status, entrustOrder.Side, entrustOrder.Price, entrustOrder.Qty, entrustOrder.FilledQty, entrustOrder.OrderType, entrustOrder.TriggerStatus).
+		Where(entrustOrder.ID.Eq(in.Id)).
+		First()
+	if err != nil {
+		if errors.Is(gorm.ErrRecordNotFound, err) {
+			return nil, errs.OrderNotFound
+		}
+		logx.Errorw("query entrustOrder failed", logger.ErrorField(err))
+		return nil, errs.ExecSqlFailed
+	}
+	if order.UserID != in.Uid {
+		return nil, errs.OrderNotFound
+	}
+	//只有在订单簿中的限价单可以修改
+	if enum.OrderType(order.OrderType) != enum.OrderType_LO || enum.TriggerStatus(order.TriggerStatus) == enum.TriggerStatus_Untriggered {
+		return nil, errs.ErrAmendOrderType
+	}
+	if enum.OrderStatus(order.Status) != enum.OrderStatus_NewCreated && enum.OrderStatus(order.Status) != enum.OrderStatus_PartFilled {
+		return nil, errs.OrderHasResolved
+	}
+	var (
+		oldPrice, oldQty = utils.NewFromStringMaxPrec(order.Price), utils.NewFromStringMaxPrec(order.Qty)
+		newPrice, newQty = utils.NewFromStringMaxPrec(in.Price), utils.NewFromStringMaxPrec(in.Qty)
+		filledQty        = utils.NewFromStringMaxPrec(order.FilledQty)
+	)
+	if !newPrice.IsPositive() {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "price must is a number")
+	}
+	if newQty.LessThanOrEqual(filledQty) {
+		return nil, errs.ErrAmendQty
+	}
+	if newPrice.Equal(oldPrice) && newQty.Equal(oldQty) {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "price and qty not changed")
+	}
+	in.OldPrice = order.Price
+	in.Side = enum.Side(order.Side)
+	frozenQty := amendFrozenQty(in.Side, oldPrice, oldQty, newPrice, newQty, filledQty)
+	in.FrozenQty = frozenQty.String()
+
+	//不需要额外冻结直接发送
+	if !frozenQty.IsPositive() {
+		if err := sendAmendOrder(l.ctx, l.svcCtx, in); err != nil {
+			logx.Errorw("AmendOrder send message failed", logger.ErrorField(err))
+			return nil, errs.PulsarErr
+		}
+		return &pb.OrderEmpty{}, nil
+	}
+
+	freezeReq := &accountpb.FreezeUserAssetReq{
+		Uid:    in.Uid,
+		CoinId: l.svcCtx.Config.SymbolInfo.BaseCoinID,
+		Qty:    in.FrozenQty,
+	}
+	if in.Side == enum.Side_Buy {
+		freezeReq.CoinId = l.svcCtx.Config.SymbolInfo.QuoteCoinID
+	}
+	gid, err := l.svcCtx.DtmClient.NewGid(l.ctx, &emptypb.Empty{})
+	if err != nil {
+		logx.Errorw("get gid failed", logger.ErrorField(err))
+		return nil, errs.DtmErr
+	}
+	accountTarget, err := l.svcCtx.Config.AccountRpcConf.BuildTarget()
+	if err != nil {
+		logx.Errorw("get account client failed", logger.ErrorField(err))
+		return nil, errs.Internal
+	}
+	orderTarget, err := l.svcCtx.Config.OrderRpcConf.BuildTarget()
+	if err != nil {
+		logx.Errorw("get order client failed", logger.ErrorField(err))
+		return nil, errs.Internal
+	}
+	var (
+		freezeUserAddr       = accountTarget + "/account.AccountService/FreezeUserAsset"
+		unFreezeUserAddr     = accountTarget + "/account.AccountService/UnFreezeUserAsset"
+		sendAmendOrderAddr   = orderTarget + "/order.OrderService/SendAmendOrder"
+		sendAmendOrderRevert = orderTarget + "/order.OrderService/SendAmendOrderRevert"
+	)
+	dtmAddr, err := l.svcCtx.Config.DtmConf.BuildTarget()
+	if err != nil {
+		logx.Errorw("get dtm client failed", logger.ErrorField(err))
+		return nil, err
+	}
+	sagaGrpc := dtmgrpc.NewSagaGrpc(dtmAddr, gid.Gid)
+	sagaGrpc.WaitResult = true
+	if err := sagaGrpc.
+		Add(freezeUserAddr, unFreezeUserAddr, freezeReq).
+		Add(sendAmendOrderAddr, sendAmendOrderRevert, in).Submit(); err != nil {
+		logx.Errorw("Submit amend order saga failed", logger.ErrorField(err))
+		return nil, castSagaError(err)
+	}
+	return &pb.OrderEmpty{}, nil
+}
+
+// amendFrozenQty 修改订单需要额外冻结的数量，卖单冻结基础币，买单按照价格冻结计价币。
+// 订单在修改消息到达撮合之前可能继续成交，买单需要的冻结数量随着成交数量变化，
+// 取成交数量在可能范围内的最大值，多冻结的部分在撮合之后解冻。
+func amendFrozenQty(side enum.Side, oldPrice, oldQty, newPrice, newQty, filledQty decimal.Decimal) decimal.Decimal {
+	if side == enum.Side_Sell {
+		return decimal.Max(newQty.Sub(oldQty), decimal.Zero)
+	}
+	frozen := func(filled decimal.Decimal) decimal.Decimal {
+		return newQty.Sub(filled).Mul(newPrice).Sub(oldQty.Sub(filled).Mul(oldPrice))
+	}
+	return decimal.Max(frozen(filledQty), frozen(decimal.Min(oldQty, newQty)), decimal.Zero)
+}
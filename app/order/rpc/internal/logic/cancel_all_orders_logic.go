@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/luxun9527/gex/app/order/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/order/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAllOrdersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelAllOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAllOrdersLogic {
+	return &CancelAllOrdersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelAllOrders 撤销用户在交易对的所有订单,撮合引擎根据用户id查找订单,不需要查询数据库。
+// 每个订单撤销之后撮合引擎单独返回一个撤单结果,订单状态和解冻资产按照单个撤单处理。
+func (l *CancelAllOrdersLogic) CancelAllOrders(in *pb.CancelAllOrdersReq) (*pb.OrderEmpty, error) {
+	if in.Uid == 0 {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "uid is required")
+	}
+	if in.Side != enum.Side_UnknownSide && in.Side != enum.Side_Buy && in.Side != enum.Side_Sell {
+		return nil, errs.WarpMessage(errs.ParamValidateFailed, "side is invalid")
+	}
+	cancelReq := &matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_CancelAll{
+			CancelAll: &matchMq.CancelAllOperate{
+				Uid:  in.Uid,
+				Side: in.Side,
+			},
+		},
+	}
+	data, _ := proto.Marshal(cancelReq)
+	if _, err := l.svcCtx.MatchProducer.Send(l.ctx, &pulsar.ProducerMessage{
+		Payload: data,
+	}); err != nil {
+		return nil, errs.PulsarErr
+	}
+	return &pb.OrderEmpty{}, nil
+}
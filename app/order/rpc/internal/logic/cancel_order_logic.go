@@ -39,7 +39,7 @@ func (l *CancelOrderLogic) CancelOrder(in *pb.CancelOrderReq) (*pb.OrderEmpty, e
 	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, in.Uid))
 
 	order, err := entrustOrder.WithContext(l.ctx).
-		Select(entrustOrder.UserID, entrustOrder.Status, entrustOrder.Side, entrustOrder.Price, entrustOrder.OrderType).
+		Select(entrustOrder.UserID, entrustOrder.Status, entrustOrder.Side, entrustOrder.Price, entrustOrder.OrderType, entrustOrder.TriggerStatus).
 		Where(entrustOrder.ID.Eq(in.Id)).
 		First()
 	if err != nil {
@@ -53,8 +53,8 @@ func (l *CancelOrderLogic) CancelOrder(in *pb.CancelOrderReq) (*pb.OrderEmpty, e
 	if order.UserID != in.Uid {
 		return nil, errs.ExecSqlFailed
 	}
-	//市价单不能手动取消
-	if enum.OrderType(order.OrderType) == enum.OrderType_MO {
+	//只有限价单和未触发的条件单可以手动取消,市价单、FOK、IOC不会进入订单簿
+	if enum.OrderType(order.OrderType) != enum.OrderType_LO && enum.TriggerStatus(order.TriggerStatus) != enum.TriggerStatus_Untriggered {
 		return nil, errs.LoOrderCancelFailed
 	}
 	//订单状态不对
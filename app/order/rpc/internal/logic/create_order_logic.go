@@ -44,7 +44,10 @@ func (l *CreateOrderLogic) CreateOrder(in *pb.CreateOrderReq) (*pb.OrderEmpty, e
 	if in.OrderType == enum.OrderType_MO {
 		if in.Side == enum.Side_Buy {
 			in.Price = "0"
-			in.Qty = "0"
+			//按照数量的市价买单保留数量
+			if in.Qty == "" {
+				in.Qty = "0"
+			}
 		} else {
 			in.Price = "0"
 			in.Amount = "0"
@@ -53,12 +56,21 @@ func (l *CreateOrderLogic) CreateOrder(in *pb.CreateOrderReq) (*pb.OrderEmpty, e
 	//订单Id的规则
 	//市价单MO
 	//限价单LO
+	//立即全部成交否则取消FOK
+	//立即成交剩余取消IOC
 	//买1 卖 2
 	orderId := "mo"
-	if in.OrderType == enum.OrderType_LO {
+	switch in.OrderType {
+	case enum.OrderType_LO:
 		orderId = "lo"
+	case enum.OrderType_FOK:
+		orderId = "fok"
+	case enum.OrderType_IOC:
+		orderId = "ioc"
 	}
 	orderId = fmt.Sprintf("%v%v%v", orderId, int32(in.Side), idgen.NextId())
+	//手续费率在下单时确定，之后修改交易对的手续费率不影响已经下的订单
+	makerFeeRate, takerFeeRate := l.svcCtx.Config.SymbolInfo.GetFeeRate(in.VipLevel)
 
 	order := &model.EntrustOrder{
 		ID:             idgen.NextId(),
@@ -78,6 +90,34 @@ func (l *CreateOrderLogic) CreateOrder(in *pb.CreateOrderReq) (*pb.OrderEmpty, e
 		FilledAmount:   "0",
 		UnFilledAmount: in.Amount,
 		CreatedAt:      time.Now().Unix(),
+		StpMode:        int32(in.StpMode),
+		MakerFeeRate:   makerFeeRate,
+		TakerFeeRate:   takerFeeRate,
+		Fee:            "0",
+	}
+	if in.PostOnly {
+		order.PostOnly = 1
+	}
+	//条件单，触发之前不进入订单簿
+	order.TriggerPrice = "0"
+	if in.TriggerPrice != "" {
+		order.TriggerPrice = in.TriggerPrice
+		order.TriggerStatus = int32(enum.TriggerStatus_Untriggered)
+	}
+	//冰山单
+	order.DisplayQty = "0"
+	if in.DisplayQty != "" {
+		order.DisplayQty = in.DisplayQty
+	}
+	//限时单
+	order.ExpireTime = in.ExpireTime
+	//市价单的滑点保护
+	order.MaxSlippage, order.ProtectionPrice = "0", "0"
+	if in.MaxSlippage != "" {
+		order.MaxSlippage = in.MaxSlippage
+	}
+	if in.ProtectionPrice != "" {
+		order.ProtectionPrice = in.ProtectionPrice
 	}
 
 	barrier, err := dtmgrpc.BarrierFromGrpc(l.ctx)
@@ -108,14 +148,24 @@ func (l *CreateOrderLogic) CreateOrder(in *pb.CreateOrderReq) (*pb.OrderEmpty, e
 	//构建消息发送
 	msg := &matchMq.MatchReq{Operate: &matchMq.MatchReq_NewOrder{
 		NewOrder: &matchMq.NewOrderOperate{
-			OrderId:    order.OrderID,
-			SequenceId: order.ID,
-			Uid:        order.UserID,
-			Side:       in.Side,
-			Price:      in.Price,
-			Qty:        in.Qty,
-			Amount:     in.Amount,
-			OrderType:  in.OrderType,
+			OrderId:         order.OrderID,
+			SequenceId:      order.ID,
+			Uid:             order.UserID,
+			Side:            in.Side,
+			Price:           in.Price,
+			Qty:             in.Qty,
+			Amount:          in.Amount,
+			OrderType:       in.OrderType,
+			PostOnly:        in.PostOnly,
+			TriggerPrice:    in.TriggerPrice,
+			StpMode:         in.StpMode,
+			MakerFeeRate:    makerFeeRate,
+			TakerFeeRate:    takerFeeRate,
+			DisplayQty:      in.DisplayQty,
+			ExpireTime:      in.ExpireTime,
+			MaxSlippage:     in.MaxSlippage,
+			ProtectionPrice: in.ProtectionPrice,
+			ByQty:           in.OrderType == enum.OrderType_MO && in.Side == enum.Side_Buy && commonUtils.NewFromStringMaxPrec(in.Qty).IsPositive(),
 		},
 	}}
 	logx.Infow("send message", logx.Field("msg", msg))
@@ -142,6 +192,9 @@ func (l *CreateOrderLogic) CreateOrder(in *pb.CreateOrderReq) (*pb.OrderEmpty, e
 		FilledAvgPrice: commonUtils.PrecCut(order.FilledAvgPrice, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
 		Uid:            cast.ToString(order.UserID),
 		CreatedAt:      order.CreatedAt,
+		TriggerPrice:   commonUtils.PrecCut(order.TriggerPrice, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+		TriggerStatus:  int8(order.TriggerStatus),
+		Fee:            order.Fee,
 	}
 	l.pushWsData(wsOrder)
 	return &pb.OrderEmpty{}, nil
@@ -45,16 +45,28 @@ func (l *GetOrderAllPendingOrderLogic) GetOrderAllPendingOrder(in *pb.OrderEmpty
 			}
 			for _, v := range result {
 				d := &pb.GetOrderAllPendingOrderResp{
-					OrderId:        v.OrderID,
-					SequenceId:     v.ID,
-					Uid:            v.UserID,
-					Side:           enum.Side(v.Side),
-					Price:          v.Price,
-					Qty:            v.Qty,
-					Amount:         v.Amount,
-					OrderType:      enum.OrderType(v.OrderType),
-					UnFilledAmount: v.UnFilledAmount,
-					UnFilledQty:    v.UnFilledQty,
+					OrderId:         v.OrderID,
+					SequenceId:      v.ID,
+					Uid:             v.UserID,
+					Side:            enum.Side(v.Side),
+					Price:           v.Price,
+					Qty:             v.Qty,
+					Amount:          v.Amount,
+					OrderType:       enum.OrderType(v.OrderType),
+					UnFilledAmount:  v.UnFilledAmount,
+					UnFilledQty:     v.UnFilledQty,
+					PostOnly:        v.PostOnly == 1,
+					TriggerPrice:    v.TriggerPrice,
+					TriggerStatus:   enum.TriggerStatus(v.TriggerStatus),
+					StpMode:         enum.STPMode(v.StpMode),
+					MakerFeeRate:    v.MakerFeeRate,
+					TakerFeeRate:    v.TakerFeeRate,
+					Fee:             v.Fee,
+					DisplayQty:      v.DisplayQty,
+					ExpireTime:      v.ExpireTime,
+					MaxSlippage:     v.MaxSlippage,
+					ProtectionPrice: v.ProtectionPrice,
+					QueueId:         v.QueueID,
 				}
 				if err := stream.Send(d); err != nil {
 					logx.Errorw("send order to match failed", logx.Field("err", err))
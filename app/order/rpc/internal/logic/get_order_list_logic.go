@@ -78,6 +78,8 @@ func (l *GetOrderListLogic) GetOrderList(in *pb.GetOrderListByUserReq) (*pb.GetO
 			FilledAmount:   utils.PrecCut(v.FilledAmount, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
 			FilledAvgPrice: utils.PrecCut(v.FilledAvgPrice, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
 			CreatedAt:      v.CreatedAt,
+			TriggerPrice:   utils.PrecCut(v.TriggerPrice, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+			TriggerStatus:  enum.TriggerStatus(v.TriggerStatus),
 		}
 		orders = append(orders, order)
 	}
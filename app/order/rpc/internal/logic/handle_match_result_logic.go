@@ -66,11 +66,13 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 				Status:         int32(v.Maker.OrderStatus),
 				ID:             v.Maker.Id,
 				UserID:         v.Maker.Uid,
+				Fee:            v.Maker.Fee,
+				QueueID:        v.Maker.QueueId,
 			}
 			makerOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(order.TableName(), order.UserID))
 
 			if _, err := makerOrder.WithContext(context.Background()).
-				Select(makerOrder.FilledQty, makerOrder.UnFilledQty, makerOrder.FilledAvgPrice, makerOrder.FilledAmount, makerOrder.UnFilledAmount, makerOrder.Status).
+				Select(makerOrder.FilledQty, makerOrder.UnFilledQty, makerOrder.FilledAvgPrice, makerOrder.FilledAmount, makerOrder.UnFilledAmount, makerOrder.Status, makerOrder.Fee, makerOrder.QueueID).
 				Where(makerOrder.ID.Eq(order.ID)).
 				Updates(order); err != nil {
 				return err
@@ -81,6 +83,7 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 				Status:       int8(order.Status),
 				FilledAmount: utils.PrecCut(order.FilledAmount, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
 				Uid:          cast.ToString(order.UserID),
+				Fee:          utils.PrecCut(order.Fee, l.feePrec(!result.MatchResult.TakerIsBuy)),
 			}
 			l.oc <- wsOrder
 
@@ -99,6 +102,7 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 			Status:         int32(taker.OrderStatus),
 			ID:             taker.Id,
 			UserID:         taker.Uid,
+			Fee:            taker.Fee,
 		}
 		wsOrder := &commonWs.Order{
 			Id:           cast.ToString(order.ID),
@@ -106,11 +110,12 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 			Status:       int8(order.Status),
 			FilledAmount: utils.PrecCut(order.FilledAmount, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
 			Uid:          cast.ToString(order.UserID),
+			Fee:          utils.PrecCut(order.Fee, l.feePrec(result.MatchResult.TakerIsBuy)),
 		}
 		takerOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(order.TableName(), order.UserID))
 
 		if _, err := tx.EntrustOrder.Table(commonUtils.WithShardingSuffix(order.TableName(), order.UserID)).WithContext(context.Background()).
-			Select(takerOrder.FilledQty, takerOrder.UnFilledQty, takerOrder.FilledAvgPrice, takerOrder.FilledAmount, takerOrder.UnFilledAmount, takerOrder.Status).
+			Select(takerOrder.FilledQty, takerOrder.UnFilledQty, takerOrder.FilledAvgPrice, takerOrder.FilledAmount, takerOrder.UnFilledAmount, takerOrder.Status, takerOrder.Fee).
 			Where(takerOrder.ID.Eq(order.ID)).
 			Updates(order); err != nil {
 			return err
@@ -130,18 +135,34 @@ func (l *HandleMatchResultLogic) HandleMatchResult(result *matchMq.MatchResp_Mat
 	return nil
 }
 
+// feePrec 手续费的精度，买单收取基础币，卖单收取计价币
+func (l *HandleMatchResultLogic) feePrec(isBuy bool) int32 {
+	if isBuy {
+		return l.svcCtx.Config.SymbolInfo.BaseCoinPrec.Load()
+	}
+	return l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()
+}
+
 // CancelOrder  取消订单
 func (l *HandleMatchResultLogic) CancelOrder(resp *matchMq.MatchResp_Cancel, storeConsumedMessageId func() error) error {
+	if resp.Cancel.DecrementQty != "" {
+		return l.DecrementOrder(resp, storeConsumedMessageId)
+	}
 
+	//限时单到期之后撮合引擎撤销，状态为过期
+	status := enum.OrderStatus_Canceled
+	if resp.Cancel.Expired {
+		status = enum.OrderStatus_Expired
+	}
 	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, resp.Cancel.Uid))
 	if _, err := entrustOrder.WithContext(context.Background()).
 		Where(entrustOrder.ID.Eq(resp.Cancel.Id)).
-		Update(entrustOrder.Status, int32(enum.OrderStatus_Canceled)); err != nil {
+		Update(entrustOrder.Status, int32(status)); err != nil {
 		return err
 	}
 	wsOrder := &commonWs.Order{
 		Id:     cast.ToString(resp.Cancel.Id),
-		Status: int8(enum.OrderStatus_Canceled),
+		Status: int8(status),
 		Uid:    cast.ToString(resp.Cancel.Uid),
 	}
 	if err := storeConsumedMessageId(); err != nil {
@@ -150,3 +171,95 @@ func (l *HandleMatchResultLogic) CancelOrder(resp *matchMq.MatchResp_Cancel, sto
 	l.oc <- wsOrder
 	return nil
 }
+
+// DecrementOrder  自成交保护减少订单的数量和未成交数量，订单没有撤销
+func (l *HandleMatchResultLogic) DecrementOrder(resp *matchMq.MatchResp_Cancel, storeConsumedMessageId func() error) error {
+
+	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, resp.Cancel.Uid))
+	if _, err := entrustOrder.WithContext(context.Background()).
+		Where(entrustOrder.ID.Eq(resp.Cancel.Id)).
+		UpdateSimple(
+			entrustOrder.Qty.Value(resp.Cancel.OrderQty),
+			entrustOrder.Amount.Value(resp.Cancel.OrderAmount),
+			entrustOrder.UnFilledQty.Value(resp.Cancel.UnFilledQty),
+			entrustOrder.UnFilledAmount.Value(resp.Cancel.UnFilledAmount),
+		); err != nil {
+		return err
+	}
+	order, err := entrustOrder.WithContext(context.Background()).
+		Select(entrustOrder.Status, entrustOrder.FilledQty, entrustOrder.FilledAmount).
+		Where(entrustOrder.ID.Eq(resp.Cancel.Id)).
+		First()
+	if err != nil {
+		return err
+	}
+	wsOrder := &commonWs.Order{
+		Id:           cast.ToString(resp.Cancel.Id),
+		FilledQty:    utils.PrecCut(order.FilledQty, l.svcCtx.Config.SymbolInfo.BaseCoinPrec.Load()),
+		Status:       int8(order.Status),
+		FilledAmount: utils.PrecCut(order.FilledAmount, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+		Uid:          cast.ToString(resp.Cancel.Uid),
+	}
+	if err := storeConsumedMessageId(); err != nil {
+		return err
+	}
+	l.oc <- wsOrder
+	return nil
+}
+
+// AmendOrder  修改订单，修改失败订单不变
+func (l *HandleMatchResultLogic) AmendOrder(resp *matchMq.MatchResp_Amend, storeConsumedMessageId func() error) error {
+	if resp.Amend.Rejected {
+		return storeConsumedMessageId()
+	}
+	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, resp.Amend.Uid))
+	if _, err := entrustOrder.WithContext(context.Background()).
+		Where(entrustOrder.ID.Eq(resp.Amend.Id)).
+		UpdateSimple(
+			entrustOrder.Price.Value(resp.Amend.Price),
+			entrustOrder.Qty.Value(resp.Amend.Qty),
+			entrustOrder.Amount.Value(resp.Amend.Amount),
+			entrustOrder.UnFilledQty.Value(resp.Amend.UnFilledQty),
+			entrustOrder.UnFilledAmount.Value(resp.Amend.UnFilledAmount),
+			entrustOrder.QueueID.Value(resp.Amend.QueueId),
+		); err != nil {
+		return err
+	}
+	wsOrder := &commonWs.Order{
+		Id:     cast.ToString(resp.Amend.Id),
+		Price:  utils.PrecCut(resp.Amend.Price, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+		Qty:    utils.PrecCut(resp.Amend.Qty, l.svcCtx.Config.SymbolInfo.BaseCoinPrec.Load()),
+		Amount: utils.PrecCut(resp.Amend.Amount, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+		Status: int8(resp.Amend.OrderStatus),
+		Uid:    cast.ToString(resp.Amend.Uid),
+	}
+	if err := storeConsumedMessageId(); err != nil {
+		return err
+	}
+	l.oc <- wsOrder
+	return nil
+}
+
+// TriggerOrder  条件单触发
+func (l *HandleMatchResultLogic) TriggerOrder(resp *matchMq.MatchResp_Trigger, storeConsumedMessageId func() error) error {
+
+	entrustOrder := l.svcCtx.Query.EntrustOrder.Table(commonUtils.WithShardingSuffix(model.TableNameEntrustOrder, resp.Trigger.Uid))
+	if _, err := entrustOrder.WithContext(context.Background()).
+		Where(entrustOrder.ID.Eq(resp.Trigger.Id)).
+		Update(entrustOrder.TriggerStatus, int32(enum.TriggerStatus_Triggered)); err != nil {
+		return err
+	}
+	wsOrder := &commonWs.Order{
+		Id:            cast.ToString(resp.Trigger.Id),
+		OrderId:       resp.Trigger.OrderId,
+		Status:        int8(enum.OrderStatus_NewCreated),
+		Uid:           cast.ToString(resp.Trigger.Uid),
+		TriggerPrice:  utils.PrecCut(resp.Trigger.TriggerPrice, l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load()),
+		TriggerStatus: int8(enum.TriggerStatus_Triggered),
+	}
+	if err := storeConsumedMessageId(); err != nil {
+		return err
+	}
+	l.oc <- wsOrder
+	return nil
+}
@@ -5,21 +5,27 @@ import (
 	"fmt"
 	"github.com/dtm-labs/client/dtmgrpc"
 	accountpb "github.com/luxun9527/gex/app/account/rpc/pb"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
 	"github.com/luxun9527/gex/app/order/rpc/internal/svc"
 	"github.com/luxun9527/gex/app/order/rpc/pb"
 	"github.com/luxun9527/gex/common/errs"
 	enum "github.com/luxun9527/gex/common/proto/enum"
 	"github.com/luxun9527/gex/common/utils"
 	logger "github.com/luxun9527/zlog"
+	"github.com/shopspring/decimal"
 	"github.com/spf13/cast"
 	"github.com/yitter/idgenerator-go/idgen"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"strings"
 )
 
+// marketBuyDepthLevel 按照数量的市价买单计算冻结金额时查询的深度档位数量
+const marketBuyDepthLevel = 200
+
 type OrderLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -36,7 +42,14 @@ func NewOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OrderLogic
 
 // 下单。
 func (l *OrderLogic) Order(in *pb.CreateOrderReq) (*pb.OrderEmpty, error) {
-
+	//按照数量的市价买单，按照深度计算最坏情况下需要的金额冻结
+	if in.OrderType == enum.OrderType_MO && in.Side == enum.Side_Buy && utils.NewFromStringMaxPrec(in.Qty).IsPositive() {
+		amount, err := l.marketBuyAmount(in)
+		if err != nil {
+			return nil, err
+		}
+		in.Amount = amount
+	}
 	freezeReq := &accountpb.FreezeUserAssetReq{
 		Uid:    in.UserId,
 		CoinId: 0,
@@ -46,7 +59,8 @@ func (l *OrderLogic) Order(in *pb.CreateOrderReq) (*pb.OrderEmpty, error) {
 	if in.Side == enum.Side_Buy {
 		freezeReq.CoinId = l.svcCtx.Config.SymbolInfo.QuoteCoinID
 		freezeReq.Qty = in.Amount
-		if in.OrderType == enum.OrderType_LO {
+		//限价单、FOK、IOC按照下单价格冻结
+		if in.OrderType != enum.OrderType_MO {
 			freezeReq.Qty = utils.NewFromStringMaxPrec(in.Qty).Mul(utils.NewFromStringMaxPrec(in.Price)).String()
 		}
 	} else {
@@ -56,23 +70,42 @@ func (l *OrderLogic) Order(in *pb.CreateOrderReq) (*pb.OrderEmpty, error) {
 	//订单Id的规则
 	//市价单MO
 	//限价单LO
+	//立即全部成交否则取消FOK
+	//立即成交剩余取消IOC
+	//条件单在前面加s,止损市价单smo,止损限价单slo
 	//买1 卖 2
 	orderId := "mo"
-	if in.OrderType == enum.OrderType_LO {
+	switch in.OrderType {
+	case enum.OrderType_LO:
 		orderId = "lo"
+	case enum.OrderType_FOK:
+		orderId = "fok"
+	case enum.OrderType_IOC:
+		orderId = "ioc"
+	}
+	if in.TriggerPrice != "" {
+		orderId = "s" + orderId
 	}
 	orderId = fmt.Sprintf("%v%v%v", orderId, int32(in.Side), idgen.NextId())
 
 	createOrderReq := &pb.CreateOrderReq{
-		UserId:     in.UserId,
-		SymbolId:   in.SymbolId,
-		SymbolName: in.SymbolName,
-		Qty:        in.Qty,
-		Price:      in.Price,
-		Side:       in.Side,
-		OrderType:  in.OrderType,
-		Amount:     in.Amount,
-		OrderId:    orderId,
+		UserId:          in.UserId,
+		SymbolId:        in.SymbolId,
+		SymbolName:      in.SymbolName,
+		Qty:             in.Qty,
+		Price:           in.Price,
+		Side:            in.Side,
+		OrderType:       in.OrderType,
+		Amount:          in.Amount,
+		OrderId:         orderId,
+		PostOnly:        in.PostOnly,
+		TriggerPrice:    in.TriggerPrice,
+		StpMode:         in.StpMode,
+		VipLevel:        in.VipLevel,
+		DisplayQty:      in.DisplayQty,
+		ExpireTime:      in.ExpireTime,
+		MaxSlippage:     in.MaxSlippage,
+		ProtectionPrice: in.ProtectionPrice,
 	}
 	gid, err := l.svcCtx.DtmClient.NewGid(l.ctx, &emptypb.Empty{})
 	if err != nil {
@@ -110,21 +143,67 @@ func (l *OrderLogic) Order(in *pb.CreateOrderReq) (*pb.OrderEmpty, error) {
 		Add(freezeUserAddr, unFreezeUserAddr, freezeReq).
 		Add(createOrderAddr, createOrderRevert, createOrderReq).Submit(); err != nil {
 		logx.Errorw("Submit saga  failed", logger.ErrorField(err))
-		s, ok := status.FromError(err)
-		if ok {
-			if s.Code() == codes.Aborted && strings.Contains(s.Message(), "=") {
-				msg := s.Message()
-				start, end := strings.Index(msg, "="), strings.LastIndex(msg, "=")
-				d := msg[start+1 : end]
-				e, err := cast.ToInt32E(d)
-				if err != nil {
-					return nil, errs.Internal
-				}
-				return nil, errs.Code(e).Error("")
-			}
+		return nil, castSagaError(err)
+	}
+	return &pb.OrderEmpty{}, nil
+}
+
+// marketBuyAmount 按照数量的市价买单冻结的金额，从卖一开始累加深度，数量达到订单数量的档位价格是最坏情况下的成交价，
+// 订单的保护价格和最大滑点更低时按照更低的价格，撮合引擎不会超出这个价格成交。深度不够时按照最后一档的价格计算，
+// 撮合之前深度可能变化，撮合引擎按照冻结的金额限制成交的数量，没有用完的金额在结算时退回。
+func (l *OrderLogic) marketBuyAmount(in *pb.CreateOrderReq) (string, error) {
+	ctx := metadata.NewIncomingContext(l.ctx, metadata.Pairs("symbol", in.SymbolName))
+	depth, err := l.svcCtx.MatchClient.GetDepth(ctx, &matchpb.GetDepthReq{
+		Symbol: in.SymbolName,
+		Level:  marketBuyDepthLevel,
+	})
+	if err != nil {
+		logx.Errorw("marketBuyAmount call GetDepth failed", logger.ErrorField(err))
+		return "", errs.Internal
+	}
+	if len(depth.Asks) == 0 {
+		return "", errs.NotAsks
+	}
+	qty := utils.NewFromStringMaxPrec(in.Qty)
+	//卖盘按照价格从大到小排列，从后往前累加
+	var worstPrice, total decimal.Decimal
+	for i := len(depth.Asks) - 1; i >= 0; i-- {
+		worstPrice = utils.NewFromStringMaxPrec(depth.Asks[i].Price)
+		total = total.Add(utils.NewFromStringMaxPrec(depth.Asks[i].Qty))
+		if total.GreaterThanOrEqual(qty) {
+			break
+		}
+	}
+	if in.ProtectionPrice != "" {
+		if p := utils.NewFromStringMaxPrec(in.ProtectionPrice); p.IsPositive() && p.LessThan(worstPrice) {
+			worstPrice = p
+		}
+	}
+	if in.MaxSlippage != "" {
+		bestAsk := utils.NewFromStringMaxPrec(depth.Asks[len(depth.Asks)-1].Price)
+		limit := bestAsk.Mul(decimal.NewFromInt(1).Add(utils.NewFromStringMaxPrec(in.MaxSlippage))).RoundFloor(l.svcCtx.Config.SymbolInfo.QuoteCoinPrec.Load())
+		if limit.IsPositive() && limit.LessThan(worstPrice) {
+			worstPrice = limit
+		}
+	}
+	return qty.Mul(worstPrice).String(), nil
+}
 
+// castSagaError 事务分支返回的业务错误转换为错误码
+func castSagaError(err error) error {
+	s, ok := status.FromError(err)
+	if ok {
+		if s.Code() == codes.Aborted && strings.Contains(s.Message(), "=") {
+			msg := s.Message()
+			start, end := strings.Index(msg, "="), strings.LastIndex(msg, "=")
+			d := msg[start+1 : end]
+			e, err := cast.ToInt32E(d)
+			if err != nil {
+				return errs.Internal
+			}
+			return errs.Code(e).Error("")
 		}
-		return nil, errs.DtmErr
+
 	}
-	return &pb.OrderEmpty{}, nil
+	return errs.DtmErr
 }
@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"github.com/luxun9527/gex/common/errs"
+	"github.com/luxun9527/gex/common/proto/enum"
+	matchMq "github.com/luxun9527/gex/common/proto/mq/match"
+	logger "github.com/luxun9527/zlog"
+	"github.com/yitter/idgenerator-go/idgen"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/luxun9527/gex/app/order/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/order/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendAmendOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendAmendOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendAmendOrderLogic {
+	return &SendAmendOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendAmendOrder 冻结资产之后发送修改订单的消息，消息发送失败则回滚屏障记录，允许重试。
+func (l *SendAmendOrderLogic) SendAmendOrder(in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	barrier, err := dtmgrpc.BarrierFromGrpc(l.ctx)
+	if err != nil {
+		logx.Errorw("SendAmendOrder BarrierFromGrpc failed", logger.ErrorField(err))
+		return nil, errs.CastToDtmError(errs.DtmErr)
+	}
+	db, err := l.svcCtx.Query.EntrustOrder.WithContext(l.ctx).UnderlyingDB().DB()
+	if err != nil {
+		logx.Errorw("SendAmendOrder get UnderlyingDB failed", logger.ErrorField(err))
+		return nil, errs.CastToDtmError(errs.ExecSqlFailed)
+	}
+	if err := barrier.CallWithDB(db, func(tx *sql.Tx) error {
+		return sendAmendOrder(l.ctx, l.svcCtx, in)
+	}); err != nil {
+		logx.Errorw("SendAmendOrder send message failed", logger.ErrorField(err))
+		return nil, errs.CastToDtmError(errs.PulsarErr)
+	}
+	return &pb.OrderEmpty{}, nil
+}
+
+// sendAmendOrder 发送修改订单的消息到撮合
+func sendAmendOrder(ctx context.Context, svcCtx *svc.ServiceContext, in *pb.AmendOrderReq) error {
+	msg := &matchMq.MatchReq{
+		Operate: &matchMq.MatchReq_Amend{
+			Amend: &matchMq.AmendOperate{
+				Id:        in.Id,
+				Uid:       in.Uid,
+				Side:      in.Side,
+				OrderType: enum.OrderType_LO,
+				Price:     in.OldPrice,
+				NewPrice:  in.Price,
+				NewQty:    in.Qty,
+				FrozenQty: in.FrozenQty,
+				QueueId:   idgen.NextId(),
+			},
+		},
+	}
+	logx.Infow("send amend message", logx.Field("msg", msg))
+	data, _ := proto.Marshal(msg)
+	_, err := svcCtx.MatchProducer.Send(ctx, &pulsar.ProducerMessage{
+		Payload: data,
+	})
+	return err
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"github.com/luxun9527/gex/common/errs"
+	logger "github.com/luxun9527/zlog"
+
+	"github.com/luxun9527/gex/app/order/rpc/internal/svc"
+	"github.com/luxun9527/gex/app/order/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendAmendOrderRevertLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendAmendOrderRevertLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendAmendOrderRevertLogic {
+	return &SendAmendOrderRevertLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendAmendOrderRevert 发送修改订单的补偿。
+// 发送失败时消息没有发出，没有需要回滚的数据，只记录屏障，防止空补偿和悬挂。
+func (l *SendAmendOrderRevertLogic) SendAmendOrderRevert(in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	logx.Infow("SendAmendOrderRevert invoke", logx.Field("data", in))
+	barrier, err := dtmgrpc.BarrierFromGrpc(l.ctx)
+	if err != nil {
+		logx.Errorw("BarrierFromGrpc failed", logger.ErrorField(err))
+		return nil, errs.DtmErr
+	}
+	db, err := l.svcCtx.Query.EntrustOrder.WithContext(l.ctx).UnderlyingDB().DB()
+	if err != nil {
+		logx.Errorw("get UnderlyingDB failed", logger.ErrorField(err))
+		return nil, errs.ExecSqlFailed
+	}
+	if err := barrier.CallWithDB(db, func(tx *sql.Tx) error {
+		return nil
+	}); err != nil {
+		logx.Errorw("SendAmendOrderRevert failed", logger.ErrorField(err))
+		return nil, errs.Internal
+	}
+	return &pb.OrderEmpty{}, nil
+}
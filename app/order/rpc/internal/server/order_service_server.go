@@ -46,6 +46,30 @@ func (s *OrderServiceServer) CancelOrder(ctx context.Context, in *pb.CancelOrder
 	return l.CancelOrder(in)
 }
 
+// 撤销用户在交易对的所有订单
+func (s *OrderServiceServer) CancelAllOrders(ctx context.Context, in *pb.CancelAllOrdersReq) (*pb.OrderEmpty, error) {
+	l := logic.NewCancelAllOrdersLogic(ctx, s.svcCtx)
+	return l.CancelAllOrders(in)
+}
+
+// 修改订单
+func (s *OrderServiceServer) AmendOrder(ctx context.Context, in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	l := logic.NewAmendOrderLogic(ctx, s.svcCtx)
+	return l.AmendOrder(in)
+}
+
+// 发送修改订单的消息,需要额外冻结资产时在分布式事务中调用
+func (s *OrderServiceServer) SendAmendOrder(ctx context.Context, in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	l := logic.NewSendAmendOrderLogic(ctx, s.svcCtx)
+	return l.SendAmendOrder(in)
+}
+
+// 发送修改订单的补偿
+func (s *OrderServiceServer) SendAmendOrderRevert(ctx context.Context, in *pb.AmendOrderReq) (*pb.OrderEmpty, error) {
+	l := logic.NewSendAmendOrderRevertLogic(ctx, s.svcCtx)
+	return l.SendAmendOrderRevert(in)
+}
+
 // 下单补偿
 func (s *OrderServiceServer) CreateOrderRevert(ctx context.Context, in *pb.CreateOrderReq) (*pb.OrderEmpty, error) {
 	l := logic.NewCreateOrderRevertLogic(ctx, s.svcCtx)
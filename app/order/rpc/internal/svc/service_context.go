@@ -4,6 +4,8 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/dtm-labs/client/dtmgrpc/dtmgimp"
 	"github.com/dtm-labs/client/dtmgrpc/dtmgpb"
+	"github.com/luxun9527/gex/app/match/rpc/matchservice"
+	matchpb "github.com/luxun9527/gex/app/match/rpc/pb"
 	"github.com/luxun9527/gex/app/order/rpc/internal/config"
 	"github.com/luxun9527/gex/app/order/rpc/internal/dao/query"
 	"github.com/luxun9527/gex/common/pkg/etcd"
@@ -15,6 +17,8 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
+	"go.etcd.io/etcd/client/v3/naming/resolver"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/attributes"
 	"strings"
 	"time"
@@ -30,6 +34,7 @@ type ServiceContext struct {
 	MatchProducer pulsar.Producer
 	WsClient      ws.ProxyClient
 	RedisClient   *redis.Redis
+	MatchClient   matchpb.MatchServiceClient
 }
 
 func NewServiceContext(c *config.Config) *ServiceContext {
@@ -99,6 +104,16 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 		logx.Severef("init pulsar consumer failed %v", err)
 	}
 
+	//撮合服务按照交易对选择连接
+	etcdConfig := etcd.EtcdConfig{Endpoints: c.MatchRpcConf.Etcd.Hosts}
+	etcdResolver, err := resolver.NewBuilder(etcdConfig.MustNewEtcdClient())
+	if err != nil {
+		logx.Severef("NewBuilder error: %v", err)
+	}
+	matchClient := zrpc.MustNewClient(c.MatchRpcConf,
+		zrpc.WithDialOption(grpc.WithResolvers(etcdResolver)),
+		zrpc.WithDialOption(grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"symbol_lb"}`)))
+
 	sc := &ServiceContext{
 		Config:        c,
 		Query:         query.Use(c.GormConf.MustNewGormClient()),
@@ -107,6 +122,7 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 		MatchProducer: producer,
 		WsClient:      ws.NewProxyClient(zrpc.MustNewClient(c.WsConf).Conn()),
 		RedisClient:   redis.MustNewRedis(c.RedisConf),
+		MatchClient:   matchservice.NewMatchService(matchClient),
 	}
 	return sc
 }
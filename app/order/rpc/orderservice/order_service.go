@@ -13,6 +13,8 @@ import (
 )
 
 type (
+	AmendOrderReq               = pb.AmendOrderReq
+	CancelAllOrdersReq          = pb.CancelAllOrdersReq
 	CancelOrderReq              = pb.CancelOrderReq
 	CreateOrderReq              = pb.CreateOrderReq
 	FreezeUserAssetResp         = pb.FreezeUserAssetResp
@@ -33,6 +35,14 @@ type (
 		GetOrderList(ctx context.Context, in *GetOrderListByUserReq, opts ...grpc.CallOption) (*GetOrderListByUserResp, error)
 		// 取消订单
 		CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+		// 撤销用户在交易对的所有订单
+		CancelAllOrders(ctx context.Context, in *CancelAllOrdersReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+		// 修改订单
+		AmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+		// 发送修改订单的消息,需要额外冻结资产时在分布式事务中调用
+		SendAmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+		// 发送修改订单的补偿
+		SendAmendOrderRevert(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
 		// 下单补偿
 		CreateOrderRevert(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
 		// 获取所有订单状态为未成交或部分成交的订单
@@ -74,6 +84,30 @@ func (m *defaultOrderService) CancelOrder(ctx context.Context, in *CancelOrderRe
 	return client.CancelOrder(ctx, in, opts...)
 }
 
+// 撤销用户在交易对的所有订单
+func (m *defaultOrderService) CancelAllOrders(ctx context.Context, in *CancelAllOrdersReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	client := pb.NewOrderServiceClient(m.cli.Conn())
+	return client.CancelAllOrders(ctx, in, opts...)
+}
+
+// 修改订单
+func (m *defaultOrderService) AmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	client := pb.NewOrderServiceClient(m.cli.Conn())
+	return client.AmendOrder(ctx, in, opts...)
+}
+
+// 发送修改订单的消息,需要额外冻结资产时在分布式事务中调用
+func (m *defaultOrderService) SendAmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	client := pb.NewOrderServiceClient(m.cli.Conn())
+	return client.SendAmendOrder(ctx, in, opts...)
+}
+
+// 发送修改订单的补偿
+func (m *defaultOrderService) SendAmendOrderRevert(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	client := pb.NewOrderServiceClient(m.cli.Conn())
+	return client.SendAmendOrderRevert(ctx, in, opts...)
+}
+
 // 下单补偿
 func (m *defaultOrderService) CreateOrderRevert(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
 	client := pb.NewOrderServiceClient(m.cli.Conn())
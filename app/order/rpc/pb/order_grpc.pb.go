@@ -23,6 +23,10 @@ const (
 	OrderService_CreateOrder_FullMethodName             = "/order.OrderService/CreateOrder"
 	OrderService_GetOrderList_FullMethodName            = "/order.OrderService/GetOrderList"
 	OrderService_CancelOrder_FullMethodName             = "/order.OrderService/CancelOrder"
+	OrderService_CancelAllOrders_FullMethodName         = "/order.OrderService/CancelAllOrders"
+	OrderService_AmendOrder_FullMethodName              = "/order.OrderService/AmendOrder"
+	OrderService_SendAmendOrder_FullMethodName          = "/order.OrderService/SendAmendOrder"
+	OrderService_SendAmendOrderRevert_FullMethodName    = "/order.OrderService/SendAmendOrderRevert"
 	OrderService_CreateOrderRevert_FullMethodName       = "/order.OrderService/CreateOrderRevert"
 	OrderService_GetOrderAllPendingOrder_FullMethodName = "/order.OrderService/GetOrderAllPendingOrder"
 )
@@ -39,6 +43,14 @@ type OrderServiceClient interface {
 	GetOrderList(ctx context.Context, in *GetOrderListByUserReq, opts ...grpc.CallOption) (*GetOrderListByUserResp, error)
 	// 取消订单
 	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+	// 撤销用户在交易对的所有订单
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+	// 修改订单
+	AmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+	// 发送修改订单的消息,需要额外冻结资产时在分布式事务中调用
+	SendAmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
+	// 发送修改订单的补偿
+	SendAmendOrderRevert(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
 	// 下单补偿
 	CreateOrderRevert(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error)
 	// 获取所有订单状态为未成交或部分成交的订单
@@ -89,6 +101,42 @@ func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	out := new(OrderEmpty)
+	err := c.cc.Invoke(ctx, OrderService_CancelAllOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	out := new(OrderEmpty)
+	err := c.cc.Invoke(ctx, OrderService_AmendOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SendAmendOrder(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	out := new(OrderEmpty)
+	err := c.cc.Invoke(ctx, OrderService_SendAmendOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SendAmendOrderRevert(ctx context.Context, in *AmendOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
+	out := new(OrderEmpty)
+	err := c.cc.Invoke(ctx, OrderService_SendAmendOrderRevert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) CreateOrderRevert(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*OrderEmpty, error) {
 	out := new(OrderEmpty)
 	err := c.cc.Invoke(ctx, OrderService_CreateOrderRevert_FullMethodName, in, out, opts...)
@@ -142,6 +190,14 @@ type OrderServiceServer interface {
 	GetOrderList(context.Context, *GetOrderListByUserReq) (*GetOrderListByUserResp, error)
 	// 取消订单
 	CancelOrder(context.Context, *CancelOrderReq) (*OrderEmpty, error)
+	// 撤销用户在交易对的所有订单
+	CancelAllOrders(context.Context, *CancelAllOrdersReq) (*OrderEmpty, error)
+	// 修改订单
+	AmendOrder(context.Context, *AmendOrderReq) (*OrderEmpty, error)
+	// 发送修改订单的消息,需要额外冻结资产时在分布式事务中调用
+	SendAmendOrder(context.Context, *AmendOrderReq) (*OrderEmpty, error)
+	// 发送修改订单的补偿
+	SendAmendOrderRevert(context.Context, *AmendOrderReq) (*OrderEmpty, error)
 	// 下单补偿
 	CreateOrderRevert(context.Context, *CreateOrderReq) (*OrderEmpty, error)
 	// 获取所有订单状态为未成交或部分成交的订单
@@ -165,6 +221,18 @@ func (UnimplementedOrderServiceServer) GetOrderList(context.Context, *GetOrderLi
 func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderReq) (*OrderEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) CancelAllOrders(context.Context, *CancelAllOrdersReq) (*OrderEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) AmendOrder(context.Context, *AmendOrderReq) (*OrderEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) SendAmendOrder(context.Context, *AmendOrderReq) (*OrderEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAmendOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) SendAmendOrderRevert(context.Context, *AmendOrderReq) (*OrderEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAmendOrderRevert not implemented")
+}
 func (UnimplementedOrderServiceServer) CreateOrderRevert(context.Context, *CreateOrderReq) (*OrderEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderRevert not implemented")
 }
@@ -256,6 +324,78 @@ func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelAllOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelAllOrders(ctx, req.(*CancelAllOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_AmendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AmendOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AmendOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AmendOrder(ctx, req.(*AmendOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SendAmendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SendAmendOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SendAmendOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SendAmendOrder(ctx, req.(*AmendOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SendAmendOrderRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SendAmendOrderRevert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SendAmendOrderRevert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SendAmendOrderRevert(ctx, req.(*AmendOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_CreateOrderRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOrderReq)
 	if err := dec(in); err != nil {
@@ -318,6 +458,22 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _OrderService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _OrderService_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "AmendOrder",
+			Handler:    _OrderService_AmendOrder_Handler,
+		},
+		{
+			MethodName: "SendAmendOrder",
+			Handler:    _OrderService_SendAmendOrder_Handler,
+		},
+		{
+			MethodName: "SendAmendOrderRevert",
+			Handler:    _OrderService_SendAmendOrderRevert_Handler,
+		},
 		{
 			MethodName: "CreateOrderRevert",
 			Handler:    _OrderService_CreateOrderRevert_Handler,
@@ -35,6 +35,7 @@ func (l *GetDepthListLogic) GetDepthList(req *types.GetDepthListReq) (resp *type
 	depthResp, err := l.svcCtx.MatchClients.GetDepth(ctx, &matchpb.GetDepthReq{
 		Symbol: req.Symbol,
 		Level:  req.Level,
+		Group:  req.Group,
 	})
 	if err != nil {
 		logx.Errorf("GetDepthListLogic.GetDepthList error:%v", err)
@@ -59,9 +60,10 @@ func (l *GetDepthListLogic) GetDepthList(req *types.GetDepthListReq) (resp *type
 		bids = append(bids, position)
 	}
 	resp = &types.GetDepthListResp{
-		Version: cast.ToString(depthResp.Version),
-		Asks:    asks,
-		Bids:    bids,
+		Version:  cast.ToString(depthResp.Version),
+		Asks:     asks,
+		Bids:     bids,
+		Checksum: depthResp.Checksum,
 	}
 	return
 }
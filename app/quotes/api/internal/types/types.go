@@ -26,8 +26,9 @@ type KlineListResp struct {
 }
 
 type GetDepthListReq struct {
-	Symbol string `json:"symbol"` //交易对
-	Level  int32  `json:"level"`  //档位
+	Symbol string `json:"symbol"`         //交易对
+	Level  int32  `json:"level"`          //档位
+	Group  string `json:"group,optional"` //合并深度的档位,例如0.1,为空则不合并
 }
 
 type Position struct {
@@ -37,9 +38,10 @@ type Position struct {
 }
 
 type GetDepthListResp struct {
-	Version string      `json:"version"` //当前版本号
-	Asks    []*Position `json:"asks"`    //卖盘
-	Bids    []*Position `json:"bids"`    //买盘
+	Version  string      `json:"version"`  //当前版本号
+	Asks     []*Position `json:"asks"`     //卖盘
+	Bids     []*Position `json:"bids"`     //买盘
+	Checksum uint32      `json:"checksum"` //前25档的校验和,和推送的深度使用同一个算法
 }
 
 type GetTickerListReq struct {
@@ -16,6 +16,22 @@ const (
 	NotAsksCode
 
 	ErrPrecCode
+	// ErrTickSizeCode 价格不是最小变动单位的整数倍
+	ErrTickSizeCode
+	// ErrLotSizeCode 数量不是最小变动单位的整数倍
+	ErrLotSizeCode
+	// ErrMinQtyCode 数量小于最小下单数量
+	ErrMinQtyCode
+	// ErrMaxQtyCode 数量大于最大下单数量
+	ErrMaxQtyCode
+	// ErrMinNotionalCode 金额小于最小下单金额
+	ErrMinNotionalCode
+	// ErrMaxNotionalCode 金额大于最大下单金额
+	ErrMaxNotionalCode
+	// ErrAmendOrderTypeCode 只有限价单可以修改
+	ErrAmendOrderTypeCode
+	// ErrAmendQtyCode 修改之后的数量必须大于已成交数量
+	ErrAmendQtyCode
 )
 
 var (
@@ -25,4 +41,12 @@ var (
 	NotBids             = NotBidsCode.Error("")
 	NotAsks             = NotAsksCode.Error("")
 	ErrPrec             = ErrPrecCode.Error("")
+	ErrTickSize         = ErrTickSizeCode.Error("")
+	ErrLotSize          = ErrLotSizeCode.Error("")
+	ErrMinQty           = ErrMinQtyCode.Error("")
+	ErrMaxQty           = ErrMaxQtyCode.Error("")
+	ErrMinNotional      = ErrMinNotionalCode.Error("")
+	ErrMaxNotional      = ErrMaxNotionalCode.Error("")
+	ErrAmendOrderType   = ErrAmendOrderTypeCode.Error("")
+	ErrAmendQty         = ErrAmendQtyCode.Error("")
 )
@@ -0,0 +1,59 @@
+package etcd
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// 基于etcd租约的选主，和服务注册一样通过租约判断实例是否存活。
+// 同一个key下面每个实例用自己的租约创建一个key，创建版本最小的是主节点，其他实例等待前面的key删除。
+// 主节点的进程退出或者网络断开之后租约在ttl秒之后过期，下一个实例成为主节点。
+
+type ElectionConf struct {
+	EtcdConf EtcdConfig
+	Key      string
+	Value    string //主节点的标识，例如实例的名称
+	TTL      int    //租约时间 单位秒
+}
+
+// Campaign 阻塞直到成为主节点或者ctx取消。成为主节点之后租约失效时关闭返回的通道，
+// ctx取消之后主动放弃，撤销租约，其他实例立即成为主节点。
+func Campaign(ctx context.Context, conf ElectionConf) (<-chan struct{}, error) {
+	cli, err := conf.EtcdConf.NewEtcdClient()
+	if err != nil {
+		return nil, err
+	}
+	//租约不跟随ctx，ctx取消之后还需要用租约放弃主节点
+	session, err := concurrency.NewSession(cli, concurrency.WithTTL(conf.TTL))
+	if err != nil {
+		cli.Close()
+		return nil, err
+	}
+	election := concurrency.NewElection(session, conf.Key)
+	if err := election.Campaign(ctx, conf.Value); err != nil {
+		session.Close()
+		cli.Close()
+		return nil, err
+	}
+	logx.Infof("etcd campaign success,key: %v,value: %v", conf.Key, conf.Value)
+	lost := make(chan struct{})
+	go func() {
+		defer close(lost)
+		defer cli.Close()
+		select {
+		case <-session.Done():
+			logx.Errorf("etcd election lease expired,key: %v,value: %v", conf.Key, conf.Value)
+		case <-ctx.Done():
+			resignCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := election.Resign(resignCtx); err != nil {
+				logx.Errorf("etcd resign failed key %v err %v", conf.Key, err)
+			}
+			cancel()
+			session.Close()
+		}
+	}()
+	return lost, nil
+}
@@ -34,6 +34,12 @@ type EtcdRegisterConf struct {
 }
 
 func Register(conf EtcdRegisterConf) {
+	RegisterWithContext(context.Background(), conf)
+}
+
+// RegisterWithContext 注册服务，ctx取消之后撤销租约，注册的key立即删除。
+// 撮合服务一个进程运行多个交易对，每个交易对单独注册，交易对下线时取消注册。
+func RegisterWithContext(ctx context.Context, conf EtcdRegisterConf) {
 	go func() { // 使用goroutine异步执行注册逻辑
 		// etcd客户端初始化
 		cli, err := conf.EtcdConf.NewEtcdClient() // 创建etcd客户端连接
@@ -64,7 +70,7 @@ func Register(conf EtcdRegisterConf) {
 		endpointMap, _ := manager.List(context.Background())
 		logx.Info("List of manager:", endpointMap)
 		// KeepAlive返回一个只读通道，用于接收租约续期通知
-		c, err := cli.KeepAlive(context.Background(), resp.ID) // 保持租约存活
+		c, err := cli.KeepAlive(ctx, resp.ID) // 保持租约存活，ctx取消之后停止续期
 		if err != nil {
 			logx.Severef("etcd keepalive err: %v", err)
 		}
@@ -120,6 +126,17 @@ func Register(conf EtcdRegisterConf) {
 			select {
 			case _, ok := <-c: // 监听通道事件
 				if !ok { // 通道关闭时触发
+					//主动取消注册，撤销租约
+					if ctx.Err() != nil {
+						revokeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+						if _, err := cli.Revoke(revokeCtx, resp.ID); err != nil {
+							logx.Errorf("etcd revoke lease failed key %v err %v", conf.Key, err)
+						}
+						cancel()
+						cli.Close()
+						logx.Infof("etcd unregister success,key: %v,value: %v", conf.Key, conf.Value)
+						return
+					}
 					// 记录错误日志并退出协程
 					logx.Errorf("etcd keepalive failed,please check etcd key %v existed", conf.Key)
 					return
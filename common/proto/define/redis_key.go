@@ -8,6 +8,7 @@ const (
 	AccountToken             RedisKey = "gex:account:token"
 	AccountConsumedMessageId RedisKey = "gex:account:consumed:messageId"
 	OrderConsumedMessageId   RedisKey = "gex:order:consumed:messageId"
+	OrderCancelAfter         RedisKey = "gex:order:cancelAfter" //撤单倒计时 有序集合 成员为用户id@交易对 分数为撤单时间
 )
 
 func (key RedisKey) WithSymbol(symbol string) string {
@@ -1,14 +1,19 @@
 package define
 
 import (
+	"fmt"
+	"github.com/luxun9527/gex/common/errs"
 	"github.com/luxun9527/gex/common/pkg/confx"
 	"github.com/luxun9527/gex/common/pkg/etcd"
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
 	"gopkg.in/yaml.v3"
+	"math"
 	"sync"
+	"time"
 )
 
 const (
@@ -29,8 +34,7 @@ func InitSymbolConfig(key string, etcdConfig etcd.EtcdConfig, symbolInfo *Symbol
 				logx.Severef("base coin prec quote coin prec hava a invalid QuoteCoinPrecValue = %v BaseCoinPrecValue =%v ", symbolInfo.QuoteCoinPrecValue, symbolInfo.BaseCoinPrecValue)
 
 			}
-			symbolInfo.BaseCoinPrec.Store(symbolInfo.BaseCoinPrecValue)
-			symbolInfo.QuoteCoinPrec.Store(symbolInfo.QuoteCoinPrecValue)
+			symbolInfo.StoreValues()
 
 		}
 	}), confx.WithCustomWatchFunc(func(evs []*clientv3.Event, target any) {
@@ -40,8 +44,7 @@ func InitSymbolConfig(key string, etcdConfig etcd.EtcdConfig, symbolInfo *Symbol
 				if err := yaml.Unmarshal(v.Kv.Value, symbolInfo); err != nil {
 					logx.Errorf("get symbol config failed symbolInfo =%v", key)
 				}
-				symbolInfo.QuoteCoinPrec.Store(symbolInfo.QuoteCoinPrecValue)
-				symbolInfo.BaseCoinPrec.Store(symbolInfo.BaseCoinPrecValue)
+				symbolInfo.StoreValues()
 			case mvccpb.DELETE: //删除
 				logx.Sloww("warn symbol config deleted")
 			}
@@ -51,16 +54,236 @@ func InitSymbolConfig(key string, etcdConfig etcd.EtcdConfig, symbolInfo *Symbol
 }
 
 type SymbolInfo struct {
-	SymbolName         string
-	SymbolID           int32
-	BaseCoinName       string
-	BaseCoinID         int32
-	QuoteCoinName      string
-	QuoteCoinID        int32
-	BaseCoinPrecValue  int32        `yaml:"baseCoinPrec"`
-	QuoteCoinPrecValue int32        `yaml:"quoteCoinPrec"`
-	BaseCoinPrec       atomic.Int32 `yaml:"-"`
-	QuoteCoinPrec      atomic.Int32 `yaml:"-"`
+	SymbolName                string
+	SymbolID                  int32
+	BaseCoinName              string
+	BaseCoinID                int32
+	QuoteCoinName             string
+	QuoteCoinID               int32
+	BaseCoinPrecValue         int32        `yaml:"baseCoinPrec"`
+	QuoteCoinPrecValue        int32        `yaml:"quoteCoinPrec"`
+	BaseCoinPrec              atomic.Int32 `yaml:"-"`
+	QuoteCoinPrec             atomic.Int32 `yaml:"-"`
+	STPModeValue              int32        `yaml:"stpMode"` //默认的自成交保护模式,订单没有指定时使用
+	STPMode                   atomic.Int32 `yaml:"-"`
+	MakerFeeRateValue         string       `yaml:"makerFeeRate"`         //默认的maker手续费率
+	TakerFeeRateValue         string       `yaml:"takerFeeRate"`         //默认的taker手续费率
+	VipFeeRatesValue          []FeeRate    `yaml:"vipFeeRates"`          //vip等级的手续费率,覆盖默认的手续费率
+	FeeRates                  atomic.Value `yaml:"-"`                    //map[int32]FeeRate vip等级对应的手续费率
+	TickSizeValue             string       `yaml:"tickSize"`             //价格最小变动单位
+	LotSizeValue              string       `yaml:"lotSize"`              //数量最小变动单位
+	MinQtyValue               string       `yaml:"minQty"`               //最小下单数量
+	MaxQtyValue               string       `yaml:"maxQty"`               //最大下单数量
+	MinNotionalValue          string       `yaml:"minNotional"`          //最小下单金额
+	MaxNotionalValue          string       `yaml:"maxNotional"`          //最大下单金额
+	TradingRule               atomic.Value `yaml:"-"`                    //TradingRule 交易规则
+	PriceBandValue            string       `yaml:"priceBand"`            //价格保护范围,相对最新成交价的比例,市价单超出范围的部分不成交
+	ReferencePriceValue       string       `yaml:"referencePrice"`       //参考价格,还没有成交价时使用
+	CircuitBreakerValue       string       `yaml:"circuitBreaker"`       //熔断阈值,窗口内价格波动的比例超过阈值暂停交易
+	CircuitBreakerWindowValue int32        `yaml:"circuitBreakerWindow"` //熔断的统计窗口 单位秒
+	HaltDurationValue         int32        `yaml:"haltDuration"`         //熔断暂停交易的时长 单位秒
+	PriceProtection           atomic.Value `yaml:"-"`                    //PriceProtection 价格保护
+	OpenTimeValue             int64        `yaml:"openTime"`             //开盘时间 单位秒,开盘之前为集合竞价
+	HaltAuctionValue          bool         `yaml:"haltAuction"`          //熔断期间集合竞价,恢复交易时按照统一的价格撮合
+	Auction                   atomic.Value `yaml:"-"`                    //AuctionConfig 集合竞价配置
+}
+
+// StoreValues 加载或者修改配置之后更新所有需要原子读取的配置
+func (s *SymbolInfo) StoreValues() {
+	s.BaseCoinPrec.Store(s.BaseCoinPrecValue)
+	s.QuoteCoinPrec.Store(s.QuoteCoinPrecValue)
+	s.STPMode.Store(s.STPModeValue)
+	s.StoreFeeRates()
+	s.StoreTradingRule()
+	s.StorePriceProtection()
+	s.StoreAuction()
+}
+
+// FeeRate 手续费率
+type FeeRate struct {
+	Level        int32  `yaml:"level"` //vip等级
+	MakerFeeRate string `yaml:"makerFeeRate"`
+	TakerFeeRate string `yaml:"takerFeeRate"`
+}
+
+// StoreFeeRates 加载或者修改配置之后更新手续费率,等级0为默认的手续费率
+func (s *SymbolInfo) StoreFeeRates() {
+	rates := make(map[int32]FeeRate, len(s.VipFeeRatesValue)+1)
+	rates[0] = FeeRate{MakerFeeRate: s.MakerFeeRateValue, TakerFeeRate: s.TakerFeeRateValue}
+	for _, v := range s.VipFeeRatesValue {
+		rates[v.Level] = v
+	}
+	s.FeeRates.Store(rates)
+}
+
+// GetFeeRate 获取vip等级对应的手续费率,没有配置的等级使用默认的手续费率,没有配置手续费率则不收手续费
+func (s *SymbolInfo) GetFeeRate(vipLevel int32) (makerFeeRate, takerFeeRate string) {
+	rates, _ := s.FeeRates.Load().(map[int32]FeeRate)
+	rate, ok := rates[vipLevel]
+	if !ok {
+		rate = rates[0]
+	}
+	makerFeeRate, takerFeeRate = rate.MakerFeeRate, rate.TakerFeeRate
+	if makerFeeRate == "" {
+		makerFeeRate = "0"
+	}
+	if takerFeeRate == "" {
+		takerFeeRate = "0"
+	}
+	return makerFeeRate, takerFeeRate
+}
+
+// DefaultMaxNotional 交易对没有配置最大下单金额时的最大下单金额。
+// 撮合引擎的金额是int64定点数,小数位数为价格精度加数量精度,能表示的最大金额为9223372036854775807/10^(价格精度+数量精度),
+// 例如价格和数量都是8位精度时只有922。最大下单金额超出这个范围的交易对不能添加,撮合引擎也不会启动。
+const DefaultMaxNotional = 10000000000
+
+// CheckMaxNotional 校验最大下单金额在撮合引擎金额的范围内,maxNotional为空或者为零时使用DefaultMaxNotional
+func CheckMaxNotional(baseCoinPrec, quoteCoinPrec int32, maxNotional string) error {
+	notional := decimal.NewFromInt(DefaultMaxNotional)
+	if d, err := decimal.NewFromString(maxNotional); err == nil && d.IsPositive() {
+		notional = d
+	}
+	limit := decimal.New(math.MaxInt64, -(baseCoinPrec + quoteCoinPrec))
+	if notional.GreaterThan(limit) {
+		return fmt.Errorf("max notional %v exceeds %v with base coin prec %v and quote coin prec %v", notional, limit, baseCoinPrec, quoteCoinPrec)
+	}
+	return nil
+}
+
+// TradingRule 交易规则,为零表示不限制,最大下单金额没有配置时为DefaultMaxNotional
+type TradingRule struct {
+	TickSize    decimal.Decimal
+	LotSize     decimal.Decimal
+	MinQty      decimal.Decimal
+	MaxQty      decimal.Decimal
+	MinNotional decimal.Decimal
+	MaxNotional decimal.Decimal
+}
+
+// StoreTradingRule 加载或者修改配置之后更新交易规则
+func (s *SymbolInfo) StoreTradingRule() {
+	rule := TradingRule{
+		TickSize:    parseRuleValue("tickSize", s.TickSizeValue),
+		LotSize:     parseRuleValue("lotSize", s.LotSizeValue),
+		MinQty:      parseRuleValue("minQty", s.MinQtyValue),
+		MaxQty:      parseRuleValue("maxQty", s.MaxQtyValue),
+		MinNotional: parseRuleValue("minNotional", s.MinNotionalValue),
+		MaxNotional: parseRuleValue("maxNotional", s.MaxNotionalValue),
+	}
+	if !rule.MaxNotional.IsPositive() {
+		rule.MaxNotional = decimal.NewFromInt(DefaultMaxNotional)
+	}
+	s.TradingRule.Store(rule)
+}
+
+// GetTradingRule 获取交易规则,没有加载过则不限制
+func (s *SymbolInfo) GetTradingRule() TradingRule {
+	rule, _ := s.TradingRule.Load().(TradingRule)
+	return rule
+}
+
+func parseRuleValue(name, value string) decimal.Decimal {
+	if value == "" {
+		return decimal.Zero
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil || d.IsNegative() {
+		logx.Errorf("invalid trading rule %v = %v", name, value)
+		return decimal.Zero
+	}
+	return d
+}
+
+// CheckPrice 校验价格是否为最小变动单位的整数倍
+func (r TradingRule) CheckPrice(price decimal.Decimal) error {
+	if r.TickSize.IsPositive() && !price.Mod(r.TickSize).IsZero() {
+		return errs.ErrTickSize
+	}
+	return nil
+}
+
+// CheckQty 校验数量的最小变动单位和上下限
+func (r TradingRule) CheckQty(qty decimal.Decimal) error {
+	if r.LotSize.IsPositive() && !qty.Mod(r.LotSize).IsZero() {
+		return errs.ErrLotSize
+	}
+	if r.MinQty.IsPositive() && qty.LessThan(r.MinQty) {
+		return errs.ErrMinQty
+	}
+	if r.MaxQty.IsPositive() && qty.GreaterThan(r.MaxQty) {
+		return errs.ErrMaxQty
+	}
+	return nil
+}
+
+// CheckNotional 校验下单金额的上下限
+func (r TradingRule) CheckNotional(amount decimal.Decimal) error {
+	if r.MinNotional.IsPositive() && amount.LessThan(r.MinNotional) {
+		return errs.ErrMinNotional
+	}
+	if r.MaxNotional.IsPositive() && amount.GreaterThan(r.MaxNotional) {
+		return errs.ErrMaxNotional
+	}
+	return nil
+}
+
+// CheckLimitOrder 校验带价格的订单
+func (r TradingRule) CheckLimitOrder(price, qty decimal.Decimal) error {
+	if err := r.CheckPrice(price); err != nil {
+		return err
+	}
+	if err := r.CheckQty(qty); err != nil {
+		return err
+	}
+	return r.CheckNotional(price.Mul(qty))
+}
+
+// PriceProtection 价格保护和熔断配置,为零表示不开启
+type PriceProtection struct {
+	PriceBand      decimal.Decimal
+	ReferencePrice decimal.Decimal
+	CircuitBreaker decimal.Decimal
+	Window         time.Duration
+	HaltDuration   time.Duration
+}
+
+// StorePriceProtection 加载或者修改配置之后更新价格保护配置
+func (s *SymbolInfo) StorePriceProtection() {
+	s.PriceProtection.Store(PriceProtection{
+		PriceBand:      parseRuleValue("priceBand", s.PriceBandValue),
+		ReferencePrice: parseRuleValue("referencePrice", s.ReferencePriceValue),
+		CircuitBreaker: parseRuleValue("circuitBreaker", s.CircuitBreakerValue),
+		Window:         time.Duration(s.CircuitBreakerWindowValue) * time.Second,
+		HaltDuration:   time.Duration(s.HaltDurationValue) * time.Second,
+	})
+}
+
+// GetPriceProtection 获取价格保护配置,没有加载过则不开启
+func (s *SymbolInfo) GetPriceProtection() PriceProtection {
+	p, _ := s.PriceProtection.Load().(PriceProtection)
+	return p
+}
+
+// AuctionConfig 集合竞价配置
+type AuctionConfig struct {
+	OpenTime    time.Time
+	HaltAuction bool
+}
+
+// StoreAuction 加载或者修改配置之后更新集合竞价配置
+func (s *SymbolInfo) StoreAuction() {
+	c := AuctionConfig{HaltAuction: s.HaltAuctionValue}
+	if s.OpenTimeValue > 0 {
+		c.OpenTime = time.Unix(s.OpenTimeValue, 0)
+	}
+	s.Auction.Store(c)
+}
+
+// GetAuction 获取集合竞价配置,没有加载过则不开启
+func (s *SymbolInfo) GetAuction() AuctionConfig {
+	c, _ := s.Auction.Load().(AuctionConfig)
+	return c
 }
 
 type CoinInfo struct {
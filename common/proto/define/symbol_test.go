@@ -0,0 +1,18 @@
+package define
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckMaxNotional(t *testing.T) {
+	// 3位数量精度和5位价格精度最大金额约为9.2e10，默认的最大下单金额可以表示
+	assert.Nil(t, CheckMaxNotional(3, 5, ""))
+	assert.Nil(t, CheckMaxNotional(3, 5, "0"))
+	assert.NotNil(t, CheckMaxNotional(3, 5, "100000000000"))
+	// 8位数量精度和8位价格精度最大金额只有922
+	assert.NotNil(t, CheckMaxNotional(8, 8, ""))
+	assert.Nil(t, CheckMaxNotional(8, 8, "922"))
+	assert.NotNil(t, CheckMaxNotional(8, 8, "923"))
+}
@@ -85,6 +85,8 @@ const (
 	OrderType_LO OrderType = 2
 	// 立即全部成交否則取消
 	OrderType_FOK OrderType = 3
+	// 立即成交剩余部分取消
+	OrderType_IOC OrderType = 4
 )
 
 // Enum value maps for OrderType.
@@ -94,12 +96,14 @@ var (
 		1: "MO",
 		2: "LO",
 		3: "FOK",
+		4: "IOC",
 	}
 	OrderType_value = map[string]int32{
 		"UnknownOrderType": 0,
 		"MO":               1,
 		"LO":               2,
 		"FOK":              3,
+		"IOC":              4,
 	}
 )
 
@@ -146,6 +150,8 @@ const (
 	OrderStatus_Canceled OrderStatus = 4
 	// 废弃
 	OrderStatus_Wasted OrderStatus = 5
+	// 过期,有效期到了之后撮合引擎撤销
+	OrderStatus_Expired OrderStatus = 6
 )
 
 // Enum value maps for OrderStatus.
@@ -157,6 +163,7 @@ var (
 		3: "ALLFilled",
 		4: "Canceled",
 		5: "Wasted",
+		6: "Expired",
 	}
 	OrderStatus_value = map[string]int32{
 		"UnknownOrderStatus": 0,
@@ -165,6 +172,7 @@ var (
 		"ALLFilled":          3,
 		"Canceled":           4,
 		"Wasted":             5,
+		"Expired":            6,
 	}
 )
 
@@ -195,6 +203,124 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{2}
 }
 
+// 条件单触发状态
+type TriggerStatus int32
+
+const (
+	// 未知,不是条件单
+	TriggerStatus_UnknownTriggerStatus TriggerStatus = 0
+	// 待触发
+	TriggerStatus_Untriggered TriggerStatus = 1
+	// 已触发
+	TriggerStatus_Triggered TriggerStatus = 2
+)
+
+// Enum value maps for TriggerStatus.
+var (
+	TriggerStatus_name = map[int32]string{
+		0: "UnknownTriggerStatus",
+		1: "Untriggered",
+		2: "Triggered",
+	}
+	TriggerStatus_value = map[string]int32{
+		"UnknownTriggerStatus": 0,
+		"Untriggered":          1,
+		"Triggered":            2,
+	}
+)
+
+func (x TriggerStatus) Enum() *TriggerStatus {
+	p := new(TriggerStatus)
+	*p = x
+	return p
+}
+
+func (x TriggerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TriggerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enum_enum_proto_enumTypes[3].Descriptor()
+}
+
+func (TriggerStatus) Type() protoreflect.EnumType {
+	return &file_common_proto_enum_enum_proto_enumTypes[3]
+}
+
+func (x TriggerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TriggerStatus.Descriptor instead.
+func (TriggerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{3}
+}
+
+// 自成交保护模式,taker和maker是同一个用户时的处理方式
+type STPMode int32
+
+const (
+	// 未指定,使用交易对的默认配置
+	STPMode_UnknownSTPMode STPMode = 0
+	// 允许自成交
+	STPMode_AllowSelfTrade STPMode = 1
+	// 撤销新订单(taker)
+	STPMode_CancelNewest STPMode = 2
+	// 撤销旧订单(maker)
+	STPMode_CancelOldest STPMode = 3
+	// 两个订单都撤销
+	STPMode_CancelBoth STPMode = 4
+	// 两个订单都减少较小的数量,数量减为0的订单撤销
+	STPMode_DecrementAndCancel STPMode = 5
+)
+
+// Enum value maps for STPMode.
+var (
+	STPMode_name = map[int32]string{
+		0: "UnknownSTPMode",
+		1: "AllowSelfTrade",
+		2: "CancelNewest",
+		3: "CancelOldest",
+		4: "CancelBoth",
+		5: "DecrementAndCancel",
+	}
+	STPMode_value = map[string]int32{
+		"UnknownSTPMode":     0,
+		"AllowSelfTrade":     1,
+		"CancelNewest":       2,
+		"CancelOldest":       3,
+		"CancelBoth":         4,
+		"DecrementAndCancel": 5,
+	}
+)
+
+func (x STPMode) Enum() *STPMode {
+	p := new(STPMode)
+	*p = x
+	return p
+}
+
+func (x STPMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (STPMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enum_enum_proto_enumTypes[4].Descriptor()
+}
+
+func (STPMode) Type() protoreflect.EnumType {
+	return &file_common_proto_enum_enum_proto_enumTypes[4]
+}
+
+func (x STPMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use STPMode.Descriptor instead.
+func (STPMode) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{4}
+}
+
 // 成交角色
 type FillRole int32
 
@@ -232,11 +358,11 @@ func (x FillRole) String() string {
 }
 
 func (FillRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enum_enum_proto_enumTypes[3].Descriptor()
+	return file_common_proto_enum_enum_proto_enumTypes[5].Descriptor()
 }
 
 func (FillRole) Type() protoreflect.EnumType {
-	return &file_common_proto_enum_enum_proto_enumTypes[3]
+	return &file_common_proto_enum_enum_proto_enumTypes[5]
 }
 
 func (x FillRole) Number() protoreflect.EnumNumber {
@@ -245,7 +371,7 @@ func (x FillRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FillRole.Descriptor instead.
 func (FillRole) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{3}
+	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{5}
 }
 
 // 报价类型
@@ -284,11 +410,11 @@ func (x Quote) String() string {
 }
 
 func (Quote) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enum_enum_proto_enumTypes[4].Descriptor()
+	return file_common_proto_enum_enum_proto_enumTypes[6].Descriptor()
 }
 
 func (Quote) Type() protoreflect.EnumType {
-	return &file_common_proto_enum_enum_proto_enumTypes[4]
+	return &file_common_proto_enum_enum_proto_enumTypes[6]
 }
 
 func (x Quote) Number() protoreflect.EnumNumber {
@@ -297,7 +423,7 @@ func (x Quote) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Quote.Descriptor instead.
 func (Quote) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{4}
+	return file_common_proto_enum_enum_proto_rawDescGZIP(), []int{6}
 }
 
 var File_common_proto_enum_enum_proto protoreflect.FileDescriptor
@@ -308,17 +434,31 @@ var file_common_proto_enum_enum_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2a, 0x2a, 0x0a, 0x04, 0x53, 0x69,
 	0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x64,
 	0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x75, 0x79, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x53, 0x65, 0x6c, 0x6c, 0x10, 0x02, 0x2a, 0x3a, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x53, 0x65, 0x6c, 0x6c, 0x10, 0x02, 0x2a, 0x43, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
 	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4f, 0x72,
 	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x4f, 0x10,
 	0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x4f, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4b,
-	0x10, 0x03, 0x2a, 0x6e, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x65, 0x77,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x61, 0x72,
-	0x74, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4c, 0x4c,
-	0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x61, 0x73, 0x74, 0x65, 0x64,
+	0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x10, 0x04, 0x2a, 0x7b, 0x0a, 0x0b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4c, 0x4c, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10,
+	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12,
+	0x0a, 0x0a, 0x06, 0x57, 0x61, 0x73, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x06, 0x2a, 0x49, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x65, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x07, 0x53, 0x54, 0x50, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x54, 0x50, 0x4d, 0x6f, 0x64, 0x65,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x6c, 0x66, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x74, 0x68, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
 	0x10, 0x05, 0x2a, 0x35, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13,
 	0x0a, 0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x6c,
 	0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x10, 0x01, 0x12, 0x09,
@@ -342,13 +482,15 @@ func file_common_proto_enum_enum_proto_rawDescGZIP() []byte {
 	return file_common_proto_enum_enum_proto_rawDescData
 }
 
-var file_common_proto_enum_enum_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_common_proto_enum_enum_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
 var file_common_proto_enum_enum_proto_goTypes = []interface{}{
-	(Side)(0),        // 0: commonEnum.Side
-	(OrderType)(0),   // 1: commonEnum.OrderType
-	(OrderStatus)(0), // 2: commonEnum.OrderStatus
-	(FillRole)(0),    // 3: commonEnum.FillRole
-	(Quote)(0),       // 4: commonEnum.Quote
+	(Side)(0),          // 0: commonEnum.Side
+	(OrderType)(0),     // 1: commonEnum.OrderType
+	(OrderStatus)(0),   // 2: commonEnum.OrderStatus
+	(TriggerStatus)(0), // 3: commonEnum.TriggerStatus
+	(STPMode)(0),       // 4: commonEnum.STPMode
+	(FillRole)(0),      // 5: commonEnum.FillRole
+	(Quote)(0),         // 6: commonEnum.Quote
 }
 var file_common_proto_enum_enum_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -368,7 +510,7 @@ func file_common_proto_enum_enum_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_proto_enum_enum_proto_rawDesc,
-			NumEnums:      5,
+			NumEnums:      7,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -30,6 +30,10 @@ type MatchReq struct {
 	//
 	//	*MatchReq_NewOrder
 	//	*MatchReq_Cancel
+	//	*MatchReq_Amend
+	//	*MatchReq_CancelAll
+	//	*MatchReq_Halt
+	//	*MatchReq_Timer
 	Operate isMatchReq_Operate `protobuf_oneof:"Operate"`
 }
 
@@ -86,6 +90,34 @@ func (x *MatchReq) GetCancel() *CancelOperate {
 	return nil
 }
 
+func (x *MatchReq) GetAmend() *AmendOperate {
+	if x, ok := x.GetOperate().(*MatchReq_Amend); ok {
+		return x.Amend
+	}
+	return nil
+}
+
+func (x *MatchReq) GetCancelAll() *CancelAllOperate {
+	if x, ok := x.GetOperate().(*MatchReq_CancelAll); ok {
+		return x.CancelAll
+	}
+	return nil
+}
+
+func (x *MatchReq) GetHalt() *HaltOperate {
+	if x, ok := x.GetOperate().(*MatchReq_Halt); ok {
+		return x.Halt
+	}
+	return nil
+}
+
+func (x *MatchReq) GetTimer() *TimerOperate {
+	if x, ok := x.GetOperate().(*MatchReq_Timer); ok {
+		return x.Timer
+	}
+	return nil
+}
+
 type isMatchReq_Operate interface {
 	isMatchReq_Operate()
 }
@@ -98,10 +130,34 @@ type MatchReq_Cancel struct {
 	Cancel *CancelOperate `protobuf:"bytes,2,opt,name=cancel,proto3,oneof"`
 }
 
+type MatchReq_Amend struct {
+	Amend *AmendOperate `protobuf:"bytes,3,opt,name=amend,proto3,oneof"`
+}
+
+type MatchReq_CancelAll struct {
+	CancelAll *CancelAllOperate `protobuf:"bytes,4,opt,name=cancel_all,json=cancelAll,proto3,oneof"`
+}
+
+type MatchReq_Halt struct {
+	Halt *HaltOperate `protobuf:"bytes,5,opt,name=halt,proto3,oneof"`
+}
+
+type MatchReq_Timer struct {
+	Timer *TimerOperate `protobuf:"bytes,6,opt,name=timer,proto3,oneof"`
+}
+
 func (*MatchReq_NewOrder) isMatchReq_Operate() {}
 
 func (*MatchReq_Cancel) isMatchReq_Operate() {}
 
+func (*MatchReq_Amend) isMatchReq_Operate() {}
+
+func (*MatchReq_CancelAll) isMatchReq_Operate() {}
+
+func (*MatchReq_Halt) isMatchReq_Operate() {}
+
+func (*MatchReq_Timer) isMatchReq_Operate() {}
+
 type MatchResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -111,8 +167,16 @@ type MatchResp struct {
 	//
 	//	*MatchResp_MatchResult
 	//	*MatchResp_Cancel
+	//	*MatchResp_Trigger
+	//	*MatchResp_Amend
 	Resp      isMatchResp_Resp `protobuf_oneof:"Resp"`
 	MessageId string           `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// 撮合结果的序号，每个交易对单调递增，主备切换之后从主节点最后发送的序号继续，下游丢弃小于等于已经处理过的序号的结果
+	Seq int64 `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	// 主备部署时产生这个结果的match_source消息id
+	SourceId []byte `protobuf:"bytes,7,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// 同一个match_source消息产生的第几个结果，从0开始
+	SourceIndex int32 `protobuf:"varint,8,opt,name=source_index,json=sourceIndex,proto3" json:"source_index,omitempty"`
 }
 
 func (x *MatchResp) Reset() {
@@ -168,6 +232,20 @@ func (x *MatchResp) GetCancel() *CancelResp {
 	return nil
 }
 
+func (x *MatchResp) GetTrigger() *TriggerResp {
+	if x, ok := x.GetResp().(*MatchResp_Trigger); ok {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *MatchResp) GetAmend() *AmendResp {
+	if x, ok := x.GetResp().(*MatchResp_Amend); ok {
+		return x.Amend
+	}
+	return nil
+}
+
 func (x *MatchResp) GetMessageId() string {
 	if x != nil {
 		return x.MessageId
@@ -175,6 +253,27 @@ func (x *MatchResp) GetMessageId() string {
 	return ""
 }
 
+func (x *MatchResp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MatchResp) GetSourceId() []byte {
+	if x != nil {
+		return x.SourceId
+	}
+	return nil
+}
+
+func (x *MatchResp) GetSourceIndex() int32 {
+	if x != nil {
+		return x.SourceIndex
+	}
+	return 0
+}
+
 type isMatchResp_Resp interface {
 	isMatchResp_Resp()
 }
@@ -187,10 +286,22 @@ type MatchResp_Cancel struct {
 	Cancel *CancelResp `protobuf:"bytes,2,opt,name=cancel,proto3,oneof"`
 }
 
+type MatchResp_Trigger struct {
+	Trigger *TriggerResp `protobuf:"bytes,4,opt,name=trigger,proto3,oneof"`
+}
+
+type MatchResp_Amend struct {
+	Amend *AmendResp `protobuf:"bytes,5,opt,name=amend,proto3,oneof"`
+}
+
 func (*MatchResp_MatchResult) isMatchResp_Resp() {}
 
 func (*MatchResp_Cancel) isMatchResp_Resp() {}
 
+func (*MatchResp_Trigger) isMatchResp_Resp() {}
+
+func (*MatchResp_Amend) isMatchResp_Resp() {}
+
 // 下单操作
 type NewOrderOperate struct {
 	state         protoimpl.MessageState
@@ -209,14 +320,34 @@ type NewOrderOperate struct {
 	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
 	// 订单数量
 	Qty string `protobuf:"bytes,4,opt,name=qty,proto3" json:"qty,omitempty"`
-	// 订单金额 计价币数量
+	// 订单金额 计价币数量,按照数量的市价买单为冻结的最大金额
 	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	// 订单类型
+	// 订单类型 市价单 限价单 FOK IOC
 	OrderType enum.OrderType `protobuf:"varint,6,opt,name=order_type,json=orderType,proto3,enum=commonEnum.OrderType" json:"order_type,omitempty"`
 	// 交易对id
 	SymbolId int32 `protobuf:"varint,8,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
 	// 交易对名称
 	SymbolName string `protobuf:"bytes,10,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 是否只做maker,限价单如果会立即成交则撤单
+	PostOnly bool `protobuf:"varint,11,opt,name=post_only,json=postOnly,proto3" json:"post_only,omitempty"`
+	// 触发价格,不为空则为条件单,最新成交价达到触发价后按照限价单或市价单撮合
+	TriggerPrice string `protobuf:"bytes,12,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// 自成交保护模式,未指定则使用交易对的默认配置
+	StpMode enum.STPMode `protobuf:"varint,13,opt,name=stp_mode,json=stpMode,proto3,enum=commonEnum.STPMode" json:"stp_mode,omitempty"`
+	// maker手续费率,下单时根据交易对和用户的vip等级确定
+	MakerFeeRate string `protobuf:"bytes,14,opt,name=maker_fee_rate,json=makerFeeRate,proto3" json:"maker_fee_rate,omitempty"`
+	// taker手续费率
+	TakerFeeRate string `protobuf:"bytes,15,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	// 冰山单每次显示的数量,为空则不是冰山单
+	DisplayQty string `protobuf:"bytes,16,opt,name=display_qty,json=displayQty,proto3" json:"display_qty,omitempty"`
+	// 过期时间 单位秒,为0则一直有效,到期之后撮合引擎撤销
+	ExpireTime int64 `protobuf:"varint,17,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// 市价单的最大滑点,例如0.05表示成交价最多偏离对手盘一档价格的5%,为空则不限制
+	MaxSlippage string `protobuf:"bytes,18,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// 市价单的保护价格,买单为最高成交价,卖单为最低成交价,为空则不限制
+	ProtectionPrice string `protobuf:"bytes,19,opt,name=protection_price,json=protectionPrice,proto3" json:"protection_price,omitempty"`
+	// 市价买单按照数量买入,金额为冻结的最大金额
+	ByQty bool `protobuf:"varint,20,opt,name=by_qty,json=byQty,proto3" json:"by_qty,omitempty"`
 }
 
 func (x *NewOrderOperate) Reset() {
@@ -321,6 +452,76 @@ func (x *NewOrderOperate) GetSymbolName() string {
 	return ""
 }
 
+func (x *NewOrderOperate) GetPostOnly() bool {
+	if x != nil {
+		return x.PostOnly
+	}
+	return false
+}
+
+func (x *NewOrderOperate) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetStpMode() enum.STPMode {
+	if x != nil {
+		return x.StpMode
+	}
+	return enum.STPMode(0)
+}
+
+func (x *NewOrderOperate) GetMakerFeeRate() string {
+	if x != nil {
+		return x.MakerFeeRate
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetTakerFeeRate() string {
+	if x != nil {
+		return x.TakerFeeRate
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetDisplayQty() string {
+	if x != nil {
+		return x.DisplayQty
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *NewOrderOperate) GetMaxSlippage() string {
+	if x != nil {
+		return x.MaxSlippage
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetProtectionPrice() string {
+	if x != nil {
+		return x.ProtectionPrice
+	}
+	return ""
+}
+
+func (x *NewOrderOperate) GetByQty() bool {
+	if x != nil {
+		return x.ByQty
+	}
+	return false
+}
+
 // 取消订单操作。
 type CancelOperate struct {
 	state         protoimpl.MessageState
@@ -393,33 +594,25 @@ func (x *CancelOperate) GetOrderType() enum.OrderType {
 	return enum.OrderType(0)
 }
 
-type OrderResp struct {
+// 修改订单操作,只修改价格和数量,只减少数量时保持排队的优先级,修改价格或者增加数量重新排队
+type AmendOperate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 主键id
-	Id int64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
-	// 用户id
-	Uid int64 `protobuf:"varint,6,opt,name=uid,proto3" json:"uid,omitempty"`
-	// 订单id
-	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	// 已经成交数量
-	FilledQty string `protobuf:"bytes,2,opt,name=filled_qty,json=filledQty,proto3" json:"filled_qty,omitempty"`
-	// 未成交的数量
-	UnFilledQty string `protobuf:"bytes,7,opt,name=un_filled_qty,json=unFilledQty,proto3" json:"un_filled_qty,omitempty"`
-	// 已经成交金额
-	FilledAmount string `protobuf:"bytes,3,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
-	// 未成交的金额
-	UnFilledAmount string `protobuf:"bytes,4,opt,name=un_filled_amount,json=unFilledAmount,proto3" json:"un_filled_amount,omitempty"`
-	// 订单状态
-	OrderStatus enum.OrderStatus `protobuf:"varint,5,opt,name=order_status,json=orderStatus,proto3,enum=commonEnum.OrderStatus" json:"order_status,omitempty"`
-	// 解冻金额
-	UnFrozenAmount string `protobuf:"bytes,9,opt,name=un_frozen_amount,json=unFrozenAmount,proto3" json:"un_frozen_amount,omitempty"`
+	Id        int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                          //主键id
+	Uid       int64          `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`                                                        //用户id
+	Side      enum.Side      `protobuf:"varint,3,opt,name=side,proto3,enum=commonEnum.Side" json:"side,omitempty"`                                 //方向
+	OrderType enum.OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=commonEnum.OrderType" json:"order_type,omitempty"` //订单类型
+	Price     string         `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                                                     //修改之前的价格,用于在订单簿中查找订单
+	NewPrice  string         `protobuf:"bytes,6,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`                               //修改之后的价格
+	NewQty    string         `protobuf:"bytes,7,opt,name=new_qty,json=newQty,proto3" json:"new_qty,omitempty"`                                     //修改之后的订单数量,包括已经成交的数量
+	FrozenQty string         `protobuf:"bytes,8,opt,name=frozen_qty,json=frozenQty,proto3" json:"frozen_qty,omitempty"`                            //修改之前额外冻结的数量,买单为计价币,卖单为基础币
+	QueueId   int64          `protobuf:"varint,9,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`                                 //重新排队的序号,修改价格或者增加数量之后按照这个序号排在相同价格的订单后面
 }
 
-func (x *OrderResp) Reset() {
-	*x = OrderResp{}
+func (x *AmendOperate) Reset() {
+	*x = AmendOperate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mq_match_match_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,13 +620,13 @@ func (x *OrderResp) Reset() {
 	}
 }
 
-func (x *OrderResp) String() string {
+func (x *AmendOperate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderResp) ProtoMessage() {}
+func (*AmendOperate) ProtoMessage() {}
 
-func (x *OrderResp) ProtoReflect() protoreflect.Message {
+func (x *AmendOperate) ProtoReflect() protoreflect.Message {
 	mi := &file_mq_match_match_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,111 +638,86 @@ func (x *OrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderResp.ProtoReflect.Descriptor instead.
-func (*OrderResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AmendOperate.ProtoReflect.Descriptor instead.
+func (*AmendOperate) Descriptor() ([]byte, []int) {
 	return file_mq_match_match_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *OrderResp) GetId() int64 {
+func (x *AmendOperate) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *OrderResp) GetUid() int64 {
+func (x *AmendOperate) GetUid() int64 {
 	if x != nil {
 		return x.Uid
 	}
 	return 0
 }
 
-func (x *OrderResp) GetOrderId() string {
+func (x *AmendOperate) GetSide() enum.Side {
 	if x != nil {
-		return x.OrderId
+		return x.Side
 	}
-	return ""
+	return enum.Side(0)
 }
 
-func (x *OrderResp) GetFilledQty() string {
+func (x *AmendOperate) GetOrderType() enum.OrderType {
 	if x != nil {
-		return x.FilledQty
+		return x.OrderType
 	}
-	return ""
+	return enum.OrderType(0)
 }
 
-func (x *OrderResp) GetUnFilledQty() string {
+func (x *AmendOperate) GetPrice() string {
 	if x != nil {
-		return x.UnFilledQty
+		return x.Price
 	}
 	return ""
 }
 
-func (x *OrderResp) GetFilledAmount() string {
+func (x *AmendOperate) GetNewPrice() string {
 	if x != nil {
-		return x.FilledAmount
+		return x.NewPrice
 	}
 	return ""
 }
 
-func (x *OrderResp) GetUnFilledAmount() string {
+func (x *AmendOperate) GetNewQty() string {
 	if x != nil {
-		return x.UnFilledAmount
+		return x.NewQty
 	}
 	return ""
 }
 
-func (x *OrderResp) GetOrderStatus() enum.OrderStatus {
+func (x *AmendOperate) GetFrozenQty() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.FrozenQty
 	}
-	return enum.OrderStatus(0)
+	return ""
 }
 
-func (x *OrderResp) GetUnFrozenAmount() string {
+func (x *AmendOperate) GetQueueId() int64 {
 	if x != nil {
-		return x.UnFrozenAmount
+		return x.QueueId
 	}
-	return ""
+	return 0
 }
 
-// MatchResp 撮合结果
-type MatchResult struct {
+// 批量撤单操作,撤销用户在订单簿中的订单和未触发的条件单,每个订单返回一个撤单结果
+type CancelAllOperate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 交易对id
-	SymbolId int32 `protobuf:"varint,8,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
-	// 交易对id
-	SymbolName string `protobuf:"bytes,9,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 基础币id
-	BaseCoinId int32 `protobuf:"varint,21,opt,name=base_coin_id,json=baseCoinId,proto3" json:"base_coin_id,omitempty"`
-	// 基价币id
-	QuoteCoinId int32 `protobuf:"varint,20,opt,name=quote_coin_id,json=quoteCoinId,proto3" json:"quote_coin_id,omitempty"`
-	// 撮合id
-	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	// taker是否是买
-	TakerIsBuy    bool                         `protobuf:"varint,22,opt,name=taker_is_buy,json=takerIsBuy,proto3" json:"taker_is_buy,omitempty"`
-	MatchedRecord []*MatchResult_MatchedRecord `protobuf:"bytes,2,rep,name=matched_record,json=matchedRecord,proto3" json:"matched_record,omitempty"`
-	// 开始价格
-	BeginPrice string `protobuf:"bytes,3,opt,name=begin_price,json=beginPrice,proto3" json:"begin_price,omitempty"`
-	// 结束价格
-	EndPrice string `protobuf:"bytes,4,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	// 撮合时间
-	MatchTime int64 `protobuf:"varint,6,opt,name=match_time,json=matchTime,proto3" json:"match_time,omitempty"`
-	// 数量
-	Qty string `protobuf:"bytes,7,opt,name=qty,proto3" json:"qty,omitempty"`
-	// 金额
-	Amount string `protobuf:"bytes,11,opt,name=amount,proto3" json:"amount,omitempty"`
-	// 最高价
-	HighPrice string `protobuf:"bytes,12,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
-	// 最低价
-	LowPrice string `protobuf:"bytes,10,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	Uid  int64     `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`                        //用户id
+	Side enum.Side `protobuf:"varint,2,opt,name=side,proto3,enum=commonEnum.Side" json:"side,omitempty"` //方向,为空则撤销两个方向的订单
 }
 
-func (x *MatchResult) Reset() {
-	*x = MatchResult{}
+func (x *CancelAllOperate) Reset() {
+	*x = CancelAllOperate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mq_match_match_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,13 +725,13 @@ func (x *MatchResult) Reset() {
 	}
 }
 
-func (x *MatchResult) String() string {
+func (x *CancelAllOperate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchResult) ProtoMessage() {}
+func (*CancelAllOperate) ProtoMessage() {}
 
-func (x *MatchResult) ProtoReflect() protoreflect.Message {
+func (x *CancelAllOperate) ProtoReflect() protoreflect.Message {
 	mi := &file_mq_match_match_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,142 +743,589 @@ func (x *MatchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
-func (*MatchResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAllOperate.ProtoReflect.Descriptor instead.
+func (*CancelAllOperate) Descriptor() ([]byte, []int) {
 	return file_mq_match_match_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MatchResult) GetSymbolId() int32 {
+func (x *CancelAllOperate) GetUid() int64 {
 	if x != nil {
-		return x.SymbolId
+		return x.Uid
 	}
 	return 0
 }
 
-func (x *MatchResult) GetSymbolName() string {
+func (x *CancelAllOperate) GetSide() enum.Side {
 	if x != nil {
-		return x.SymbolName
+		return x.Side
 	}
-	return ""
+	return enum.Side(0)
 }
 
-func (x *MatchResult) GetBaseCoinId() int32 {
-	if x != nil {
-		return x.BaseCoinId
-	}
-	return 0
-}
+// 人工暂停或者恢复交易,暂停期间只接受撤单
+type HaltOperate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *MatchResult) GetQuoteCoinId() int32 {
-	if x != nil {
-		return x.QuoteCoinId
-	}
-	return 0
+	Halted bool `protobuf:"varint,1,opt,name=halted,proto3" json:"halted,omitempty"` //true暂停交易 false恢复交易
 }
 
-func (x *MatchResult) GetMatchId() string {
-	if x != nil {
-		return x.MatchId
+func (x *HaltOperate) Reset() {
+	*x = HaltOperate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MatchResult) GetTakerIsBuy() bool {
-	if x != nil {
-		return x.TakerIsBuy
-	}
-	return false
+func (x *HaltOperate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MatchResult) GetMatchedRecord() []*MatchResult_MatchedRecord {
-	if x != nil {
-		return x.MatchedRecord
+func (*HaltOperate) ProtoMessage() {}
+
+func (x *HaltOperate) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MatchResult) GetBeginPrice() string {
-	if x != nil {
-		return x.BeginPrice
-	}
-	return ""
+// Deprecated: Use HaltOperate.ProtoReflect.Descriptor instead.
+func (*HaltOperate) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MatchResult) GetEndPrice() string {
+func (x *HaltOperate) GetHalted() bool {
 	if x != nil {
-		return x.EndPrice
+		return x.Halted
 	}
-	return ""
+	return false
+}
+
+// 定时消息,没有新的消息时主节点在限时单过期或者集合竞价结束时发送,撮合引擎的时间推进到time之后撤销过期的订单、结束集合竞价
+type TimerOperate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` //到期的时间 纳秒时间戳
+}
+
+func (x *TimerOperate) Reset() {
+	*x = TimerOperate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerOperate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerOperate) ProtoMessage() {}
+
+func (x *TimerOperate) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerOperate.ProtoReflect.Descriptor instead.
+func (*TimerOperate) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TimerOperate) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type OrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 主键id
+	Id int64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+	// 用户id
+	Uid int64 `protobuf:"varint,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 订单id
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 已经成交数量
+	FilledQty string `protobuf:"bytes,2,opt,name=filled_qty,json=filledQty,proto3" json:"filled_qty,omitempty"`
+	// 未成交的数量
+	UnFilledQty string `protobuf:"bytes,7,opt,name=un_filled_qty,json=unFilledQty,proto3" json:"un_filled_qty,omitempty"`
+	// 已经成交金额
+	FilledAmount string `protobuf:"bytes,3,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	// 未成交的金额
+	UnFilledAmount string `protobuf:"bytes,4,opt,name=un_filled_amount,json=unFilledAmount,proto3" json:"un_filled_amount,omitempty"`
+	// 订单状态
+	OrderStatus enum.OrderStatus `protobuf:"varint,5,opt,name=order_status,json=orderStatus,proto3,enum=commonEnum.OrderStatus" json:"order_status,omitempty"`
+	// 解冻金额
+	UnFrozenAmount string `protobuf:"bytes,9,opt,name=un_frozen_amount,json=unFrozenAmount,proto3" json:"un_frozen_amount,omitempty"`
+	// 订单累计的手续费,买单收取基础币,卖单收取计价币
+	Fee string `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	// maker排队的序号,冰山单补充显示的数量之后重新排队,订单服务保存之后重启按照这个序号恢复排队的位置
+	QueueId int64 `protobuf:"varint,11,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+}
+
+func (x *OrderResp) Reset() {
+	*x = OrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResp) ProtoMessage() {}
+
+func (x *OrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResp.ProtoReflect.Descriptor instead.
+func (*OrderResp) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrderResp) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderResp) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *OrderResp) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderResp) GetFilledQty() string {
+	if x != nil {
+		return x.FilledQty
+	}
+	return ""
+}
+
+func (x *OrderResp) GetUnFilledQty() string {
+	if x != nil {
+		return x.UnFilledQty
+	}
+	return ""
+}
+
+func (x *OrderResp) GetFilledAmount() string {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return ""
+}
+
+func (x *OrderResp) GetUnFilledAmount() string {
+	if x != nil {
+		return x.UnFilledAmount
+	}
+	return ""
+}
+
+func (x *OrderResp) GetOrderStatus() enum.OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return enum.OrderStatus(0)
+}
+
+func (x *OrderResp) GetUnFrozenAmount() string {
+	if x != nil {
+		return x.UnFrozenAmount
+	}
+	return ""
+}
+
+func (x *OrderResp) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *OrderResp) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+// MatchResp 撮合结果
+type MatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 交易对id
+	SymbolId int32 `protobuf:"varint,8,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+	// 交易对id
+	SymbolName string `protobuf:"bytes,9,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 基础币id
+	BaseCoinId int32 `protobuf:"varint,21,opt,name=base_coin_id,json=baseCoinId,proto3" json:"base_coin_id,omitempty"`
+	// 基价币id
+	QuoteCoinId int32 `protobuf:"varint,20,opt,name=quote_coin_id,json=quoteCoinId,proto3" json:"quote_coin_id,omitempty"`
+	// 撮合id
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// taker是否是买
+	TakerIsBuy    bool                         `protobuf:"varint,22,opt,name=taker_is_buy,json=takerIsBuy,proto3" json:"taker_is_buy,omitempty"`
+	MatchedRecord []*MatchResult_MatchedRecord `protobuf:"bytes,2,rep,name=matched_record,json=matchedRecord,proto3" json:"matched_record,omitempty"`
+	// 开始价格
+	BeginPrice string `protobuf:"bytes,3,opt,name=begin_price,json=beginPrice,proto3" json:"begin_price,omitempty"`
+	// 结束价格
+	EndPrice string `protobuf:"bytes,4,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	// 撮合时间
+	MatchTime int64 `protobuf:"varint,6,opt,name=match_time,json=matchTime,proto3" json:"match_time,omitempty"`
+	// 数量
+	Qty string `protobuf:"bytes,7,opt,name=qty,proto3" json:"qty,omitempty"`
+	// 金额
+	Amount string `protobuf:"bytes,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 最高价
+	HighPrice string `protobuf:"bytes,12,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	// 最低价
+	LowPrice string `protobuf:"bytes,10,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+}
+
+func (x *MatchResult) Reset() {
+	*x = MatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResult) ProtoMessage() {}
+
+func (x *MatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
+func (*MatchResult) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MatchResult) GetSymbolId() int32 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *MatchResult) GetSymbolName() string {
+	if x != nil {
+		return x.SymbolName
+	}
+	return ""
+}
+
+func (x *MatchResult) GetBaseCoinId() int32 {
+	if x != nil {
+		return x.BaseCoinId
+	}
+	return 0
+}
+
+func (x *MatchResult) GetQuoteCoinId() int32 {
+	if x != nil {
+		return x.QuoteCoinId
+	}
+	return 0
+}
+
+func (x *MatchResult) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchResult) GetTakerIsBuy() bool {
+	if x != nil {
+		return x.TakerIsBuy
+	}
+	return false
+}
+
+func (x *MatchResult) GetMatchedRecord() []*MatchResult_MatchedRecord {
+	if x != nil {
+		return x.MatchedRecord
+	}
+	return nil
+}
+
+func (x *MatchResult) GetBeginPrice() string {
+	if x != nil {
+		return x.BeginPrice
+	}
+	return ""
+}
+
+func (x *MatchResult) GetEndPrice() string {
+	if x != nil {
+		return x.EndPrice
+	}
+	return ""
 }
 
 func (x *MatchResult) GetMatchTime() int64 {
 	if x != nil {
-		return x.MatchTime
+		return x.MatchTime
+	}
+	return 0
+}
+
+func (x *MatchResult) GetQty() string {
+	if x != nil {
+		return x.Qty
+	}
+	return ""
+}
+
+func (x *MatchResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *MatchResult) GetHighPrice() string {
+	if x != nil {
+		return x.HighPrice
+	}
+	return ""
+}
+
+func (x *MatchResult) GetLowPrice() string {
+	if x != nil {
+		return x.LowPrice
+	}
+	return ""
+}
+
+// 取消订单返回，表示订单被撤单
+type CancelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单主键id
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 解冻币种id
+	CoinId int32 `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	// 数量
+	Qty string `protobuf:"bytes,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	// 用户id
+	Uid int64 `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 自成交保护减少的数量,不为空表示订单只是减少了未成交数量,没有撤销
+	DecrementQty string `protobuf:"bytes,5,opt,name=decrement_qty,json=decrementQty,proto3" json:"decrement_qty,omitempty"`
+	// 减少之后的未成交数量
+	UnFilledQty string `protobuf:"bytes,6,opt,name=un_filled_qty,json=unFilledQty,proto3" json:"un_filled_qty,omitempty"`
+	// 减少之后的未成交金额
+	UnFilledAmount string `protobuf:"bytes,7,opt,name=un_filled_amount,json=unFilledAmount,proto3" json:"un_filled_amount,omitempty"`
+	// 减少之后的订单数量
+	OrderQty string `protobuf:"bytes,8,opt,name=order_qty,json=orderQty,proto3" json:"order_qty,omitempty"`
+	// 减少之后的订单金额
+	OrderAmount string `protobuf:"bytes,9,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	// 是否是订单过期撤销
+	Expired bool `protobuf:"varint,10,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *CancelResp) Reset() {
+	*x = CancelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResp) ProtoMessage() {}
+
+func (x *CancelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResp.ProtoReflect.Descriptor instead.
+func (*CancelResp) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelResp) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MatchResult) GetQty() string {
+func (x *CancelResp) GetCoinId() int32 {
+	if x != nil {
+		return x.CoinId
+	}
+	return 0
+}
+
+func (x *CancelResp) GetQty() string {
 	if x != nil {
 		return x.Qty
 	}
 	return ""
 }
 
-func (x *MatchResult) GetAmount() string {
+func (x *CancelResp) GetUid() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CancelResp) GetDecrementQty() string {
+	if x != nil {
+		return x.DecrementQty
 	}
 	return ""
 }
 
-func (x *MatchResult) GetHighPrice() string {
+func (x *CancelResp) GetUnFilledQty() string {
 	if x != nil {
-		return x.HighPrice
+		return x.UnFilledQty
 	}
 	return ""
 }
 
-func (x *MatchResult) GetLowPrice() string {
+func (x *CancelResp) GetUnFilledAmount() string {
 	if x != nil {
-		return x.LowPrice
+		return x.UnFilledAmount
 	}
 	return ""
 }
 
-// 取消订单返回，表示订单被撤单
-type CancelResp struct {
+func (x *CancelResp) GetOrderQty() string {
+	if x != nil {
+		return x.OrderQty
+	}
+	return ""
+}
+
+func (x *CancelResp) GetOrderAmount() string {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return ""
+}
+
+func (x *CancelResp) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+// 条件单触发返回，表示条件单已经触发进入撮合
+type TriggerResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 订单主键id
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 解冻币种id
-	CoinId int32 `protobuf:"varint,2,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
-	// 数量
-	Qty string `protobuf:"bytes,3,opt,name=qty,proto3" json:"qty,omitempty"`
 	// 用户id
-	Uid int64 `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 订单id
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 触发价格
+	TriggerPrice string `protobuf:"bytes,4,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// 触发时的最新成交价
+	LastPrice string `protobuf:"bytes,5,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
 }
 
-func (x *CancelResp) Reset() {
-	*x = CancelResp{}
+func (x *TriggerResp) Reset() {
+	*x = TriggerResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mq_match_match_proto_msgTypes[6]
+		mi := &file_mq_match_match_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelResp) String() string {
+func (x *TriggerResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelResp) ProtoMessage() {}
+func (*TriggerResp) ProtoMessage() {}
 
-func (x *CancelResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mq_match_match_proto_msgTypes[6]
+func (x *TriggerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,35 +1336,190 @@ func (x *CancelResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelResp.ProtoReflect.Descriptor instead.
-func (*CancelResp) Descriptor() ([]byte, []int) {
-	return file_mq_match_match_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TriggerResp.ProtoReflect.Descriptor instead.
+func (*TriggerResp) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CancelResp) GetId() int64 {
+func (x *TriggerResp) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CancelResp) GetCoinId() int32 {
+func (x *TriggerResp) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *TriggerResp) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TriggerResp) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *TriggerResp) GetLastPrice() string {
+	if x != nil {
+		return x.LastPrice
+	}
+	return ""
+}
+
+// 修改订单返回
+type AmendResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 订单主键id
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 用户id
+	Uid int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 解冻币种id 买单为计价币id 卖单为基础币id
+	CoinId int32 `protobuf:"varint,3,opt,name=coin_id,json=coinId,proto3" json:"coin_id,omitempty"`
+	// 解冻的数量,修改之前额外冻结的数量加上原来冻结的数量减去修改之后需要冻结的数量
+	UnFrozenQty string `protobuf:"bytes,4,opt,name=un_frozen_qty,json=unFrozenQty,proto3" json:"un_frozen_qty,omitempty"`
+	// 修改失败,订单不变,额外冻结的数量全部解冻
+	Rejected bool `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// 修改之后的价格
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// 修改之后的订单数量
+	Qty string `protobuf:"bytes,7,opt,name=qty,proto3" json:"qty,omitempty"`
+	// 修改之后的订单金额
+	Amount string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 修改之后的未成交数量
+	UnFilledQty string `protobuf:"bytes,9,opt,name=un_filled_qty,json=unFilledQty,proto3" json:"un_filled_qty,omitempty"`
+	// 修改之后的未成交金额
+	UnFilledAmount string `protobuf:"bytes,10,opt,name=un_filled_amount,json=unFilledAmount,proto3" json:"un_filled_amount,omitempty"`
+	// 订单状态
+	OrderStatus enum.OrderStatus `protobuf:"varint,11,opt,name=order_status,json=orderStatus,proto3,enum=commonEnum.OrderStatus" json:"order_status,omitempty"`
+	// 排队的序号,修改价格或者增加数量之后重新排队,订单服务保存之后重启按照这个序号恢复排队的位置
+	QueueId int64 `protobuf:"varint,12,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+}
+
+func (x *AmendResp) Reset() {
+	*x = AmendResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mq_match_match_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendResp) ProtoMessage() {}
+
+func (x *AmendResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mq_match_match_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendResp.ProtoReflect.Descriptor instead.
+func (*AmendResp) Descriptor() ([]byte, []int) {
+	return file_mq_match_match_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AmendResp) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AmendResp) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *AmendResp) GetCoinId() int32 {
 	if x != nil {
 		return x.CoinId
 	}
 	return 0
 }
 
-func (x *CancelResp) GetQty() string {
+func (x *AmendResp) GetUnFrozenQty() string {
+	if x != nil {
+		return x.UnFrozenQty
+	}
+	return ""
+}
+
+func (x *AmendResp) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+func (x *AmendResp) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *AmendResp) GetQty() string {
 	if x != nil {
 		return x.Qty
 	}
 	return ""
 }
 
-func (x *CancelResp) GetUid() int64 {
+func (x *AmendResp) GetAmount() string {
 	if x != nil {
-		return x.Uid
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AmendResp) GetUnFilledQty() string {
+	if x != nil {
+		return x.UnFilledQty
+	}
+	return ""
+}
+
+func (x *AmendResp) GetUnFilledAmount() string {
+	if x != nil {
+		return x.UnFilledAmount
+	}
+	return ""
+}
+
+func (x *AmendResp) GetOrderStatus() enum.OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return enum.OrderStatus(0)
+}
+
+func (x *AmendResp) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
 	}
 	return 0
 }
@@ -772,12 +1542,16 @@ type MatchResult_MatchedRecord struct {
 	Taker *OrderResp `protobuf:"bytes,7,opt,name=taker,proto3" json:"taker,omitempty"`
 	// maker 订单
 	Maker *OrderResp `protobuf:"bytes,8,opt,name=maker,proto3" json:"maker,omitempty"`
+	// 本次匹配taker的手续费,taker买则为基础币,taker卖则为计价币
+	TakerFee string `protobuf:"bytes,11,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// 本次匹配maker的手续费,maker买则为基础币,maker卖则为计价币
+	MakerFee string `protobuf:"bytes,12,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
 }
 
 func (x *MatchResult_MatchedRecord) Reset() {
 	*x = MatchResult_MatchedRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mq_match_match_proto_msgTypes[7]
+		mi := &file_mq_match_match_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -790,7 +1564,7 @@ func (x *MatchResult_MatchedRecord) String() string {
 func (*MatchResult_MatchedRecord) ProtoMessage() {}
 
 func (x *MatchResult_MatchedRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_mq_match_match_proto_msgTypes[7]
+	mi := &file_mq_match_match_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1577,7 @@ func (x *MatchResult_MatchedRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchResult_MatchedRecord.ProtoReflect.Descriptor instead.
 func (*MatchResult_MatchedRecord) Descriptor() ([]byte, []int) {
-	return file_mq_match_match_proto_rawDescGZIP(), []int{5, 0}
+	return file_mq_match_match_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *MatchResult_MatchedRecord) GetQty() string {
@@ -848,131 +1622,269 @@ func (x *MatchResult_MatchedRecord) GetMaker() *OrderResp {
 	return nil
 }
 
+func (x *MatchResult_MatchedRecord) GetTakerFee() string {
+	if x != nil {
+		return x.TakerFee
+	}
+	return ""
+}
+
+func (x *MatchResult_MatchedRecord) GetMakerFee() string {
+	if x != nil {
+		return x.MakerFee
+	}
+	return ""
+}
+
 var File_mq_match_match_proto protoreflect.FileDescriptor
 
 var file_mq_match_match_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x6d, 0x71, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71,
 	0x1a, 0x0f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x38,
+	0x6f, 0x22, 0xcc, 0x02, 0x0a, 0x08, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x38,
 	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4e, 0x65, 0x77,
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08,
 	0x6e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63,
 	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x4d, 0x71, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x2e, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x42,
-	0x06, 0x0a, 0x04, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb9, 0x02, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x61,
+	0x6d, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x68, 0x61, 0x6c, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d,
+	0x71, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x04, 0x68, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0xd0, 0x02, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x05, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x06, 0x0a, 0x04, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x9e, 0x05, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x70, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x2e, 0x53, 0x54, 0x50, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x73, 0x74, 0x70, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6b, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x51, 0x74, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x62, 0x79, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62,
+	0x79, 0x51, 0x74, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45,
+	0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73,
 	0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64,
 	0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e,
 	0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74,
-	0x79, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71,
-	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c,
-	0x65, 0x64, 0x51, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69,
-	0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e,
-	0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x72,
-	0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x05, 0x0a, 0x0b, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75,
-	0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x6b,
-	0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x75, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x73, 0x42, 0x75, 0x79, 0x12, 0x4a, 0x0a, 0x0e, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x67, 0x69, 0x6e,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x65,
-	0x67, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0xc7, 0x01, 0x0a, 0x0d, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x52, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x61, 0x6b,
-	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x4d, 0x71, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x6d,
-	0x61, 0x6b, 0x65, 0x72, 0x22, 0x59, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x42,
-	0x1f, 0x5a, 0x1d, 0x2e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x6d, 0x71, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x3b, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65,
+	0x77, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77,
+	0x51, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x71, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x51,
+	0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a,
+	0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x53,
+	0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x22, 0x25, 0x0a, 0x0b, 0x48, 0x61, 0x6c,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64,
+	0x22, 0x22, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0xed, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74, 0x79, 0x12, 0x22,
+	0x0a, 0x0d, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51,
+	0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x5f, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x75, 0x6e, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x64, 0x22, 0xe1, 0x05, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f,
+	0x62, 0x75, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x49, 0x73, 0x42, 0x75, 0x79, 0x12, 0x4a, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69,
+	0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x1a, 0x81, 0x02, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x4d, 0x71, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x71, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71,
+	0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x71, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x51, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x09, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x75,
+	0x6e, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x51, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x71, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x75,
+	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x51, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64,
+	0x42, 0x1f, 0x5a, 0x1d, 0x2e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x6d, 0x71, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x3b, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -987,38 +1899,56 @@ func file_mq_match_match_proto_rawDescGZIP() []byte {
 	return file_mq_match_match_proto_rawDescData
 }
 
-var file_mq_match_match_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mq_match_match_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_mq_match_match_proto_goTypes = []interface{}{
 	(*MatchReq)(nil),                  // 0: commonMq.MatchReq
 	(*MatchResp)(nil),                 // 1: commonMq.MatchResp
 	(*NewOrderOperate)(nil),           // 2: commonMq.NewOrderOperate
 	(*CancelOperate)(nil),             // 3: commonMq.CancelOperate
-	(*OrderResp)(nil),                 // 4: commonMq.OrderResp
-	(*MatchResult)(nil),               // 5: commonMq.MatchResult
-	(*CancelResp)(nil),                // 6: commonMq.CancelResp
-	(*MatchResult_MatchedRecord)(nil), // 7: commonMq.MatchResult.MatchedRecord
-	(enum.Side)(0),                    // 8: commonEnum.Side
-	(enum.OrderType)(0),               // 9: commonEnum.OrderType
-	(enum.OrderStatus)(0),             // 10: commonEnum.OrderStatus
+	(*AmendOperate)(nil),              // 4: commonMq.AmendOperate
+	(*CancelAllOperate)(nil),          // 5: commonMq.CancelAllOperate
+	(*HaltOperate)(nil),               // 6: commonMq.HaltOperate
+	(*TimerOperate)(nil),              // 7: commonMq.TimerOperate
+	(*OrderResp)(nil),                 // 8: commonMq.OrderResp
+	(*MatchResult)(nil),               // 9: commonMq.MatchResult
+	(*CancelResp)(nil),                // 10: commonMq.CancelResp
+	(*TriggerResp)(nil),               // 11: commonMq.TriggerResp
+	(*AmendResp)(nil),                 // 12: commonMq.AmendResp
+	(*MatchResult_MatchedRecord)(nil), // 13: commonMq.MatchResult.MatchedRecord
+	(enum.Side)(0),                    // 14: commonEnum.Side
+	(enum.OrderType)(0),               // 15: commonEnum.OrderType
+	(enum.STPMode)(0),                 // 16: commonEnum.STPMode
+	(enum.OrderStatus)(0),             // 17: commonEnum.OrderStatus
 }
 var file_mq_match_match_proto_depIdxs = []int32{
 	2,  // 0: commonMq.MatchReq.new_order:type_name -> commonMq.NewOrderOperate
 	3,  // 1: commonMq.MatchReq.cancel:type_name -> commonMq.CancelOperate
-	5,  // 2: commonMq.MatchResp.match_result:type_name -> commonMq.MatchResult
-	6,  // 3: commonMq.MatchResp.cancel:type_name -> commonMq.CancelResp
-	8,  // 4: commonMq.NewOrderOperate.side:type_name -> commonEnum.Side
-	9,  // 5: commonMq.NewOrderOperate.order_type:type_name -> commonEnum.OrderType
-	8,  // 6: commonMq.CancelOperate.side:type_name -> commonEnum.Side
-	9,  // 7: commonMq.CancelOperate.order_type:type_name -> commonEnum.OrderType
-	10, // 8: commonMq.OrderResp.order_status:type_name -> commonEnum.OrderStatus
-	7,  // 9: commonMq.MatchResult.matched_record:type_name -> commonMq.MatchResult.MatchedRecord
-	4,  // 10: commonMq.MatchResult.MatchedRecord.taker:type_name -> commonMq.OrderResp
-	4,  // 11: commonMq.MatchResult.MatchedRecord.maker:type_name -> commonMq.OrderResp
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	4,  // 2: commonMq.MatchReq.amend:type_name -> commonMq.AmendOperate
+	5,  // 3: commonMq.MatchReq.cancel_all:type_name -> commonMq.CancelAllOperate
+	6,  // 4: commonMq.MatchReq.halt:type_name -> commonMq.HaltOperate
+	7,  // 5: commonMq.MatchReq.timer:type_name -> commonMq.TimerOperate
+	9,  // 6: commonMq.MatchResp.match_result:type_name -> commonMq.MatchResult
+	10, // 7: commonMq.MatchResp.cancel:type_name -> commonMq.CancelResp
+	11, // 8: commonMq.MatchResp.trigger:type_name -> commonMq.TriggerResp
+	12, // 9: commonMq.MatchResp.amend:type_name -> commonMq.AmendResp
+	14, // 10: commonMq.NewOrderOperate.side:type_name -> commonEnum.Side
+	15, // 11: commonMq.NewOrderOperate.order_type:type_name -> commonEnum.OrderType
+	16, // 12: commonMq.NewOrderOperate.stp_mode:type_name -> commonEnum.STPMode
+	14, // 13: commonMq.CancelOperate.side:type_name -> commonEnum.Side
+	15, // 14: commonMq.CancelOperate.order_type:type_name -> commonEnum.OrderType
+	14, // 15: commonMq.AmendOperate.side:type_name -> commonEnum.Side
+	15, // 16: commonMq.AmendOperate.order_type:type_name -> commonEnum.OrderType
+	14, // 17: commonMq.CancelAllOperate.side:type_name -> commonEnum.Side
+	17, // 18: commonMq.OrderResp.order_status:type_name -> commonEnum.OrderStatus
+	13, // 19: commonMq.MatchResult.matched_record:type_name -> commonMq.MatchResult.MatchedRecord
+	17, // 20: commonMq.AmendResp.order_status:type_name -> commonEnum.OrderStatus
+	8,  // 21: commonMq.MatchResult.MatchedRecord.taker:type_name -> commonMq.OrderResp
+	8,  // 22: commonMq.MatchResult.MatchedRecord.maker:type_name -> commonMq.OrderResp
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_mq_match_match_proto_init() }
@@ -1076,7 +2006,7 @@ func file_mq_match_match_proto_init() {
 			}
 		}
 		file_mq_match_match_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderResp); i {
+			switch v := v.(*AmendOperate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1088,7 +2018,7 @@ func file_mq_match_match_proto_init() {
 			}
 		}
 		file_mq_match_match_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchResult); i {
+			switch v := v.(*CancelAllOperate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,7 +2030,7 @@ func file_mq_match_match_proto_init() {
 			}
 		}
 		file_mq_match_match_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelResp); i {
+			switch v := v.(*HaltOperate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1112,6 +2042,78 @@ func file_mq_match_match_proto_init() {
 			}
 		}
 		file_mq_match_match_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerOperate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mq_match_match_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MatchResult_MatchedRecord); i {
 			case 0:
 				return &v.state
@@ -1127,10 +2129,16 @@ func file_mq_match_match_proto_init() {
 	file_mq_match_match_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*MatchReq_NewOrder)(nil),
 		(*MatchReq_Cancel)(nil),
+		(*MatchReq_Amend)(nil),
+		(*MatchReq_CancelAll)(nil),
+		(*MatchReq_Halt)(nil),
+		(*MatchReq_Timer)(nil),
 	}
 	file_mq_match_match_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*MatchResp_MatchResult)(nil),
 		(*MatchResp_Cancel)(nil),
+		(*MatchResp_Trigger)(nil),
+		(*MatchResp_Amend)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1138,7 +2146,7 @@ func file_mq_match_match_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mq_match_match_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
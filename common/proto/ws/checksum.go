@@ -0,0 +1,25 @@
+package ws
+
+import (
+	"hash/crc32"
+	"strings"
+)
+
+// DepthChecksumLevel 计算深度校验和的档位数量
+const DepthChecksumLevel = 25
+
+// DepthChecksum 深度前DepthChecksumLevel档的CRC32(IEEE)校验和，服务端和客户端使用同一个算法。
+// bids按照价格从高到低，asks按照价格从低到高，每一档的前两个元素是价格和数量，格式和推送的字符串一致。
+// 买盘和卖盘交替拼接成 买一价:买一量:卖一价:卖一量:买二价:买二量... ，某一边的档位不足时跳过。
+func DepthChecksum(bids, asks [][]string) uint32 {
+	fields := make([]string, 0, DepthChecksumLevel*4)
+	for i := 0; i < DepthChecksumLevel; i++ {
+		if i < len(bids) {
+			fields = append(fields, bids[i][0], bids[i][1])
+		}
+		if i < len(asks) {
+			fields = append(fields, asks[i][0], asks[i][1])
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(strings.Join(fields, ":")))
+}
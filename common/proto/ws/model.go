@@ -14,6 +14,9 @@ const (
 	MiniTickerPrefix TopicPrefix = "miniTicker"
 	TickPrefix       TopicPrefix = "tick"
 	OrderPrefix      TopicPrefix = "order"
+	HaltPrefix       TopicPrefix = "halt"
+	AuctionPrefix    TopicPrefix = "auction"
+	L3Prefix         TopicPrefix = "l3"
 )
 
 func (w TopicPrefix) WithParam(param ...string) string {
@@ -69,6 +72,8 @@ type Depth struct {
 	Asks [][]string `json:"a"`
 	//买盘
 	Bids [][]string `json:"b"`
+	//应用这次推送之后前DepthChecksumLevel档的校验和
+	Checksum uint32 `json:"cs"`
 }
 
 // Tick 成交信息
@@ -96,10 +101,46 @@ type Order struct {
 	FilledAvgPrice string `json:"fap"`
 	Uid            string `json:"u"`
 	CreatedAt      int64  `json:"ca"`
+	TriggerPrice   string `json:"tp"`
+	TriggerStatus  int8   `json:"ts"`
+	Fee            string `json:"fe"` //累计手续费 买单为基础币 卖单为计价币
+}
+
+// Halt 熔断状态
+type Halt struct {
+	Symbol    string `json:"s"`
+	Halted    bool   `json:"h"` //是否暂停交易
+	Price     string `json:"p"` //触发熔断的成交价
+	Until     int64  `json:"u"` //预计恢复交易的时间 单位秒
+	TimeStamp int64  `json:"ts"`
+}
+
+// Auction 集合竞价的参考成交价和成交量
+type Auction struct {
+	Symbol    string `json:"s"`
+	Auction   bool   `json:"a"` //是否处于集合竞价,集合竞价结束时推送实际的成交价和成交量
+	Price     string `json:"p"` //参考成交价,没有可以成交的订单为0
+	Qty       string `json:"q"` //参考成交量
+	Until     int64  `json:"u"` //集合竞价结束的时间 单位秒
+	TimeStamp int64  `json:"ts"`
+}
+
+// L3 逐笔委托，订单簿中每个订单的变化
+type L3 struct {
+	Symbol    string `json:"s"`
+	Seq       int64  `json:"sq"` //逐笔委托的序号,连续递增,不连续说明丢失了数据,需要重新获取快照
+	Version   int64  `json:"v"`  //订单簿版本
+	Event     int8   `json:"e"`  //1新增 2修改 3删除
+	Id        int64  `json:"i"`  //订单id
+	Side      int8   `json:"si"`
+	Price     string `json:"p"`
+	Qty       string `json:"q"`  //订单簿中的数量,冰山单只有显示的数量,删除时为0
+	Queue     int64  `json:"qi"` //排队的序号,相同价格的订单按照排队的序号排序
+	TimeStamp int64  `json:"ts"`
 }
 
 type WsDataModel interface {
-	Kline | Ticker | MiniTicker | Depth | Tick | Order
+	Kline | Ticker | MiniTicker | Depth | Tick | Order | Halt | Auction | L3
 }
 type Message[T WsDataModel] struct {
 	Topic   string `json:"t"`
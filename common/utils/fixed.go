@@ -0,0 +1,509 @@
+package utils
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+/*
+Fixed 定点数，表示value*10^exp，exp在-MaxPrec到0之间。
+
+撮合引擎的热路径使用定点数代替decimal，计算只有整数运算，不分配内存。
+加减和比较按照较小的指数对齐，乘法的指数相加，乘除的中间结果使用128位整数。
+结果超出int64的范围时panic，不会静默溢出；需要舍入的地方必须显式调用RoundDown系列的方法。
+*/
+type Fixed struct {
+	value int64
+	exp   int32
+}
+
+var (
+	ErrFixedOverflow  = errors.New("fixed overflow")
+	ErrFixedPrecision = errors.New("fixed precision exceeded")
+	ErrFixedSyntax    = errors.New("invalid fixed number")
+)
+
+// pow10 10的0到19次方，10^19是uint64能表示的最大的10的幂
+var pow10 [20]uint64
+
+func init() {
+	pow10[0] = 1
+	for i := 1; i < len(pow10); i++ {
+		pow10[i] = pow10[i-1] * 10
+	}
+}
+
+// NewFixed value*10^exp
+func NewFixed(value int64, exp int32) Fixed {
+	checkFixedExp(exp)
+	return Fixed{value: value, exp: exp}
+}
+
+// NewFixedFromString 解析十进制字符串，指数为小数部分去掉末尾的零之后的位数，空字符串为0
+func NewFixedFromString(s string) (Fixed, error) {
+	if s == "" {
+		return Fixed{}, nil
+	}
+	str, neg := s, false
+	if str[0] == '-' || str[0] == '+' {
+		str, neg = str[1:], str[0] == '-'
+	}
+	integer, fraction := str, ""
+	if i := strings.IndexByte(str, '.'); i != -1 {
+		integer, fraction = str[:i], str[i+1:]
+	}
+	if integer == "" && fraction == "" {
+		return Fixed{}, fmt.Errorf("%w: %q", ErrFixedSyntax, s)
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > MaxPrec {
+		return Fixed{}, fmt.Errorf("%w: %q", ErrFixedPrecision, s)
+	}
+	var u uint64
+	for _, digits := range [2]string{integer, fraction} {
+		for i := 0; i < len(digits); i++ {
+			c := digits[i]
+			if c < '0' || c > '9' {
+				return Fixed{}, fmt.Errorf("%w: %q", ErrFixedSyntax, s)
+			}
+			hi, lo := bits.Mul64(u, 10)
+			lo, carry := bits.Add64(lo, uint64(c-'0'), 0)
+			if hi != 0 || carry != 0 {
+				return Fixed{}, fmt.Errorf("%w: %q", ErrFixedOverflow, s)
+			}
+			u = lo
+		}
+	}
+	v, ok := fromUint128(0, u, neg)
+	if !ok {
+		return Fixed{}, fmt.Errorf("%w: %q", ErrFixedOverflow, s)
+	}
+	return Fixed{value: v, exp: -int32(len(fraction))}, nil
+}
+
+// RequireFixedFromString 解析失败时panic，用于常量和测试
+func RequireFixedFromString(s string) Fixed {
+	f, err := NewFixedFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// NewFixedFromDecimal decimal转换为定点数，超出范围返回错误
+func NewFixedFromDecimal(d decimal.Decimal) (Fixed, error) {
+	return NewFixedFromString(d.String())
+}
+
+// FixedParser 连续解析多个字符串，只记录第一个错误，出错之后返回0
+type FixedParser struct {
+	Err error
+}
+
+func (p *FixedParser) Parse(s string) Fixed {
+	if p.Err != nil {
+		return Fixed{}
+	}
+	f, err := NewFixedFromString(s)
+	p.Err = err
+	return f
+}
+
+// MinFixed 最小值
+func MinFixed(first Fixed, rest ...Fixed) Fixed {
+	min := first
+	for _, v := range rest {
+		if v.LessThan(min) {
+			min = v
+		}
+	}
+	return min
+}
+
+func (f Fixed) Value() int64 {
+	return f.value
+}
+
+func (f Fixed) Exp() int32 {
+	return f.exp
+}
+
+// Decimal 转换为decimal，用于输出和不在热路径上的计算
+func (f Fixed) Decimal() decimal.Decimal {
+	return decimal.New(f.value, f.exp)
+}
+
+// Rescale 转换为指定的指数，精度丢失或者超出范围返回错误
+func (f Fixed) Rescale(exp int32) (Fixed, error) {
+	if exp < -MaxPrec || exp > 0 {
+		return Fixed{}, ErrFixedPrecision
+	}
+	if exp <= f.exp {
+		v, ok := mulPow10(f.value, f.exp-exp)
+		if !ok {
+			return Fixed{}, ErrFixedOverflow
+		}
+		return Fixed{value: v, exp: exp}, nil
+	}
+	d := int64(pow10[exp-f.exp])
+	if f.value%d != 0 {
+		return Fixed{}, ErrFixedPrecision
+	}
+	return Fixed{value: f.value / d, exp: exp}, nil
+}
+
+// RoundDown 舍去指数exp以下的部分，向零取整
+func (f Fixed) RoundDown(exp int32) Fixed {
+	return scale128(0, abs64(f.value), f.exp, exp, f.value < 0)
+}
+
+func (f Fixed) Add(g Fixed) Fixed {
+	r, err := f.AddChecked(g)
+	if err != nil {
+		panic(fmt.Errorf("%w: %v + %v", err, f, g))
+	}
+	return r
+}
+
+// AddChecked 超出范围返回错误，用于校验输入
+func (f Fixed) AddChecked(g Fixed) (Fixed, error) {
+	a, b, exp, ok := align(f, g)
+	s := a + b
+	if !ok || (s > a) != (b > 0) {
+		return Fixed{}, ErrFixedOverflow
+	}
+	return Fixed{value: s, exp: exp}, nil
+}
+
+func (f Fixed) Sub(g Fixed) Fixed {
+	r, err := f.SubChecked(g)
+	if err != nil {
+		panic(fmt.Errorf("%w: %v - %v", err, f, g))
+	}
+	return r
+}
+
+// SubChecked 超出范围返回错误，用于校验输入
+func (f Fixed) SubChecked(g Fixed) (Fixed, error) {
+	a, b, exp, ok := align(f, g)
+	s := a - b
+	if !ok || (s < a) != (b > 0) {
+		return Fixed{}, ErrFixedOverflow
+	}
+	return Fixed{value: s, exp: exp}, nil
+}
+
+// Mul 精确的乘积，指数相加，超出范围时panic
+func (f Fixed) Mul(g Fixed) Fixed {
+	r, err := f.MulChecked(g)
+	if err != nil {
+		panic(fmt.Errorf("%w: %v * %v", err, f, g))
+	}
+	return r
+}
+
+// MulChecked 精确的乘积，超出范围返回错误，用于校验输入
+func (f Fixed) MulChecked(g Fixed) (Fixed, error) {
+	hi, lo := bits.Mul64(abs64(f.value), abs64(g.value))
+	exp := f.exp + g.exp
+	//指数超出范围时只能去掉末尾的零
+	for exp < -MaxPrec && (hi != 0 || lo != 0) {
+		var r uint64
+		if hi, lo, r = div128(hi, lo, 10); r != 0 {
+			return Fixed{}, ErrFixedPrecision
+		}
+		exp++
+	}
+	if exp < -MaxPrec {
+		exp = -MaxPrec
+	}
+	v, ok := fromUint128(hi, lo, (f.value < 0) != (g.value < 0))
+	if !ok {
+		return Fixed{}, ErrFixedOverflow
+	}
+	return Fixed{value: v, exp: exp}, nil
+}
+
+// MulRoundDown 乘积舍去指数exp以下的部分，向零取整，中间结果不会溢出
+func (f Fixed) MulRoundDown(g Fixed, exp int32) Fixed {
+	hi, lo := bits.Mul64(abs64(f.value), abs64(g.value))
+	return scale128(hi, lo, f.exp+g.exp, exp, (f.value < 0) != (g.value < 0))
+}
+
+// DivRoundDown 商保留到指数exp，向零取整
+func (f Fixed) DivRoundDown(g Fixed, exp int32) Fixed {
+	if g.value == 0 {
+		panic("fixed division by zero")
+	}
+	checkFixedExp(exp)
+	//f/g = (fv/gv)*10^(fe-ge)，结果的整数值为 fv*10^(fe-ge-exp)/gv
+	hi, lo, k := uint64(0), abs64(f.value), f.exp-g.exp-exp
+	if k < 0 {
+		//先舍去被除数的低位，两次向下取整和一次向下取整的结果相同
+		hi, lo = scaleDown128(hi, lo, -k)
+	} else {
+		var ok bool
+		if hi, lo, ok = mulPow10_128(hi, lo, k); !ok {
+			panic(fmt.Errorf("%w: %v / %v", ErrFixedOverflow, f, g))
+		}
+	}
+	d := abs64(g.value)
+	hi, lo, _ = div128(hi, lo, d)
+	v, ok := fromUint128(hi, lo, (f.value < 0) != (g.value < 0))
+	if !ok {
+		panic(fmt.Errorf("%w: %v / %v", ErrFixedOverflow, f, g))
+	}
+	return Fixed{value: v, exp: exp}
+}
+
+func (f Fixed) Neg() Fixed {
+	if f.value == math.MinInt64 {
+		panic(fmt.Errorf("%w: -%v", ErrFixedOverflow, f))
+	}
+	return Fixed{value: -f.value, exp: f.exp}
+}
+
+func (f Fixed) Abs() Fixed {
+	if f.value < 0 {
+		return f.Neg()
+	}
+	return f
+}
+
+func (f Fixed) Sign() int {
+	switch {
+	case f.value > 0:
+		return 1
+	case f.value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func (f Fixed) IsZero() bool {
+	return f.value == 0
+}
+
+func (f Fixed) IsPositive() bool {
+	return f.value > 0
+}
+
+func (f Fixed) IsNegative() bool {
+	return f.value < 0
+}
+
+// Cmp 比较大小，f<g返回-1，f=g返回0，f>g返回1
+func (f Fixed) Cmp(g Fixed) int {
+	a, b, _, ok := align(f, g)
+	if !ok {
+		//对齐之后超出范围的一方绝对值更大
+		if f.exp > g.exp {
+			return f.Sign()
+		}
+		return -g.Sign()
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (f Fixed) Equal(g Fixed) bool {
+	return f.Cmp(g) == 0
+}
+
+func (f Fixed) LessThan(g Fixed) bool {
+	return f.Cmp(g) < 0
+}
+
+func (f Fixed) LessThanOrEqual(g Fixed) bool {
+	return f.Cmp(g) <= 0
+}
+
+func (f Fixed) GreaterThan(g Fixed) bool {
+	return f.Cmp(g) > 0
+}
+
+func (f Fixed) GreaterThanOrEqual(g Fixed) bool {
+	return f.Cmp(g) >= 0
+}
+
+// String 和decimal的String一致，去掉小数末尾的零
+func (f Fixed) String() string {
+	if f.value == 0 {
+		return "0"
+	}
+	u, exp := abs64(f.value), f.exp
+	for exp < 0 && u%10 == 0 {
+		u /= 10
+		exp++
+	}
+	s := strconv.FormatUint(u, 10)
+	if n := int(-exp); n > 0 {
+		if len(s) <= n {
+			s = "0." + strings.Repeat("0", n-len(s)) + s
+		} else {
+			s = s[:len(s)-n] + "." + s[len(s)-n:]
+		}
+	}
+	if f.value < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// StringFixedBank 保留places位小数，银行家舍入，用于推送行情
+func (f Fixed) StringFixedBank(places int32) string {
+	return f.Decimal().StringFixedBank(places)
+}
+
+// GobEncode 快照中按照value和exp编码，恢复之后指数不变
+func (f Fixed) GobEncode() ([]byte, error) {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf, uint64(f.value))
+	binary.BigEndian.PutUint32(buf[8:], uint32(f.exp))
+	return buf, nil
+}
+
+func (f *Fixed) GobDecode(data []byte) error {
+	if len(data) != 12 {
+		return fmt.Errorf("%w: gob data length %v", ErrFixedSyntax, len(data))
+	}
+	exp := int32(binary.BigEndian.Uint32(data[8:]))
+	if exp < -MaxPrec || exp > 0 {
+		return fmt.Errorf("%w: exp %v", ErrFixedPrecision, exp)
+	}
+	f.value, f.exp = int64(binary.BigEndian.Uint64(data)), exp
+	return nil
+}
+
+// MarshalJSON 和decimal一样编码为字符串，日志中的订单可读
+func (f Fixed) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(f.String())), nil
+}
+
+func (f *Fixed) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		s = string(data)
+	}
+	v, err := NewFixedFromString(s)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+func checkFixedExp(exp int32) {
+	if exp < -MaxPrec || exp > 0 {
+		panic(fmt.Errorf("%w: exp %v", ErrFixedPrecision, exp))
+	}
+}
+
+// align 按照较小的指数对齐，超出范围返回false
+func align(f, g Fixed) (a, b int64, exp int32, ok bool) {
+	switch {
+	case f.exp == g.exp:
+		return f.value, g.value, f.exp, true
+	case f.exp > g.exp:
+		a, ok = mulPow10(f.value, f.exp-g.exp)
+		return a, g.value, g.exp, ok
+	default:
+		b, ok = mulPow10(g.value, g.exp-f.exp)
+		return f.value, b, f.exp, ok
+	}
+}
+
+// mulPow10 v*10^n，n在0到MaxPrec之间
+func mulPow10(v int64, n int32) (int64, bool) {
+	if n == 0 || v == 0 {
+		return v, true
+	}
+	hi, lo := bits.Mul64(abs64(v), pow10[n])
+	return fromUint128(hi, lo, v < 0)
+}
+
+// mulPow10_128 128位无符号整数乘以10^n，超出128位返回false
+func mulPow10_128(hi, lo uint64, n int32) (uint64, uint64, bool) {
+	for n > 0 {
+		k := n
+		if k > 19 {
+			k = 19
+		}
+		h1, l1 := bits.Mul64(lo, pow10[k])
+		h2, l2 := bits.Mul64(hi, pow10[k])
+		var carry uint64
+		if hi, carry = bits.Add64(h1, l2, 0); h2 != 0 || carry != 0 {
+			return 0, 0, false
+		}
+		lo = l1
+		n -= k
+	}
+	return hi, lo, true
+}
+
+// scaleDown128 128位无符号整数除以10^n，向下取整
+func scaleDown128(hi, lo uint64, n int32) (uint64, uint64) {
+	for n > 0 && (hi != 0 || lo != 0) {
+		k := n
+		if k > 19 {
+			k = 19
+		}
+		hi, lo, _ = div128(hi, lo, pow10[k])
+		n -= k
+	}
+	return hi, lo
+}
+
+// scale128 128位的绝对值从指数from转换到指数to，向零取整，超出范围时panic
+func scale128(hi, lo uint64, from, to int32, neg bool) Fixed {
+	checkFixedExp(to)
+	var ok bool
+	if to >= from {
+		hi, lo = scaleDown128(hi, lo, to-from)
+	} else if hi, lo, ok = mulPow10_128(hi, lo, from-to); !ok {
+		panic(ErrFixedOverflow)
+	}
+	v, ok := fromUint128(hi, lo, neg)
+	if !ok {
+		panic(ErrFixedOverflow)
+	}
+	return Fixed{value: v, exp: to}
+}
+
+// div128 128位无符号整数除以d
+func div128(hi, lo, d uint64) (qhi, qlo, rem uint64) {
+	qhi, rem = hi/d, hi%d
+	qlo, rem = bits.Div64(rem, lo, d)
+	return qhi, qlo, rem
+}
+
+// fromUint128 绝对值和符号转换为int64，超出范围返回false
+func fromUint128(hi, lo uint64, neg bool) (int64, bool) {
+	if hi != 0 || lo > math.MaxInt64 {
+		return 0, false
+	}
+	if neg {
+		return -int64(lo), true
+	}
+	return int64(lo), true
+}
+
+func abs64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v)
+	}
+	return uint64(v)
+}
@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestNewFixedFromString(t *testing.T) {
+	tests := []struct {
+		s     string
+		value int64
+		exp   int32
+		err   error
+	}{
+		{s: "", value: 0, exp: 0},
+		{s: "100", value: 100, exp: 0},
+		{s: "100.50", value: 1005, exp: -1},
+		{s: "-0.001", value: -1, exp: -3},
+		{s: "+.5", value: 5, exp: -1},
+		{s: "9223372036854775807", value: 9223372036854775807, exp: 0},
+		{s: "9223372036854775808", err: ErrFixedOverflow},
+		{s: "92233720368.54775808", err: ErrFixedOverflow},
+		{s: "0.0000000000000000001", err: ErrFixedPrecision},
+		{s: "0.1000000000000000000", value: 1, exp: -1},
+		{s: "1e5", err: ErrFixedSyntax},
+		{s: ".", err: ErrFixedSyntax},
+	}
+	for _, tt := range tests {
+		f, err := NewFixedFromString(tt.s)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("NewFixedFromString(%q) err = %v, want %v", tt.s, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || f.Value() != tt.value || f.Exp() != tt.exp {
+			t.Errorf("NewFixedFromString(%q) = %v %v %v, want %v %v", tt.s, f.Value(), f.Exp(), err, tt.value, tt.exp)
+		}
+	}
+}
+
+// String和decimal的String一致
+func TestFixedString(t *testing.T) {
+	for _, f := range []Fixed{NewFixed(0, -8), NewFixed(1, -18), NewFixed(-1234500, -4), NewFixed(120, 0), NewFixed(-9, -1)} {
+		if got, want := f.String(), f.Decimal().String(); got != want {
+			t.Errorf("String() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFixedArithmetic(t *testing.T) {
+	a, b := RequireFixedFromString("1.5"), RequireFixedFromString("0.25")
+	if got := a.Add(b); got.String() != "1.75" || got.Exp() != -2 {
+		t.Errorf("Add = %v exp %v", got, got.Exp())
+	}
+	if got := b.Sub(a); got.String() != "-1.25" {
+		t.Errorf("Sub = %v", got)
+	}
+	if got := a.Mul(b); got.String() != "0.375" || got.Exp() != -3 {
+		t.Errorf("Mul = %v exp %v", got, got.Exp())
+	}
+	if !a.GreaterThan(b) || !NewFixed(150, -2).Equal(a) || MinFixed(a, b, Fixed{}).Sign() != 0 {
+		t.Errorf("compare failed")
+	}
+	//对齐之后超出范围也能比较
+	if !NewFixed(1, -18).LessThan(NewFixed(1e18, 0)) || !NewFixed(-1e18, 0).LessThan(NewFixed(1, -18)) {
+		t.Errorf("compare overflow failed")
+	}
+}
+
+func TestFixedOverflow(t *testing.T) {
+	max := NewFixed(9223372036854775807, 0)
+	ops := map[string]func(){
+		"add":    func() { max.Add(NewFixed(1, 0)) },
+		"sub":    func() { max.Neg().Sub(NewFixed(2, 0)) },
+		"align":  func() { max.Add(NewFixed(1, -1)) },
+		"mul":    func() { max.Mul(NewFixed(2, 0)) },
+		"div":    func() { max.DivRoundDown(NewFixed(1, -1), 0) },
+		"rescal": func() { max.RoundDown(-1) },
+	}
+	for name, op := range ops {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v did not panic", name)
+				}
+			}()
+			op()
+		}()
+	}
+	if _, err := max.AddChecked(NewFixed(1, 0)); !errors.Is(err, ErrFixedOverflow) {
+		t.Errorf("AddChecked err = %v", err)
+	}
+	if _, err := max.Neg().SubChecked(NewFixed(2, 0)); !errors.Is(err, ErrFixedOverflow) {
+		t.Errorf("SubChecked err = %v", err)
+	}
+	if _, err := max.MulChecked(NewFixed(2, 0)); !errors.Is(err, ErrFixedOverflow) {
+		t.Errorf("MulChecked err = %v", err)
+	}
+	if _, err := NewFixed(1, -18).MulChecked(NewFixed(1, -1)); !errors.Is(err, ErrFixedPrecision) {
+		t.Errorf("MulChecked precision err = %v", err)
+	}
+	if _, err := NewFixed(15, -1).Rescale(0); !errors.Is(err, ErrFixedPrecision) {
+		t.Errorf("Rescale err = %v", err)
+	}
+	if _, err := max.Rescale(-1); !errors.Is(err, ErrFixedOverflow) {
+		t.Errorf("Rescale err = %v", err)
+	}
+}
+
+// 市价买单按照金额买入的数量向下取整，剩余不足一个最小单位的金额是零头
+func TestFixedDivRoundDown(t *testing.T) {
+	amount, price := RequireFixedFromString("100"), RequireFixedFromString("30000.5")
+	qty := amount.DivRoundDown(price, -4)
+	if qty.String() != "0.0033" {
+		t.Errorf("qty = %v", qty)
+	}
+	dust := amount.Sub(qty.Mul(price))
+	if dust.String() != "0.99835" || !dust.IsPositive() || !dust.LessThan(NewFixed(1, -4).Mul(price)) {
+		t.Errorf("dust = %v", dust)
+	}
+	//负数向零取整
+	if got := amount.Neg().DivRoundDown(NewFixed(3, 0), -2); got.String() != "-33.33" {
+		t.Errorf("negative div = %v", got)
+	}
+	//中间结果超过int64
+	big := NewFixed(9e18, 0)
+	if got := big.DivRoundDown(NewFixed(3e18, 0), -18); got.String() != "3" {
+		t.Errorf("big div = %v", got)
+	}
+}
+
+func TestFixedMulRoundDown(t *testing.T) {
+	qty, rate := RequireFixedFromString("0.3333"), RequireFixedFromString("0.001")
+	if got := qty.MulRoundDown(rate, -6); got.String() != "0.000333" || got.Exp() != -6 {
+		t.Errorf("fee = %v", got)
+	}
+	//乘积超过int64，舍去之后在范围内
+	big := NewFixed(9e18, -18)
+	if got := big.MulRoundDown(NewFixed(1e9, -9), -9); got.String() != "9" {
+		t.Errorf("big fee = %v", got)
+	}
+}
+
+func TestFixedGob(t *testing.T) {
+	type snapshot struct {
+		Price Fixed
+		Qty   []Fixed
+	}
+	in := snapshot{Price: NewFixed(-123450, -4), Qty: []Fixed{NewFixed(1, -18), {}}}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out snapshot
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Price != in.Price || out.Qty[0] != in.Qty[0] || out.Qty[1] != in.Qty[1] {
+		t.Errorf("gob = %+v, want %+v", out, in)
+	}
+}
+
+// BenchmarkFixed 对比定点数和decimal的乘法和加法
+// go test -run=^$ -bench=Fixed -benchmem ./common/utils
+func BenchmarkFixed(b *testing.B) {
+	b.Run("fixed", func(b *testing.B) {
+		price, qty, sum := RequireFixedFromString("30000.25"), RequireFixedFromString("0.125"), Fixed{}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum = sum.Add(price.Mul(qty)).Sub(qty)
+		}
+	})
+	b.Run("decimal", func(b *testing.B) {
+		price, qty, sum := NewFromStringMaxPrec("30000.25"), NewFromStringMaxPrec("0.125"), DecimalZeroMaxPrec
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum = sum.Add(price.Mul(qty)).Sub(qty)
+		}
+	})
+}
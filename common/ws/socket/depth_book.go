@@ -0,0 +1,262 @@
+package socket
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	commonWs "github.com/luxun9527/gex/common/proto/ws"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/cast"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+/**
+DepthBook 根据增量深度维护一个校验过的本地深度，同步的步骤：
+1.订阅depth@交易对(合并深度depth@交易对@档位)，同步之前收到的推送先缓存；
+2.获取深度快照，快照的版本号为V，快照的档位数量不能少于ws.DepthChecksumLevel；
+3.丢弃当前版本(cv)小于等于V的推送，第一个应用的推送需要满足 上一个版本(lv)<=V<cv，档位的数量是绝对值，数量为0删除档位；
+4.之后每个推送的lv必须等于本地的版本，否则说明丢失了数据；
+5.每次应用之后计算本地深度前ws.DepthChecksumLevel档的校验和，和推送的校验和(cs)比较。
+丢失数据或者校验失败时重新获取快照，从第3步开始应用缓存的推送。
+*/
+
+var (
+	ErrDepthGap      = errors.New("depth version gap")
+	ErrDepthChecksum = errors.New("depth checksum mismatch")
+)
+
+// DepthSnapshot 深度快照，每个档位的格式和推送的一致 [价格,数量,金额]
+type DepthSnapshot struct {
+	Version  int64
+	Checksum uint32
+	Asks     [][]string
+	Bids     [][]string
+}
+
+// SnapshotFunc 获取深度快照
+type SnapshotFunc func() (*DepthSnapshot, error)
+
+type DepthBook struct {
+	mu       sync.RWMutex
+	topic    string
+	snapshot SnapshotFunc
+	asks     map[string][]string //价格->档位
+	bids     map[string][]string
+	version  int64
+	synced   bool
+	buffer   []commonWs.Depth //还没有应用的推送
+}
+
+// NewDepthBook group为空是原始深度
+func NewDepthBook(symbol, group string, snapshot SnapshotFunc) *DepthBook {
+	topic := commonWs.DepthPrefix.WithParam(symbol)
+	if group != "" {
+		topic = commonWs.DepthPrefix.WithParam(symbol, group)
+	}
+	return &DepthBook{
+		topic:    topic,
+		snapshot: snapshot,
+		asks:     make(map[string][]string),
+		bids:     make(map[string][]string),
+		buffer:   make([]commonWs.Depth, 0, 8),
+	}
+}
+
+// NewHttpSnapshotFunc 通过行情服务的get_depth_list接口获取快照，baseUrl例如http://localhost:20011
+func NewHttpSnapshotFunc(baseUrl, symbol, group string) SnapshotFunc {
+	client := &http.Client{Timeout: 5 * time.Second}
+	return func() (*DepthSnapshot, error) {
+		req, _ := json.Marshal(map[string]interface{}{
+			"symbol": symbol,
+			"level":  commonWs.DepthChecksumLevel * 4,
+			"group":  group,
+		})
+		resp, err := client.Post(baseUrl+"/quotes/v1/get_depth_list", "application/json", bytes.NewReader(req))
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		type position struct {
+			Qty    string `json:"qty"`
+			Price  string `json:"price"`
+			Amount string `json:"amount"`
+		}
+		var body struct {
+			Code int    `json:"code"`
+			Msg  string `json:"msg"`
+			Data struct {
+				Version  string      `json:"version"`
+				Asks     []*position `json:"asks"`
+				Bids     []*position `json:"bids"`
+				Checksum uint32      `json:"checksum"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Code != 0 {
+			return nil, fmt.Errorf("get depth list failed code=%d msg=%s", body.Code, body.Msg)
+		}
+		snapshot := &DepthSnapshot{
+			Version:  cast.ToInt64(body.Data.Version),
+			Checksum: body.Data.Checksum,
+			Asks:     make([][]string, 0, len(body.Data.Asks)),
+			Bids:     make([][]string, 0, len(body.Data.Bids)),
+		}
+		for _, v := range body.Data.Asks {
+			snapshot.Asks = append(snapshot.Asks, []string{v.Price, v.Qty, v.Amount})
+		}
+		for _, v := range body.Data.Bids {
+			snapshot.Bids = append(snapshot.Bids, []string{v.Price, v.Qty, v.Amount})
+		}
+		return snapshot, nil
+	}
+}
+
+// Run 从客户端读取推送并应用，客户端关闭之后返回，调用之前需要订阅深度的topic
+func (b *DepthBook) Run(c *Client) {
+	for {
+		message, err := c.Read()
+		if err != nil {
+			return
+		}
+		if err := b.Apply(message); err != nil {
+			logx.Errorf("apply depth failed topic=%s err=%v", b.topic, err)
+		}
+	}
+}
+
+// Apply 应用一条推送，其他topic的消息忽略。丢失数据或者校验失败时重新获取快照，
+// 获取快照失败返回错误，下一条推送会再次尝试。必须在同一个协程中调用。
+func (b *DepthBook) Apply(message []byte) error {
+	var msg commonWs.Message[commonWs.Depth]
+	if err := json.Unmarshal(message, &msg); err != nil || msg.Topic != b.topic {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buffer = append(b.buffer, msg.Payload)
+	if b.synced {
+		err := b.drain()
+		if err == nil {
+			return nil
+		}
+		logx.Infof("depth book out of sync, resync topic=%s err=%v", b.topic, err)
+		b.synced = false
+	}
+	return b.resync()
+}
+
+// resync 获取快照之后应用缓存的推送
+func (b *DepthBook) resync() error {
+	snapshot, err := b.snapshot()
+	if err != nil {
+		return err
+	}
+	b.asks = make(map[string][]string, len(snapshot.Asks))
+	b.bids = make(map[string][]string, len(snapshot.Bids))
+	b.applyLevels(b.asks, snapshot.Asks)
+	b.applyLevels(b.bids, snapshot.Bids)
+	b.version = snapshot.Version
+	if b.checksum() != snapshot.Checksum {
+		return fmt.Errorf("snapshot %w version=%d", ErrDepthChecksum, snapshot.Version)
+	}
+	if err := b.drain(); err != nil {
+		return err
+	}
+	b.synced = true
+	return nil
+}
+
+// drain 按顺序应用缓存的推送，出错时保留还没有应用成功的推送
+func (b *DepthBook) drain() error {
+	for i, v := range b.buffer {
+		lastVersion, currentVersion := cast.ToInt64(v.LastVersion), cast.ToInt64(v.CurrentVersion)
+		if currentVersion <= b.version {
+			continue
+		}
+		if lastVersion > b.version {
+			b.buffer = b.buffer[i:]
+			return fmt.Errorf("%w local=%d last=%d", ErrDepthGap, b.version, lastVersion)
+		}
+		b.applyLevels(b.asks, v.Asks)
+		b.applyLevels(b.bids, v.Bids)
+		b.version = currentVersion
+		if b.checksum() != v.Checksum {
+			b.buffer = b.buffer[i:]
+			return fmt.Errorf("%w version=%d", ErrDepthChecksum, currentVersion)
+		}
+	}
+	b.buffer = b.buffer[:0]
+	return nil
+}
+
+func (b *DepthBook) applyLevels(book map[string][]string, levels [][]string) {
+	for _, v := range levels {
+		if len(v) < 2 {
+			continue
+		}
+		if _, err := decimal.NewFromString(v[0]); err != nil {
+			continue
+		}
+		if qty, err := decimal.NewFromString(v[1]); err != nil || qty.IsZero() {
+			delete(book, v[0])
+			continue
+		}
+		book[v[0]] = v
+	}
+}
+
+func (b *DepthBook) checksum() uint32 {
+	return commonWs.DepthChecksum(sortLevels(b.bids, true), sortLevels(b.asks, false))
+}
+
+// sortLevels 按照价格排序，desc为true从大到小
+func sortLevels(book map[string][]string, desc bool) [][]string {
+	levels := make([][]string, 0, len(book))
+	for _, v := range book {
+		levels = append(levels, v)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		c := decimal.RequireFromString(levels[i][0]).Cmp(decimal.RequireFromString(levels[j][0]))
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+	return levels
+}
+
+// Synced 本地深度是否和服务端一致
+func (b *DepthBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// Version 本地深度的版本号
+func (b *DepthBook) Version() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.version
+}
+
+// Depth 本地深度的前level档，买盘从高到低，卖盘从低到高
+func (b *DepthBook) Depth(level int) (bids, asks [][]string) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	bids, asks = sortLevels(b.bids, true), sortLevels(b.asks, false)
+	if len(bids) > level {
+		bids = bids[:level]
+	}
+	if len(asks) > level {
+		asks = asks[:level]
+	}
+	return bids, asks
+}
@@ -49,6 +49,13 @@ CREATE TABLE `symbol`  (
                            `quote_coin_id` smallint UNSIGNED NOT NULL DEFAULT 0 COMMENT '计价币ID',
                            `quote_coin_name` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '计价币名称',
                            `quote_coin_prec` tinyint NOT NULL DEFAULT 0 COMMENT '计价币精度',
+                           `tick_size` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '价格最小变动单位',
+                           `lot_size` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '数量最小变动单位',
+                           `min_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '最小下单数量',
+                           `max_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '最大下单数量',
+                           `min_notional` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '最小下单金额',
+                           `max_notional` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '最大下单金额',
+                           `open_time` bigint NOT NULL DEFAULT 0 COMMENT '开盘时间',
                            `created_at` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '创建时间',
                            `updated_at` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '修改时间',
                            `deleted_at` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '删除时间',
@@ -95,7 +102,8 @@ CREATE TABLE `asset`  (
                           `frozen_qty` decimal(40, 18) NOT NULL COMMENT '冻结金额',
                           `created_at` bigint NOT NULL COMMENT '创建时间',
                           `updated_at` bigint NOT NULL COMMENT '修改时间',
-                          PRIMARY KEY (`id`) USING BTREE
+                          PRIMARY KEY (`id`) USING BTREE,
+                          UNIQUE INDEX `uni_user_coin`(`user_id` ASC, `coin_id` ASC) USING BTREE
 ) ENGINE = InnoDB AUTO_INCREMENT = 208 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
 
 
@@ -120,6 +128,18 @@ CREATE TABLE `entrust_order_00`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -147,6 +167,18 @@ CREATE TABLE `entrust_order_01`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -174,6 +206,18 @@ CREATE TABLE `entrust_order_02`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -201,6 +245,18 @@ CREATE TABLE `entrust_order_03`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -228,6 +284,18 @@ CREATE TABLE `entrust_order_04`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -255,6 +323,18 @@ CREATE TABLE `entrust_order_05`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -282,6 +362,18 @@ CREATE TABLE `entrust_order_06`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -309,6 +401,18 @@ CREATE TABLE `entrust_order_07`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -336,6 +440,18 @@ CREATE TABLE `entrust_order_08`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -363,6 +479,18 @@ CREATE TABLE `entrust_order_09`  (
                                      `created_at` bigint NOT NULL COMMENT '创建时间',
                                      `updated_at` bigint NOT NULL COMMENT '修改时间',
                                      `deleted_at` bigint NOT NULL COMMENT '删除时间',
+                                     `post_only` tinyint NOT NULL DEFAULT 0 COMMENT '是否只做maker 0否 1是',
+                                     `trigger_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '触发价格 0表示不是条件单',
+                                     `trigger_status` tinyint NOT NULL DEFAULT 0 COMMENT '条件单触发状态 0不是条件单 1待触发 2已触发',
+                                     `stp_mode` tinyint NOT NULL DEFAULT 0 COMMENT '自成交保护模式 0使用交易对默认 1允许 2撤销新订单 3撤销旧订单 4都撤销 5减少数量',
+                                     `maker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'maker手续费率',
+                                     `taker_fee_rate` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT 'taker手续费率',
+                                     `fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '累计手续费 买单为基础币 卖单为计价币',
+                                     `display_qty` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '冰山单每次显示的数量 0表示不是冰山单',
+                                     `expire_time` bigint NOT NULL DEFAULT 0 COMMENT '过期时间 单位秒 0表示一直有效',
+                                     `max_slippage` decimal(10, 6) NOT NULL DEFAULT 0 COMMENT '市价单的最大滑点 0表示不限制',
+                                     `protection_price` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT '市价单的保护价格 0表示不限制',
+                                     `queue_id` bigint NOT NULL DEFAULT 0 COMMENT '修改订单之后重新排队的序号 0表示按照订单id排队',
                                      PRIMARY KEY (`id`) USING BTREE,
                                      INDEX `idx_user_id_status`(`user_id` ASC, `status` ASC) USING BTREE,
                                      INDEX `uni_order_id`(`order_id` ASC) USING BTREE
@@ -404,6 +532,8 @@ CREATE TABLE `matched_order`  (
                                   `price` decimal(40, 18) NOT NULL COMMENT '价格',
                                   `qty` decimal(40, 18) NOT NULL COMMENT '数量(基础币)',
                                   `amount` decimal(40, 18) NOT NULL COMMENT '金额（计价币）',
+                                  `taker_fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT 'taker手续费 taker买为基础币 taker卖为计价币',
+                                  `maker_fee` decimal(40, 18) NOT NULL DEFAULT 0 COMMENT 'maker手续费 maker买为基础币 maker卖为计价币',
                                   `match_time` bigint NOT NULL DEFAULT 0 COMMENT '撮合时间',
                                   `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
                                   `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '修改时间',
@@ -419,6 +549,7 @@ CREATE TABLE `user`  (
                          `password` varchar(150) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL COMMENT '密码',
                          `phone_number` bigint NOT NULL COMMENT '手机号',
                          `status` int NOT NULL DEFAULT 1 COMMENT '用户状态，1正常2锁定',
+                         `vip_level` tinyint NOT NULL DEFAULT 0 COMMENT 'vip等级 0普通用户',
                          `created_at` bigint NOT NULL COMMENT '创建时间',
                          `updated_at` bigint NOT NULL COMMENT '更新时间',
                          PRIMARY KEY (`id`) USING BTREE,
@@ -487,6 +618,14 @@ INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `lan
 INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (55, 50006, '超过最小精度11', 'zh-CN', 1715610797, 1717333078, 0);
 INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (58, 500006, '超过币种最小精度', 'zh-CN', 1715611690, 1717333116, 0);
 INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (59, 100012, '验证码错误', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (60, 500007, '价格不是最小变动单位的整数倍', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (61, 500008, '数量不是最小变动单位的整数倍', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (62, 500009, '数量小于最小下单数量', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (63, 500010, '数量大于最大下单数量', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (64, 500011, '金额小于最小下单金额', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (65, 500012, '金额大于最大下单金额', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (66, 500013, '只有限价单可以修改', 'zh-CN', 1717341916, 1717341916, 0);
+INSERT INTO `admin`.`error_code` (`id`, `error_code_id`, `error_code_name`, `language`, `created_at`, `updated_at`, `deleted_at`) VALUES (67, 500014, '修改之后的数量必须大于已成交数量', 'zh-CN', 1717341916, 1717341916, 0);
 
 
 INSERT INTO `admin`.`symbol` (`id`, `symbol_name`, `symbol_id`, `base_coin_id`, `base_coin_name`, `base_coin_prec`, `quote_coin_id`, `quote_coin_name`, `quote_coin_prec`, `created_at`, `updated_at`, `deleted_at`) VALUES (1, 'IKUN_USDT', 1, 10001, 'IKUN', 3, 10002, 'USDT', 5, 1717851844, 1717851844, 0);
@@ -0,0 +1,17 @@
+-- 已有的数据库执行，新部署的数据库init.sql中已经包含。
+-- 手续费账户按照(user_id, coin_id)唯一索引累加手续费，之前重复创建的同一个用户同一个币种的资产先合并到id最小的一行。
+USE `trade`;
+
+UPDATE `asset` a
+    JOIN (SELECT MIN(`id`) AS `id`, SUM(`available_qty`) AS `available_qty`, SUM(`frozen_qty`) AS `frozen_qty`
+          FROM `asset`
+          GROUP BY `user_id`, `coin_id`
+          HAVING COUNT(*) > 1) d ON a.`id` = d.`id`
+SET a.`available_qty` = d.`available_qty`,
+    a.`frozen_qty`    = d.`frozen_qty`;
+
+DELETE a
+FROM `asset` a
+         JOIN `asset` b ON a.`user_id` = b.`user_id` AND a.`coin_id` = b.`coin_id` AND a.`id` > b.`id`;
+
+ALTER TABLE `asset` ADD UNIQUE INDEX `uni_user_coin`(`user_id` ASC, `coin_id` ASC) USING BTREE;
@@ -31,7 +31,15 @@ lang='50006: 超过最小精度
 500003: 市价单不允许手动取消
 500004: 订单簿没有买单
 500005: 订单簿没有卖单
-500006: 超过币种最小精度'
+500006: 超过币种最小精度
+500007: 价格不是最小变动单位的整数倍
+500008: 数量不是最小变动单位的整数倍
+500009: 数量小于最小下单数量
+500010: 数量大于最大下单数量
+500011: 金额小于最小下单金额
+500012: 金额大于最大下单金额
+500013: 只有限价单可以修改
+500014: 修改之后的数量必须大于已成交数量'
 
 coin1='coinid: 10001
 coinname: IKUN
@@ -47,7 +55,13 @@ basecoinid: 10001
 quotecoinname: USDT
 quotecoinid: 10002
 baseCoinPrec: 3
-quoteCoinPrec: 5'
+quoteCoinPrec: 5
+makerFeeRate: 0.001
+takerFeeRate: 0.002
+vipFeeRates:
+  - level: 1
+    makerFeeRate: 0.0008
+    takerFeeRate: 0.0016'
 
 # echo "Processing Pulsar file directory in the first launch..."
 # mkdir -p deploy/depend/pulsar/data/metadata